@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -23,9 +24,9 @@ type AgentBootstrapConfig struct {
 }
 
 // GetAgent - Get Agent by name
-func (c *Client) GetAgent(name string) (*Agent, int, error) {
+func (c *Client) GetAgent(ctx context.Context, name string) (*Agent, int, error) {
 
-	agent, code, err := c.GetResource("agent/"+name, new(Agent))
+	agent, code, err := c.GetResource(ctx, "agent/"+name, new(Agent))
 
 	if err != nil {
 		return nil, code, err
@@ -35,22 +36,22 @@ func (c *Client) GetAgent(name string) (*Agent, int, error) {
 }
 
 // CreateAgent - Create an Agent
-func (c *Client) CreateAgent(agent Agent) (*Agent, int, error) {
-	return c.CreateResourceAgent(agent)
+func (c *Client) CreateAgent(ctx context.Context, agent Agent) (*Agent, int, error) {
+	return c.CreateResourceAgent(ctx, agent)
 }
 
 // UpdateAgent - Update an Agent
-func (c *Client) UpdateAgent(agent Agent) (*Agent, int, error) {
+func (c *Client) UpdateAgent(ctx context.Context, agent Agent) (*Agent, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("agent/%s", *agent.Name), agent)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("agent/%s", *agent.Name), agent)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetAgent(*agent.Name)
+	return c.GetAgent(ctx, *agent.Name)
 }
 
 // DeleteAgent - Delete Agent by name
-func (c *Client) DeleteAgent(name string) error {
-	return c.DeleteResource(fmt.Sprintf("agent/%s", name))
+func (c *Client) DeleteAgent(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("agent/%s", name))
 }
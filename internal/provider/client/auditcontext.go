@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -10,9 +11,9 @@ type AuditContext struct {
 }
 
 // GetAuditContext - Get Audit Context by name
-func (c *Client) GetAuditContext(name string) (*AuditContext, int, error) {
+func (c *Client) GetAuditContext(ctx context.Context, name string) (*AuditContext, int, error) {
 
-	auditCtx, code, err := c.GetResource(fmt.Sprintf("auditctx/%s", name), new(AuditContext))
+	auditCtx, code, err := c.GetResource(ctx, fmt.Sprintf("auditctx/%s", name), new(AuditContext))
 	if err != nil {
 		return nil, code, err
 	}
@@ -21,23 +22,23 @@ func (c *Client) GetAuditContext(name string) (*AuditContext, int, error) {
 }
 
 // CreateAuditContext - Create a new Audit Context
-func (c *Client) CreateAuditContext(auditCtx AuditContext) (*AuditContext, int, error) {
+func (c *Client) CreateAuditContext(ctx context.Context, auditCtx AuditContext) (*AuditContext, int, error) {
 
-	code, err := c.CreateResource("auditctx", *auditCtx.Name, auditCtx)
+	code, err := c.CreateResource(ctx, "auditctx", *auditCtx.Name, auditCtx)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetAuditContext(*auditCtx.Name)
+	return c.GetAuditContext(ctx, *auditCtx.Name)
 }
 
 // UpdateAuditContext - Update an existing Audit Context
-func (c *Client) UpdateAuditContext(auditCtx AuditContext) (*AuditContext, int, error) {
+func (c *Client) UpdateAuditContext(ctx context.Context, auditCtx AuditContext) (*AuditContext, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("auditctx/%s", *auditCtx.Name), auditCtx)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("auditctx/%s", *auditCtx.Name), auditCtx)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetAuditContext(*auditCtx.Name)
+	return c.GetAuditContext(ctx, *auditCtx.Name)
 }
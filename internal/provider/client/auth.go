@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,78 +20,167 @@ type UnixTime int64
 // MinRemaining defines the minimum remaining time before token refresh.
 const MinRemaining UnixTime = 10 * 60
 
+// tokenRefreshURL is the endpoint exchanging a refresh token for a new access token, completed with the API key
+// retrieved from the discovery endpoint.
+var tokenRefreshURL = "https://securetoken.googleapis.com/v1/token?key="
+
 // MakeAuthorizationHeader determines whether the current access token is valid and refreshes it if necessary.
 func (c *Client) MakeAuthorizationHeader() error {
-	// Verify that a refresh token has been provided
-	if c.RefreshToken == "" {
+	// Verify that a refresh token or an OIDC token has been provided
+	if !c.canRefreshToken() {
 		// Return an error if the refresh token is missing
 		return errors.New("empty refresh token")
 	}
 
-	// Parse the JWT token to inspect its claims
-	token, err := jwt.Parse(c.Token, func(token *jwt.Token) (interface{}, error) {
-		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-	})
+	// Guard the token against concurrent refreshes
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
 
-	// Handle parsing errors by initiating a token refresh
-	if err != nil {
-		// Attempt to update the access token using the refresh token
-		err = c.updateAccessToken()
+	// Refresh the token unless it has a sufficient remaining lifespan
+	return c.refreshAccessTokenIfExpiring()
+}
 
-		// Propagate any errors from the token update process
-		if err != nil {
-			return err
+// AccessToken returns the token to authorize requests with, refreshing it first when it is about to expire.
+func (c *Client) AccessToken() (string, error) {
+	// Clients derived for another org share the token of the client they were derived from
+	if c.authOwner != nil {
+		return c.authOwner.AccessToken()
+	}
+
+	// Guard the token against concurrent refreshes
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	// Tokens without a refresh token or an OIDC token cannot be refreshed
+	if !c.canRefreshToken() {
+		return c.Token, nil
+	}
+
+	// Refresh the token when it is about to expire
+	if err := c.refreshAccessTokenIfExpiring(); err != nil {
+		// Fail only when the current token can no longer be used
+		if c.tokenExpiresAt <= UnixNow() {
+			return "", fmt.Errorf("unable to refresh the expired access token. Error: %w", err)
 		}
 
-		// Exit early after successfully refreshing the token
+		// Keep using the still-valid token and try again on the next request
+		log.Printf("Unable to refresh the access token, reusing it until it expires. Error: %s\n", err)
+	}
+
+	// Return the current token
+	return c.Token, nil
+}
+
+// RefreshAccessToken replaces an access token that the API rejected, unless a concurrent request already replaced it.
+func (c *Client) RefreshAccessToken(rejectedToken string) error {
+	// Clients derived for another org share the token of the client they were derived from
+	if c.authOwner != nil {
+		return c.authOwner.RefreshAccessToken(rejectedToken)
+	}
+
+	// Guard the token against concurrent refreshes
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	// Verify that a refresh token or an OIDC token has been provided
+	if !c.canRefreshToken() {
+		return errors.New("empty refresh token")
+	}
+
+	// Reuse the token obtained by a concurrent request
+	if c.Token != rejectedToken {
 		return nil
 	}
 
-	// Extract claims from the parsed token object
-	claims, ok := token.Claims.(jwt.MapClaims)
+	// Log that the rejected token will be refreshed
+	log.Println("Refreshing rejected token")
+
+	// Perform the token refresh operation
+	return c.updateAccessToken()
+}
 
-	// Return an error if the token claims are not in the expected format
-	if !ok {
-		return errors.New("invalid token claims")
+// CurrentToken returns the access token the client currently authorizes requests with, without refreshing it.
+func (c *Client) CurrentToken() string {
+	// Clients derived for another org share the token of the client they were derived from
+	if c.authOwner != nil {
+		return c.authOwner.CurrentToken()
 	}
 
-	// Retrieve the expiration time (in Unix seconds) from the claims
-	exp, ok := claims["exp"].(float64)
+	// Guard the token against concurrent refreshes
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
 
-	// Return an error if the expiration claim is missing or invalid
-	if !ok {
-		return errors.New("invalid expiration time in token")
+	return c.Token
+}
+
+// canRefreshToken reports whether the client is able to obtain a new access token on its own.
+func (c *Client) canRefreshToken() bool {
+	// Clients derived for another org share the credentials of the client they were derived from
+	if c.authOwner != nil {
+		return c.authOwner.canRefreshToken()
 	}
 
-	// Convert the expiration timestamp to UnixTime type
-	expires := UnixTime(exp)
+	return c.RefreshToken != "" || c.Oidc.IsSet()
+}
+
+// refreshAccessTokenIfExpiring refreshes the access token when less than MinRemaining is left before it expires.
+// The caller must hold the token mutex.
+func (c *Client) refreshAccessTokenIfExpiring() error {
+	// Read the expiration time of a token that was provided rather than obtained by the client
+	if c.tokenExpiresAt == 0 && c.Token != "" {
+		if expires, err := ParseTokenExpiry(c.Token); err == nil {
+			c.tokenExpiresAt = expires
+		}
+	}
 
 	// Calculate the time-to-live for the current token
-	ttl := expires - UnixNow()
+	ttl := c.tokenExpiresAt - UnixNow()
 
-	// Check if the token has sufficient remaining lifespan
-	if ttl >= MinRemaining {
-		// Log that the token is still valid
-		log.Printf("Reusing still-valid accessToken. Expiring in %ds.\n", ttl)
-	} else {
-		// Log that the token will be refreshed
-		log.Println("Refreshing token")
+	// Keep the token while it has a sufficient remaining lifespan
+	if c.Token != "" && ttl >= MinRemaining {
+		return nil
+	}
 
-		// Perform the token refresh operation
-		err = c.updateAccessToken()
+	// Log that the token will be refreshed
+	log.Println("Refreshing token")
 
-		// Propagate any errors that occur during refresh
-		if err != nil {
-			return err
-		}
+	// Perform the token refresh operation
+	return c.updateAccessToken()
+}
+
+// ParseTokenExpiry reads the expiration time from the "exp" claim of a JWT access token, with or without the Bearer scheme.
+func ParseTokenExpiry(token string) (UnixTime, error) {
+	// Remove the Bearer scheme, in any case
+	if len(token) >= len(bearerPrefix) && strings.EqualFold(token[:len(bearerPrefix)], bearerPrefix) {
+		token = token[len(bearerPrefix):]
 	}
 
-	// Indicate successful header creation or refresh
-	return nil
+	// Decode the claims without verifying the signature, which only the API is able to do
+	claims := jwt.MapClaims{}
+
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return 0, err
+	}
+
+	// Retrieve the expiration time from the claims
+	exp, err := claims.GetExpirationTime()
+
+	// Return an error if the expiration claim is missing or invalid
+	if err != nil || exp == nil {
+		return 0, errors.New("invalid expiration time in token")
+	}
+
+	// Convert the expiration timestamp to UnixTime type
+	return UnixTime(exp.Unix()), nil
 }
 
-// updateAccessToken uses the refresh token to obtain a new access token from the authentication service.
+// updateAccessToken uses the refresh token, or the external OIDC token, to obtain a new access token.
 func (c *Client) updateAccessToken() error {
+	// Exchange the external OIDC token when the client authenticates with one
+	if c.RefreshToken == "" && c.Oidc.IsSet() {
+		return c.exchangeOidcToken()
+	}
+
 	// Initialize a new HTTP client based on default configuration
 	client := req.C()
 
@@ -120,9 +210,6 @@ func (c *Client) updateAccessToken() error {
 		return err
 	}
 
-	// Initialize the token refresh endpoint with the retrieved API key
-	tokenRefreshURL := "https://securetoken.googleapis.com/v1/token?key="
-
 	// Create the JSON body for the refresh request
 	jsonBody, err := json.Marshal(map[string]string{
 		"refresh_token": c.RefreshToken,
@@ -145,9 +232,10 @@ func (c *Client) updateAccessToken() error {
 		return errors.New("your session has expired. A new refresh token is required")
 	}
 
-	// Define a local structure to capture the new access token
+	// Define a local structure to capture the new access token and its lifespan in seconds
 	var tokenData struct {
 		AccessToken string `json:"access_token"`
+		ExpiresIn   string `json:"expires_in"`
 	}
 
 	// Unmarshal the JSON response into the tokenData structure
@@ -158,9 +246,23 @@ func (c *Client) updateAccessToken() error {
 		return err
 	}
 
+	// Reject responses that carry no token, such as those of a revoked refresh token
+	if tokenData.AccessToken == "" {
+		return errors.New("your session has expired. A new refresh token is required")
+	}
+
 	// Prefix the token with the Bearer scheme and update the client
 	c.Token = "Bearer " + tokenData.AccessToken
 
+	// Track when the new token expires, preferring its own claim over the advertised lifespan
+	if expires, err := ParseTokenExpiry(tokenData.AccessToken); err == nil {
+		c.tokenExpiresAt = expires
+	} else if expiresIn, err := strconv.ParseInt(tokenData.ExpiresIn, 10, 64); err == nil {
+		c.tokenExpiresAt = UnixNow() + UnixTime(expiresIn)
+	} else {
+		c.tokenExpiresAt = 0
+	}
+
 	// Indicate successful token update
 	return nil
 }
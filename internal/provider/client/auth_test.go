@@ -0,0 +1,306 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TestAccessTokenProactiveRefresh verifies the access token is refreshed once less than MinRemaining is left before it
+// expires, and that a failed refresh keeps a token that is still valid.
+func TestAccessTokenProactiveRefresh(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name         string
+		ttl          time.Duration
+		refreshToken string
+		failRefresh  bool
+		wantRefresh  bool
+		wantErr      bool
+	}{
+		{name: "above the minimum lifespan", ttl: time.Duration(MinRemaining)*time.Second + time.Minute, refreshToken: "refresh-token"},
+		{name: "below the minimum lifespan", ttl: time.Duration(MinRemaining)*time.Second - time.Minute, refreshToken: "refresh-token", wantRefresh: true},
+		{name: "expired", ttl: -time.Minute, refreshToken: "refresh-token", wantRefresh: true},
+		{name: "failed refresh of a valid token", ttl: time.Duration(MinRemaining)*time.Second - time.Minute, refreshToken: "refresh-token", failRefresh: true},
+		{name: "failed refresh of an expired token", ttl: -time.Minute, refreshToken: "refresh-token", failRefresh: true, wantErr: true},
+		{name: "without a refresh token", ttl: -time.Minute},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Serve the token refresh endpoints
+			auth := &authTestServer{failRefresh: tc.failRefresh}
+			c := newAuthTestClient(t, auth, newTestToken(t, tc.ttl), tc.refreshToken)
+			initialToken := c.Token
+
+			// Retrieve the access token
+			token, err := c.AccessToken()
+
+			// Verify the outcome
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			// Verify the token was refreshed only when required
+			wantToken := initialToken
+			if tc.wantRefresh {
+				wantToken = auth.lastIssued()
+			}
+			if token != wantToken {
+				t.Fatalf("token = %q, want %q", token, wantToken)
+			}
+
+			// Verify a refresh was attempted only for tokens about to expire that can be refreshed
+			wantAttempts := int32(0)
+			if tc.refreshToken != "" && tc.ttl < time.Duration(MinRemaining)*time.Second {
+				wantAttempts = 1
+			}
+			if got := auth.refreshes.Load(); got != wantAttempts {
+				t.Fatalf("refresh attempts = %d, want %d", got, wantAttempts)
+			}
+		})
+	}
+}
+
+// TestDoRequestRefreshesRejectedToken verifies a request rejected with HTTP 401 refreshes the token and is sent again
+// exactly once, with its full body.
+func TestDoRequestRefreshesRejectedToken(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name          string
+		refreshToken  string
+		rejectAll     bool
+		wantRequests  int32
+		wantRefreshes int32
+		wantErr       bool
+	}{
+		{name: "accepted after refresh", refreshToken: "refresh-token", wantRequests: 2, wantRefreshes: 1},
+		{name: "rejected after refresh", refreshToken: "refresh-token", rejectAll: true, wantRequests: 2, wantRefreshes: 1, wantErr: true},
+		{name: "without a refresh token", wantRequests: 1, wantErr: true},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Serve the token refresh endpoints and an API accepting only the issued tokens
+			auth := &authTestServer{rejectAll: tc.rejectAll}
+			c := newAuthTestClient(t, auth, newTestToken(t, time.Hour), tc.refreshToken)
+
+			// Send a request the API rejects with the initial token
+			body := `{"name":"my-secret"}`
+			_, code, err := c.doRequestWithRetry(context.Background(), http.MethodPost, c.HostURL+"/org/my-org/secret", []byte(body), "application/json")
+
+			// Verify the outcome
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.wantErr && code != http.StatusUnauthorized {
+				t.Fatalf("status = %d, want %d", code, http.StatusUnauthorized)
+			}
+
+			// Verify the request was sent again once, after a single refresh
+			if got := auth.requests.Load(); got != tc.wantRequests {
+				t.Fatalf("requests = %d, want %d", got, tc.wantRequests)
+			}
+			if got := auth.refreshes.Load(); got != tc.wantRefreshes {
+				t.Fatalf("refreshes = %d, want %d", got, tc.wantRefreshes)
+			}
+
+			// Verify every attempt carried the full body
+			for _, received := range auth.receivedBodies() {
+				if received != body {
+					t.Fatalf("request body = %q, want %q", received, body)
+				}
+			}
+		})
+	}
+}
+
+// TestConcurrentRefreshIsDeduplicated verifies that concurrent requests rejected with the same token, including those of
+// clients derived for another org, refresh it only once.
+func TestConcurrentRefreshIsDeduplicated(t *testing.T) {
+	// Serve the token refresh endpoints and an API accepting only the issued tokens
+	auth := &authTestServer{}
+	c := newAuthTestClient(t, auth, newTestToken(t, time.Hour), "refresh-token")
+	rejectedToken := c.Token
+
+	// Send concurrent requests through the client and a client derived for another org
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// Alternate between the clients
+			requestClient := c
+			if i%2 == 1 {
+				requestClient = c.WithOrg("other-org")
+			}
+
+			// Send the request
+			_, _, err := requestClient.doRequestWithRetry(context.Background(), http.MethodGet, fmt.Sprintf("%s/org/%s/gvc", c.HostURL, requestClient.Org), nil, "")
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	// Verify every request succeeded
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Verify the token was refreshed once and is shared by the derived client
+	if got := auth.refreshes.Load(); got != 1 {
+		t.Fatalf("refreshes = %d, want 1", got)
+	}
+	if got := c.WithOrg("other-org").CurrentToken(); got != auth.lastIssued() {
+		t.Fatalf("derived client token = %q, want %q", got, auth.lastIssued())
+	}
+
+	// Verify a late rejection of the replaced token reuses the refreshed one
+	if err := c.RefreshAccessToken(rejectedToken); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := auth.refreshes.Load(); got != 1 {
+		t.Fatalf("refreshes = %d, want 1", got)
+	}
+}
+
+/*** Helpers ***/
+
+// authTestServer fakes the discovery and token refresh endpoints, and an API accepting only the tokens it issued.
+type authTestServer struct {
+	failRefresh bool
+	rejectAll   bool
+	refreshes   atomic.Int32
+	requests    atomic.Int32
+	mu          sync.Mutex
+	issued      []string
+	bodies      []string
+}
+
+// ServeHTTP handles the requests sent to the test server.
+func (s *authTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/discovery":
+		io.WriteString(w, `{"firebase":{"apiKey":"api-key"}}`)
+	case r.Method == http.MethodPost && r.URL.Path == "/token":
+		s.refresh(w, r)
+	default:
+		s.serveAPI(w, r)
+	}
+}
+
+// refresh issues a new access token for the refresh token.
+func (s *authTestServer) refresh(w http.ResponseWriter, r *http.Request) {
+	s.refreshes.Add(1)
+
+	// Decode the refresh request
+	var payload map[string]string
+	json.NewDecoder(r.Body).Decode(&payload)
+
+	// Reject refreshes that are expected to fail or are not authorized
+	if s.failRefresh || r.URL.Query().Get("key") != "api-key" || payload["grant_type"] != "refresh_token" || payload["refresh_token"] != "refresh-token" {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"error":{"message":"TOKEN_EXPIRED"}}`)
+		return
+	}
+
+	// Issue a new token
+	s.mu.Lock()
+	token := fmt.Sprintf("issued-%d", len(s.issued)+1)
+	s.issued = append(s.issued, "Bearer "+token)
+	s.mu.Unlock()
+
+	// Return the token with its lifespan
+	json.NewEncoder(w).Encode(map[string]string{"access_token": token, "expires_in": "3600"})
+}
+
+// serveAPI accepts the requests authorized with an issued token.
+func (s *authTestServer) serveAPI(w http.ResponseWriter, r *http.Request) {
+	s.requests.Add(1)
+
+	// Record the request body
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	if len(body) > 0 {
+		s.bodies = append(s.bodies, string(body))
+	}
+	accepted := false
+	for _, token := range s.issued {
+		accepted = accepted || r.Header.Get("Authorization") == token
+	}
+	s.mu.Unlock()
+
+	// Reject requests authorized with any other token
+	if s.rejectAll || !accepted {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, `{"message":"unauthorized"}`)
+		return
+	}
+
+	io.WriteString(w, `{}`)
+}
+
+// lastIssued returns the last access token issued by the server.
+func (s *authTestServer) lastIssued() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.issued) == 0 {
+		return ""
+	}
+
+	return s.issued[len(s.issued)-1]
+}
+
+// receivedBodies returns the bodies of the API requests received by the server.
+func (s *authTestServer) receivedBodies() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.bodies...)
+}
+
+// newAuthTestClient returns a client authorized with the token, refreshing it against the test server.
+func newAuthTestClient(t *testing.T, auth *authTestServer, token string, refreshToken string) *Client {
+	// Start the test server
+	c := newTestClient(t, auth.ServeHTTP)
+	c.Token = "Bearer " + token
+	c.RefreshToken = refreshToken
+
+	// Refresh tokens against the test server for the duration of the test
+	previous := tokenRefreshURL
+	tokenRefreshURL = c.HostURL + "/token?key="
+	t.Cleanup(func() { tokenRefreshURL = previous })
+
+	return c
+}
+
+// newTestToken returns a JWT signed with a test key that expires after the given duration.
+func newTestToken(t *testing.T, ttl time.Duration) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"exp": time.Now().Add(ttl).Unix()}).SignedString([]byte("test"))
+	if err != nil {
+		t.Fatalf("failed to sign the token: %s", err)
+	}
+
+	return token
+}
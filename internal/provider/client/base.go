@@ -2,16 +2,16 @@ package cpln
 
 // Base - Control Plane Base Struct
 type Base struct {
-	ID   *string `json:"id,omitempty"`
-	Name *string `json:"name,omitempty"`
-	Kind *string `json:"kind,omitempty"`
-	// Version      *int                    `json:"version,omitempty"`
-	Description *string                 `json:"description,omitempty"`
-	Tags        *map[string]interface{} `json:"tags,omitempty"`
-	TagsReplace *map[string]interface{} `json:"$replace/tags,omitempty"`
+	ID           *string                 `json:"id,omitempty"`
+	Name         *string                 `json:"name,omitempty"`
+	Kind         *string                 `json:"kind,omitempty"`
+	Version      *int                    `json:"version,omitempty"`
+	Description  *string                 `json:"description,omitempty"`
+	Tags         *map[string]interface{} `json:"tags,omitempty"`
+	TagsReplace  *map[string]interface{} `json:"$replace/tags,omitempty"`
+	LastModified *string                 `json:"lastModified,omitempty"`
+	Links        *[]Link                 `json:"links,omitempty"`
 	// Created      *string                 `json:"created,omitempty"`
-	// LastModified *string                 `json:"lastModified,omitempty"`
-	Links *[]Link `json:"links,omitempty"`
 }
 
 // Link - Link
@@ -2,13 +2,17 @@ package cpln
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"os/exec"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	constants "github.com/controlplane-com/terraform-provider-cpln/internal/provider/constants"
@@ -30,14 +34,35 @@ type Client struct {
 	HTTPClient      *http.Client
 	Token           string
 	RefreshToken    string
+	Oidc            *OidcConfig
 	ProviderVersion string
+	RetryPolicy     RetryPolicy
+
+	// FailOnExternalChanges turns the warnings about changes made outside of Terraform into errors
+	FailOnExternalChanges bool
+
+	// tokenMutex guards the access token and its expiry while concurrent operations refresh it
+	tokenMutex     sync.Mutex
+	tokenExpiresAt UnixTime
+
+	// authOwner is the client that owns the access token of a client derived with WithOrg
+	authOwner *Client
 }
 
 // NewClient instantiates a new API Client with optional token refresh
-func NewClient(org, host, profile, token, refreshToken *string, providerVersion string) (*Client, error) {
+func NewClient(org, host, profile, token, refreshToken *string, oidc *OidcConfig, providerVersion string) (*Client, error) {
+	// Resolve the profile once when the credentials come from it, so that the host is read from the same profile
+	profileName := ""
+	if profile != nil {
+		profileName = *profile
+	}
+	if *token == "" && *refreshToken == "" && !oidc.IsSet() {
+		profileName = ResolveProfileName(profileName)
+	}
+
 	// If host is nil, attempt to extact the host from the CLI profile, will fall back to default if extraction failed
 	if host == nil {
-		host = ExtractHostFromProfile(profile)
+		host = ExtractHostFromProfile(&profileName)
 	}
 
 	// Initialize the Client struct with HTTP client, host, org, and tokens
@@ -54,6 +79,8 @@ func NewClient(org, host, profile, token, refreshToken *string, providerVersion
 		RefreshToken: *refreshToken,
 		// Set the provider version
 		ProviderVersion: providerVersion,
+		// Retry failed requests with the default policy until the provider overrides it
+		RetryPolicy: DefaultRetryPolicy(),
 	}
 
 	// Check if a refresh token was provided
@@ -66,10 +93,23 @@ func NewClient(org, host, profile, token, refreshToken *string, providerVersion
 			return nil, fmt.Errorf("unable to obtain access token using the refresh token. Error: %s", err)
 		}
 
+		// If no refresh token nor access token, exchange the external OIDC token when one was provided
+	} else if c.Token == "" && oidc.IsSet() {
+		// Authenticate with the OIDC token from now on
+		c.Oidc = oidc
+
+		// Attempt to obtain an access token through the token exchange
+		err := c.MakeAuthorizationHeader()
+
+		// Handle error from the token exchange flow
+		if err != nil {
+			return nil, fmt.Errorf("unable to obtain access token using the OIDC token. Error: %s", err)
+		}
+
 		// If no refresh token but no access token either, extract from CLI profile
 	} else if c.Token == "" {
 		// Invoke profile-based token extraction
-		token, err := c.ExtractTokenFromProfile(*profile)
+		token, sessionRefreshToken, err := c.extractCredentialsFromProfile(profileName)
 
 		// Propagate any extraction errors
 		if err != nil {
@@ -77,13 +117,44 @@ func NewClient(org, host, profile, token, refreshToken *string, providerVersion
 		}
 
 		// Assign the extracted token to the client
-		c.Token = *token
+		c.Token = token
+
+		// Keep the refresh token of a login session so that the access token is refreshed during long applies
+		c.RefreshToken = sessionRefreshToken
 	}
 
 	// Return the configured client instance
 	return &c, nil
 }
 
+// WithOrg returns a client that targets the given org and shares the authentication of this client, or this client
+// itself when the org is empty or already targeted.
+func (c *Client) WithOrg(org string) *Client {
+	// Reuse the client when no other org is requested
+	if c == nil || org == "" || org == c.Org {
+		return c
+	}
+
+	// Resolve the client that owns the access token
+	owner := c
+	if c.authOwner != nil {
+		owner = c.authOwner
+	}
+
+	// Copy the settings of the client, leaving the token to its owner
+	return &Client{
+		HostURL:               c.HostURL,
+		Org:                   org,
+		HTTPClient:            c.HTTPClient,
+		RefreshToken:          c.RefreshToken,
+		Oidc:                  c.Oidc,
+		ProviderVersion:       c.ProviderVersion,
+		RetryPolicy:           c.RetryPolicy,
+		FailOnExternalChanges: c.FailOnExternalChanges,
+		authOwner:             owner,
+	}
+}
+
 // Define a method on Client to execute HTTP requests with optional content type and tokens
 func (c *Client) doRequest(req *http.Request, contentType string, optionalTokens ...string) ([]byte, int, error) {
 	// Provide WSL DNS retrieval tip
@@ -91,15 +162,59 @@ func (c *Client) doRequest(req *http.Request, contentType string, optionalTokens
 	// Include example proxy configuration for debugging
 	// os.Setenv("HTTP_PROXY", "http://172.17.80.1:8888")
 
-	// Default to the client’s token for authorization
-	clientToken := c.Token
-
-	// Override the token if an optional one is provided
+	// Override the client’s token if an optional one is provided
 	if len(optionalTokens) > 0 {
 		// Use the first provided override token
-		clientToken = optionalTokens[0]
+		return c.sendRequest(req, contentType, optionalTokens[0])
+	}
+
+	// Default to the client’s token for authorization, refreshed when it is about to expire
+	clientToken, err := c.AccessToken()
+
+	// Abort when no valid token could be obtained
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Perform the request with the client’s token
+	body, code, err := c.sendRequest(req, contentType, clientToken)
+
+	// Return unless the token was rejected and can be refreshed
+	if code != http.StatusUnauthorized || !c.canRefreshToken() {
+		return body, code, err
 	}
 
+	// Refresh the rejected token, unless a concurrent request already did
+	if refreshErr := c.RefreshAccessToken(clientToken); refreshErr != nil {
+		return body, code, err
+	}
+
+	// Rebuild the request so the retry sends the full body
+	retry := req.Clone(req.Context())
+
+	if req.GetBody != nil {
+		retryBody, bodyErr := req.GetBody()
+
+		// Keep the original failure if the body cannot be replayed
+		if bodyErr != nil {
+			return body, code, err
+		}
+
+		retry.Body = retryBody
+	}
+
+	// Retry the request once with the refreshed token
+	clientToken, tokenErr := c.AccessToken()
+
+	if tokenErr != nil {
+		return body, code, err
+	}
+
+	return c.sendRequest(retry, contentType, clientToken)
+}
+
+// sendRequest executes the HTTP request authorized with the given token.
+func (c *Client) sendRequest(req *http.Request, contentType string, clientToken string) ([]byte, int, error) {
 	// Ensure the token has the proper "Bearer " prefix
 	if len(clientToken) >= len(bearerPrefix) && strings.EqualFold(clientToken[:len(bearerPrefix)], bearerPrefix) {
 		// Token has "bearer " prefix (any case), normalize it to "Bearer "
@@ -117,11 +232,17 @@ func (c *Client) doRequest(req *http.Request, contentType string, optionalTokens
 		req.Header.Set("Content-Type", contentType)
 	}
 
+	// Record the start time to report the request latency
+	start := time.Now()
+
 	// Perform the HTTP request using the client’s HTTPClient
 	res, err := c.HTTPClient.Do(req)
 
 	// Handle errors that occur during the HTTP round trip
 	if err != nil {
+		// Log the failed round trip
+		c.logRequest(req, res, nil, time.Since(start), err)
+
 		// If a response exists, return its status code with the error
 		if res != nil {
 			return nil, res.StatusCode, err
@@ -139,36 +260,37 @@ func (c *Client) doRequest(req *http.Request, contentType string, optionalTokens
 
 	// Handle errors encountered while reading the body
 	if err != nil {
+		c.logRequest(req, res, nil, time.Since(start), err)
 		return nil, res.StatusCode, err
 	}
 
 	// Reject any status codes outside 200, 201, or 202 as errors
 	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusAccepted {
-		// Include both status code and raw body in the error
-		return nil, res.StatusCode, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		// Decode the API error out of the response
+		err = NewAPIError(res, body)
+
+		// Log the rejected request
+		c.logRequest(req, res, body, time.Since(start), err)
+
+		return nil, res.StatusCode, err
 	}
 
+	// Log the successful request
+	c.logRequest(req, res, body, time.Since(start), nil)
+
 	// Return the successful body bytes and status code
 	return body, res.StatusCode, err
 }
 
 // Define a method on Client to perform GET requests and decode JSON into a given resource type
-func (c *Client) Get(link string, resource interface{}) (interface{}, int, error) {
+func (c *Client) Get(ctx context.Context, link string, resource interface{}) (interface{}, int, error) {
 	// Normalize the link by removing a leading slash if present
 	if link[0] == '/' {
 		link = link[1:]
 	}
 
-	// Build a new HTTP GET request for the specified link
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", c.HostURL, link), nil)
-
-	// Return immediately if request creation fails
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Invoke doRequest without a content type to fetch the raw response
-	body, code, err := c.doRequest(req, "")
+	// Fetch the raw response for the specified link, retrying transient failures
+	body, code, err := c.doRequestWithRetry(ctx, http.MethodGet, fmt.Sprintf("%s/%s", c.HostURL, link), nil, "")
 
 	// Propagate any errors from the HTTP request
 	if err != nil {
@@ -191,17 +313,9 @@ func (c *Client) Get(link string, resource interface{}) (interface{}, int, error
 }
 
 // Define a method on Client to fetch a specific resource by ID and decode JSON into the provided type
-func (c *Client) GetResource(id string, resource interface{}) (interface{}, int, error) {
-	// Build a new HTTP GET request for the resource endpoint by ID
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/org/%s/%s", c.HostURL, c.Org, id), nil)
-
-	// Return immediately if request creation fails
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Invoke doRequest without a content type to fetch the raw response
-	body, code, err := c.doRequest(req, "")
+func (c *Client) GetResource(ctx context.Context, id string, resource interface{}) (interface{}, int, error) {
+	// Fetch the raw response for the resource endpoint by ID, retrying transient failures
+	body, code, err := c.doRequestWithRetry(ctx, http.MethodGet, fmt.Sprintf("%s/org/%s/%s", c.HostURL, c.Org, id), nil, "")
 
 	// Propagate any errors from the HTTP request
 	if err != nil {
@@ -223,8 +337,8 @@ func (c *Client) GetResource(id string, resource interface{}) (interface{}, int,
 	return vp.Interface(), code, nil
 }
 
-// CreateResource sends a POST to create a resource with retry logic on 429 errors.
-func (c *Client) CreateResource(resourceType, id string, resource interface{}) (int, error) {
+// CreateResource sends a POST to create a resource, retrying according to the client's retry policy.
+func (c *Client) CreateResource(ctx context.Context, resourceType, id string, resource interface{}) (int, error) {
 	// Tag the resource as created by Terraform
 	c.ForceCreatedByTerraformTag(resource, false)
 
@@ -236,75 +350,15 @@ func (c *Client) CreateResource(resourceType, id string, resource interface{}) (
 		return 0, err
 	}
 
-	// Define how many times we'll retry the request
-	const maxRetries = 5
-
-	// Set the initial backoff delay
-	backoff := 2 * time.Second
-
-	// Track the last error returned by the API
-	var lastErr error
-
-	// Attempt the HTTP request up to maxRetries times
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		// Build a new HTTP POST request for creating the resource
-		req, err := http.NewRequest(
-			http.MethodPost,
-			fmt.Sprintf("%s/org/%s/%s", c.HostURL, c.Org, resourceType),
-			strings.NewReader(string(bodyBytes)),
-		)
-
-		// If request construction fails, abort immediately
-		if err != nil {
-			return 0, err
-		}
-
-		// Execute the HTTP request
-		_, code, err := c.doRequest(req, "application/json")
-
-		// On HTTP 429 Too Many Requests...
-		if err != nil {
-			lastErr = err
-
-			if code == http.StatusTooManyRequests {
-				// If the error message mentions quota, return the original error
-				if strings.Contains(err.Error(), "quota") {
-					return code, err
-				}
-
-				// If we've exhausted all retries, break to report failure
-				if attempt == maxRetries {
-					break
-				}
-
-				// Wait for the current backoff duration
-				time.Sleep(backoff)
-
-				// Double the backoff for the next attempt
-				backoff *= 2
-
-				// Retry the request
-				continue
-			}
-
-			// For other errors, return immediately
-			return code, err
-		}
+	// Execute the HTTP POST request for creating the resource
+	_, code, err := c.doRequestWithRetry(ctx, http.MethodPost, fmt.Sprintf("%s/org/%s/%s", c.HostURL, c.Org, resourceType), bodyBytes, "application/json")
 
-		// On success, return the HTTP status code
-		return code, nil
-	}
-
-	// Report final failure after all retry attempts
-	if lastErr != nil {
-		return 0, fmt.Errorf("create resource %q failed after %d attempts due to HTTP 429: %w", id, maxRetries, lastErr)
-	}
-
-	return 0, fmt.Errorf("create resource %q failed after %d attempts due to HTTP 429", id, maxRetries)
+	// Return the HTTP status code along with any request error
+	return code, err
 }
 
-// CreateResourceAgent sends a POST to create an Agent with retry logic on 429 errors.
-func (c *Client) CreateResourceAgent(resource Agent) (*Agent, int, error) {
+// CreateResourceAgent sends a POST to create an Agent, retrying according to the client's retry policy.
+func (c *Client) CreateResourceAgent(ctx context.Context, resource Agent) (*Agent, int, error) {
 	// Tag the Agent as created by Terraform
 	c.ForceCreatedByTerraformTag(resource, false)
 
@@ -316,82 +370,28 @@ func (c *Client) CreateResourceAgent(resource Agent) (*Agent, int, error) {
 		return nil, 0, err
 	}
 
-	// Define how many times we'll retry the request
-	const maxRetries = 5
-
-	// Set the initial backoff delay
-	backoff := 2 * time.Second
-
-	// Track the last error returned by the API
-	var lastErr error
-
-	// Attempt the HTTP request up to maxRetries times
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		// Build a new HTTP POST request for creating the Agent
-		req, err := http.NewRequest(
-			http.MethodPost,
-			fmt.Sprintf("%s/org/%s/agent", c.HostURL, c.Org),
-			strings.NewReader(string(bodyBytes)),
-		)
-
-		// If request construction fails, abort immediately
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Execute the HTTP request
-		respBody, code, err := c.doRequest(req, "application/json")
-		// On HTTP 429 Too Many Requests...
-		if err != nil {
-			lastErr = err
-
-			if code == http.StatusTooManyRequests {
-				// If the error message mentions quota, return the original error
-				if strings.Contains(err.Error(), "quota") {
-					return nil, code, err
-				}
-
-				// If we've exhausted all retries, break to report failure
-				if attempt == maxRetries {
-					break
-				}
-
-				// Wait for the current backoff duration
-				time.Sleep(backoff)
-
-				// Double the backoff for the next attempt
-				backoff *= 2
-
-				// Retry the request
-				continue
-			}
-
-			// For other errors, return immediately
-			return nil, code, err
-		}
-
-		// Parse the successful response body into an Agent
-		var output Agent
+	// Execute the HTTP POST request for creating the Agent
+	respBody, code, err := c.doRequestWithRetry(ctx, http.MethodPost, fmt.Sprintf("%s/org/%s/agent", c.HostURL, c.Org), bodyBytes, "application/json")
 
-		// Handle JSON unmarshalling errors immediately
-		if err := json.Unmarshal(respBody, &output); err != nil {
-			return nil, code, err
-		}
-
-		// Return the created Agent and HTTP status code
-		return &output, code, nil
+	// Return any request errors
+	if err != nil {
+		return nil, code, err
 	}
 
-	// Report final failure after all retry attempts
-	if lastErr != nil {
-		return nil, 0, fmt.Errorf("create Agent failed after %d attempts due to HTTP 429: %w", maxRetries, lastErr)
+	// Parse the successful response body into an Agent
+	var output Agent
+
+	// Handle JSON unmarshalling errors immediately
+	if err := json.Unmarshal(respBody, &output); err != nil {
+		return nil, code, err
 	}
 
-	return nil, 0, fmt.Errorf("create Agent failed after %d attempts due to HTTP 429", maxRetries)
+	// Return the created Agent and HTTP status code
+	return &output, code, nil
 }
 
-// UpdateResource sends a PATCH to update a resource with retry logic on 429 errors.
-func (c *Client) UpdateResource(id string, resource interface{}) (int, error) {
+// UpdateResource sends a PATCH to update a resource, retrying according to the client's retry policy.
+func (c *Client) UpdateResource(ctx context.Context, id string, resource interface{}) (int, error) {
 	// Tag the resource as updated by Terraform
 	c.ForceCreatedByTerraformTag(resource, true)
 
@@ -403,157 +403,21 @@ func (c *Client) UpdateResource(id string, resource interface{}) (int, error) {
 		return 0, err
 	}
 
-	// Define how many times we'll retry the request
-	const maxRetries = 5
-
-	// Set the initial backoff delay
-	backoff := 2 * time.Second
-
-	// Track the last error returned by the API
-	var lastErr error
-
-	// Attempt the HTTP request up to maxRetries times
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		// Build a new HTTP PATCH request for updating the resource
-		req, err := http.NewRequest(
-			http.MethodPatch,
-			fmt.Sprintf("%s/org/%s/%s", c.HostURL, c.Org, id),
-			strings.NewReader(string(bodyBytes)),
-		)
-
-		// If request construction fails, abort immediately
-		if err != nil {
-			return 0, err
-		}
-
-		// Execute the HTTP request
-		_, code, err := c.doRequest(req, "application/json")
-
-		// On HTTP 429 Too Many Requests...
-		if err != nil {
-			lastErr = err
-
-			if code == http.StatusTooManyRequests {
-				// If the error message mentions quota, return the original error
-				if strings.Contains(err.Error(), "quota") {
-					return code, err
-				}
+	// Execute the HTTP PATCH request for updating the resource
+	_, code, err := c.doRequestWithRetry(ctx, http.MethodPatch, fmt.Sprintf("%s/org/%s/%s", c.HostURL, c.Org, id), bodyBytes, "application/json")
 
-				// If we've exhausted all retries, break to report failure
-				if attempt == maxRetries {
-					break
-				}
-
-				// Wait for the current backoff duration
-				time.Sleep(backoff)
-
-				// Double the backoff for the next attempt
-				backoff *= 2
-
-				// Retry the request
-				continue
-			}
-
-			// For other errors, return immediately
-			return code, err
-		}
-
-		// Return on first successful response
-		return code, nil
-	}
-
-	// Report final failure after all retry attempts
-	if lastErr != nil {
-		return 0, fmt.Errorf("update resource %q failed after %d attempts due to HTTP 429: %w", id, maxRetries, lastErr)
-	}
-
-	return 0, fmt.Errorf("update resource %q failed after %d attempts due to HTTP 429", id, maxRetries)
+	// Return the HTTP status code along with any request error
+	return code, err
 }
 
-// DeleteResource attempts to delete the specified resource by ID with retry logic on 409 and 429 errors.
-func (c *Client) DeleteResource(id string) error {
-	// Define how many times we'll retry the delete
-	const maxRetries = 5
-
-	// Set the initial backoff delay
-	backoff := 2 * time.Second
-
-	// Track the last error returned by the API
-	var lastErr error
-
-	// Attempt the HTTP delete up to maxRetries times
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		// Build a new HTTP DELETE request for the resource
-		req, err := http.NewRequest(
-			http.MethodDelete,
-			fmt.Sprintf("%s/org/%s/%s", c.HostURL, c.Org, id),
-			nil,
-		)
-
-		// If request construction fails, abort immediately
-		if err != nil {
-			return err
-		}
-
-		// Execute the HTTP request
-		_, code, err := c.doRequest(req, "")
-
-		if err != nil {
-			lastErr = err
-
-			// On HTTP 409 Conflict...
-			if code == http.StatusConflict {
-				// If we've exhausted all retries, break to report failure
-				if attempt == maxRetries {
-					break
-				}
-
-				// Wait for the current backoff duration
-				time.Sleep(backoff)
-
-				// Double the backoff for the next attempt
-				backoff *= 2
-
-				// Retry the request
-				continue
-			}
-
-			// On HTTP 429 Too Many Requests...
-			if code == http.StatusTooManyRequests {
-				// If the error message mentions quota, return the original error
-				if strings.Contains(err.Error(), "quota") {
-					return err
-				}
-
-				// If we've exhausted all retries, break to report failure
-				if attempt == maxRetries {
-					break
-				}
-
-				// Wait for the current backoff duration
-				time.Sleep(backoff)
-
-				// Double the backoff for the next attempt
-				backoff *= 2
-
-				// Retry the request
-				continue
-			}
-
-			// Return any other error immediately
-			return err
-		}
-
-		// If delete succeeded, return immediately
-		return nil
-	}
-
-	// Report final failure after all retry attempts
-	if lastErr != nil {
-		return fmt.Errorf("delete resource %q failed after %d attempts: %w", id, maxRetries, lastErr)
-	}
+// DeleteResource deletes the specified resource by ID, retrying according to the client's retry policy and on HTTP
+// 409 conflicts while dependent resources are being removed.
+func (c *Client) DeleteResource(ctx context.Context, id string) error {
+	// Execute the HTTP DELETE request for the resource
+	_, _, err := c.doRequestWithRetry(ctx, http.MethodDelete, fmt.Sprintf("%s/org/%s/%s", c.HostURL, c.Org, id), nil, "", http.StatusConflict)
 
-	return fmt.Errorf("delete resource %q failed after %d attempts", id, maxRetries)
+	// Return any request error
+	return err
 }
 
 // ForceCreatedByTerraformTag Force a tag indicating resource was created by Terraform
@@ -595,8 +459,95 @@ func (c *Client) ForceCreatedByTerraformTag(resource interface{}, isUpdate bool)
 	}
 }
 
-// ExtractTokenFromProfile runs the cpln CLI to fetch the access token for the specified profile
+// ExtractTokenFromProfile reads the access token of the specified profile, refreshing the login session when it has expired
 func (c *Client) ExtractTokenFromProfile(profileName string) (*string, error) {
+	// Read the token out of the profile
+	token, _, err := c.extractCredentialsFromProfile(profileName)
+
+	// Propagate any extraction errors
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a pointer to the token
+	return &token, nil
+}
+
+// extractCredentialsFromProfile reads the access token and the session refresh token of the specified profile from the
+// cpln profiles directory, and only runs the cpln CLI when the profile file is in a format it does not recognize.
+func (c *Client) extractCredentialsFromProfile(profileName string) (string, string, error) {
+	// Read the profile from disk
+	profile, err := ReadProfile(profileName)
+
+	// Let the cpln CLI decode profiles written in a format the provider does not know
+	if errors.Is(err, ErrUnrecognizedProfileFormat) {
+		token, err := c.extractTokenWithCli(profileName)
+
+		// Propagate any CLI errors
+		if err != nil {
+			return "", "", err
+		}
+
+		return *token, "", nil
+	}
+
+	// Report a missing profile with guidance
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", "", fmt.Errorf("unable to obtain access token: the cpln profile '%s' does not exist. Use `cpln login` or `cpln profile create` to create it, or configure the token or refresh_token of the provider", ResolveProfileName(profileName))
+	}
+
+	// Propagate any other read errors
+	if err != nil {
+		return "", "", fmt.Errorf("unable to read the cpln profile '%s'. Error: %w", ResolveProfileName(profileName), err)
+	}
+
+	// Retrieve the stored credentials
+	token := profile.Token()
+	sessionRefreshToken := profile.SessionRefreshToken()
+
+	// Refresh the login session when its access token is missing or about to expire
+	if sessionRefreshToken != "" && (token == "" || isTokenExpiring(token)) {
+		// Resolve the endpoint the session belongs to
+		endpoint := profile.Endpoint()
+		if endpoint == "" {
+			endpoint = c.HostURL
+		}
+
+		// Refresh the session without altering the client, as the profile may not be the one it authenticates with
+		session := &Client{HostURL: endpoint, RefreshToken: sessionRefreshToken}
+
+		if err := session.updateAccessToken(); err != nil {
+			return "", "", fmt.Errorf("unable to refresh the session of the cpln profile '%s'. Use `cpln login` to sign in again. Error: %w", ResolveProfileName(profileName), err)
+		}
+
+		token = session.Token
+	}
+
+	// Handle the case where the profile holds no token
+	if strings.TrimSpace(token) == "" {
+		return "", "", fmt.Errorf("empty access token in the cpln profile '%s'. Use `cpln login` to sign in", ResolveProfileName(profileName))
+	}
+
+	// Return the credentials
+	return token, sessionRefreshToken, nil
+}
+
+// isTokenExpiring reports whether a JWT access token expires within MinRemaining. Tokens without an expiry, such as
+// service account keys, never expire.
+func isTokenExpiring(token string) bool {
+	// Read the expiration time of the token
+	expires, err := ParseTokenExpiry(token)
+
+	// Treat tokens without a readable expiry as long-lived
+	if err != nil {
+		return false
+	}
+
+	return expires-UnixNow() < MinRemaining
+}
+
+// extractTokenWithCli runs the cpln CLI to fetch the access token for the specified profile
+func (c *Client) extractTokenWithCli(profileName string) (*string, error) {
 	// Create the command
 	cmd := exec.Command("cpln", "profile", "token", profileName)
 
@@ -645,15 +596,39 @@ func (c *Client) ExtractTokenFromProfile(profileName string) (*string, error) {
 	return &token, nil
 }
 
-// ExtractHostFromProfile runs the cpln CLI to fetch the endpoint host for the specified profile, fallback to DefaultClientEndpoint
+// ExtractHostFromProfile reads the endpoint host of the specified profile, fallback to DefaultClientEndpoint
 func ExtractHostFromProfile(profileName *string) *string {
 	// Return default endpoint if no profile name provided
 	if profileName == nil || *profileName == "" {
 		return &DefaultClientEndpoint
 	}
 
+	// Read the profile from disk
+	profile, err := ReadProfile(*profileName)
+
+	// Use the endpoint of the profile, or the default one when the profile does not set it
+	if err == nil {
+		// Extract the endpoint property from the request settings
+		if endpoint := profile.Endpoint(); endpoint != "" {
+			return &endpoint
+		}
+
+		return &DefaultClientEndpoint
+	}
+
+	// Let the cpln CLI decode profiles written in a format the provider does not know
+	if errors.Is(err, ErrUnrecognizedProfileFormat) {
+		return extractHostWithCli(*profileName)
+	}
+
+	// Fallback to the default endpoint when the profile cannot be read
+	return &DefaultClientEndpoint
+}
+
+// extractHostWithCli runs the cpln CLI to fetch the endpoint host for the specified profile, fallback to DefaultClientEndpoint
+func extractHostWithCli(profileName string) *string {
 	// Prepare the cpln profile get command to retrieve profile details
-	cmd := exec.Command("cpln", "profile", "get", *profileName, "-o", "json")
+	cmd := exec.Command("cpln", "profile", "get", profileName, "-o", "json")
 
 	// Initialize buffers to capture stdout and stderr
 	var stdout, stderr bytes.Buffer
@@ -0,0 +1,24 @@
+package cpln
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client for the org "my-org" sending its requests to a test server served by the given handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	// Start the test server and stop it once the test is over
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	// Retry quickly so that the tests exercising retries stay fast
+	return &Client{
+		HostURL:     server.URL,
+		Org:         "my-org",
+		HTTPClient:  server.Client(),
+		Token:       "token",
+		RetryPolicy: RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+}
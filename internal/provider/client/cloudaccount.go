@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -27,9 +28,9 @@ type CloudAccountStatus struct {
 }
 
 // GetCloudAccount - Get CloudAccount by name
-func (c *Client) GetCloudAccount(name string) (*CloudAccount, int, error) {
+func (c *Client) GetCloudAccount(ctx context.Context, name string) (*CloudAccount, int, error) {
 
-	cloudAccount, code, err := c.GetResource(fmt.Sprintf("cloudaccount/%s", name), new(CloudAccount))
+	cloudAccount, code, err := c.GetResource(ctx, fmt.Sprintf("cloudaccount/%s", name), new(CloudAccount))
 
 	if err != nil {
 		return nil, code, err
@@ -39,28 +40,28 @@ func (c *Client) GetCloudAccount(name string) (*CloudAccount, int, error) {
 }
 
 // CreateCloudAccount - Create an CloudAccount
-func (c *Client) CreateCloudAccount(cloudaccount CloudAccount) (*CloudAccount, int, error) {
+func (c *Client) CreateCloudAccount(ctx context.Context, cloudaccount CloudAccount) (*CloudAccount, int, error) {
 
-	code, err := c.CreateResource("cloudaccount", *cloudaccount.Name, cloudaccount)
+	code, err := c.CreateResource(ctx, "cloudaccount", *cloudaccount.Name, cloudaccount)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetCloudAccount(*cloudaccount.Name)
+	return c.GetCloudAccount(ctx, *cloudaccount.Name)
 }
 
 // UpdateCloudAccount - Update an CloudAccount
-func (c *Client) UpdateCloudAccount(cloudaccount CloudAccount) (*CloudAccount, int, error) {
+func (c *Client) UpdateCloudAccount(ctx context.Context, cloudaccount CloudAccount) (*CloudAccount, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("cloudaccount/%s", *cloudaccount.Name), cloudaccount)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("cloudaccount/%s", *cloudaccount.Name), cloudaccount)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetCloudAccount(*cloudaccount.Name)
+	return c.GetCloudAccount(ctx, *cloudaccount.Name)
 }
 
 // DeleteCloudAccount - Delete CloudAccount by name
-func (c *Client) DeleteCloudAccount(name string) error {
-	return c.DeleteResource(fmt.Sprintf("cloudaccount/%s", name))
+func (c *Client) DeleteCloudAccount(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("cloudaccount/%s", name))
 }
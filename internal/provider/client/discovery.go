@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -13,17 +14,9 @@ type Discovery struct {
 }
 
 // GetDiscovery fetches discovery information and returns the parsed object, HTTP status code, and any error.
-func (c *Client) GetDiscovery() (*Discovery, int, error) {
-	// Build HTTP GET request for the discovery endpoint
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/discovery", c.HostURL), nil)
-
-	// Return early if request creation fails
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Perform the HTTP request and capture response body, status code, and any error
-	body, code, err := c.doRequest(req, "")
+func (c *Client) GetDiscovery(ctx context.Context) (*Discovery, int, error) {
+	// Fetch the discovery endpoint, retrying transient failures
+	body, code, err := c.doRequestWithRetry(ctx, http.MethodGet, fmt.Sprintf("%s/discovery", c.HostURL), nil, "")
 
 	// propagate errors from the request execution
 	if err != nil {
@@ -46,9 +39,9 @@ func (c *Client) GetDiscovery() (*Discovery, int, error) {
 }
 
 // GetBillingNgEndpoint retrieves the billing-ng service endpoint from discovery.
-func (c *Client) GetBillingNgEndpoint() (string, int, error) {
+func (c *Client) GetBillingNgEndpoint(ctx context.Context) (string, int, error) {
 	// Call GetDiscovery to obtain the discovery information
-	discovery, code, err := c.GetDiscovery()
+	discovery, code, err := c.GetDiscovery(ctx)
 
 	// Propagate errors from discovery retrieval
 	if err != nil {
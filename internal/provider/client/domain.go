@@ -1,10 +1,10 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
-	"time"
 )
 
 const MAX_ATTEMPTS = 10
@@ -124,9 +124,9 @@ type DomainStatusDnsConfigRecord struct {
 }
 
 // GetDomain - Get Domain by name
-func (c *Client) GetDomain(name string) (*Domain, int, error) {
+func (c *Client) GetDomain(ctx context.Context, name string) (*Domain, int, error) {
 
-	domain, code, err := c.GetResource(fmt.Sprintf("domain/%s", name), new(Domain))
+	domain, code, err := c.GetResource(ctx, fmt.Sprintf("domain/%s", name), new(Domain))
 
 	if err != nil {
 		return nil, code, err
@@ -136,57 +136,56 @@ func (c *Client) GetDomain(name string) (*Domain, int, error) {
 }
 
 // CreateDomain - Create a new Domain
-func (c *Client) CreateDomain(domain Domain) (*Domain, int, error) {
+func (c *Client) CreateDomain(ctx context.Context, domain Domain) (*Domain, int, error) {
 
-	code, err := c.CreateResource("domain", *domain.Name, domain)
+	code, err := c.CreateResource(ctx, "domain", *domain.Name, domain)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetDomain(*domain.Name)
+	return c.GetDomain(ctx, *domain.Name)
 }
 
 // UpdateDomain - Update an existing domain
-func (c *Client) UpdateDomain(domain Domain) (*Domain, int, error) {
+func (c *Client) UpdateDomain(ctx context.Context, domain Domain) (*Domain, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("domain/%s", *domain.Name), domain)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("domain/%s", *domain.Name), domain)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetDomain(*domain.Name)
+	return c.GetDomain(ctx, *domain.Name)
 }
 
 // DeleteDomain - Delete domain by name
-func (c *Client) DeleteDomain(name string) error {
-	return c.DeleteResource(fmt.Sprintf("domain/%s", name))
+func (c *Client) DeleteDomain(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("domain/%s", name))
 }
 
 /*** Domain Route ***/
-func (c *Client) AddDomainRoute(domainName string, domainPort int, route DomainRoute) (*DomainRoute, int, error) {
+func (c *Client) AddDomainRoute(ctx context.Context, domainName string, domainPort int, route DomainRoute) (*DomainRoute, int, error) {
 
-	const maxRetries = 5
-	backoff := 2 * time.Second
-	var lastErr error
+	portFound := false
 
-	for attempt := 1; attempt <= maxRetries; attempt++ {
+	// Append the route to the latest version of the domain, retrying on HTTP 409 conflicts with concurrent changes
+	_, err := c.Retry(ctx, http.MethodPatch, func() (int, error) {
 
-		domain, _, err := c.GetDomain(domainName)
+		domain, _, err := c.GetDomain(ctx, domainName)
 
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 
 		if domain.Spec.Ports == nil || len(*domain.Spec.Ports) == 0 {
-			return nil, 0, fmt.Errorf("domain is not configured correctly, ports are not set")
+			return 0, fmt.Errorf("domain is not configured correctly, ports are not set")
 		}
 
-		shouldRetry := false
-
 		for index, value := range *domain.Spec.Ports {
 
 			if *value.Number == domainPort {
 
+				portFound = true
+
 				// Append a new route
 				if (*domain.Spec.Ports)[index].Routes == nil {
 					(*domain.Spec.Ports)[index].Routes = &[]DomainRoute{}
@@ -201,49 +200,42 @@ func (c *Client) AddDomainRoute(domainName string, domainPort int, route DomainR
 				domain.SpecReplace = DeepCopy(domain.Spec).(*DomainSpec)
 				domain.Spec = nil
 				domain.Status = nil
+				domain.Version = nil
+				domain.LastModified = nil
 
 				// Update resource
-				code, err := c.UpdateResource(fmt.Sprintf("domain/%s", *domain.Name), domain)
-
-				if err != nil {
-					if code == http.StatusConflict && attempt < maxRetries {
-						lastErr = err
-						time.Sleep(backoff)
-						backoff *= 2
-						shouldRetry = true
-						break
-					}
-					return nil, 0, err
-				}
-
-				// If we got here then route has been added successfully
-				return c.GetDomainRoute(domainName, domainPort, route.Prefix, route.Regex)
+				return c.UpdateResource(ctx, fmt.Sprintf("domain/%s", *domain.Name), domain)
 			}
 		}
 
-		if shouldRetry {
-			continue
-		}
+		return 0, nil
+	}, http.StatusConflict)
 
-		// Port not found, return an error
-		routeIdentifier := ""
+	if err != nil {
+		return nil, 0, err
+	}
 
-		if route.Prefix != nil {
-			routeIdentifier = fmt.Sprintf("with prefix '%s'", *route.Prefix)
-		}
+	if portFound {
+		// If we got here then route has been added successfully
+		return c.GetDomainRoute(ctx, domainName, domainPort, route.Prefix, route.Regex)
+	}
 
-		if route.Regex != nil {
-			routeIdentifier = fmt.Sprintf("with regex '%s'", *route.Regex)
-		}
+	// Port not found, return an error
+	routeIdentifier := ""
 
-		return nil, 0, fmt.Errorf("unable to add route %s for a domain named '%s'. Port '%d' is not set", routeIdentifier, domainName, domainPort)
+	if route.Prefix != nil {
+		routeIdentifier = fmt.Sprintf("with prefix '%s'", *route.Prefix)
 	}
 
-	return nil, 0, fmt.Errorf("add domain route failed after %d attempts due to HTTP 409: %w", maxRetries, lastErr)
+	if route.Regex != nil {
+		routeIdentifier = fmt.Sprintf("with regex '%s'", *route.Regex)
+	}
+
+	return nil, 0, fmt.Errorf("unable to add route %s for a domain named '%s'. Port '%d' is not set", routeIdentifier, domainName, domainPort)
 }
 
-func (c *Client) GetDomainRoute(domainName string, domainPort int, prefix *string, regex *string) (*DomainRoute, int, error) {
-	domain, code, err := c.GetDomain(domainName)
+func (c *Client) GetDomainRoute(ctx context.Context, domainName string, domainPort int, prefix *string, regex *string) (*DomainRoute, int, error) {
+	domain, code, err := c.GetDomain(ctx, domainName)
 
 	if err != nil {
 		return nil, code, err
@@ -263,26 +255,23 @@ func (c *Client) GetDomainRoute(domainName string, domainPort int, prefix *strin
 	return nil, code, err
 }
 
-func (c *Client) UpdateDomainRoute(domainName string, domainPort int, route *DomainRoute) (*DomainRoute, int, error) {
+func (c *Client) UpdateDomainRoute(ctx context.Context, domainName string, domainPort int, route *DomainRoute) (*DomainRoute, int, error) {
 
-	const maxRetries = 5
-	backoff := 2 * time.Second
-	var lastErr error
+	routeFound := false
 
-	for attempt := 1; attempt <= maxRetries; attempt++ {
+	// Modify the route on the latest version of the domain, retrying on HTTP 409 conflicts with concurrent changes
+	_, err := c.Retry(ctx, http.MethodPatch, func() (int, error) {
 
-		domain, _, err := c.GetDomain(domainName)
+		domain, _, err := c.GetDomain(ctx, domainName)
 
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 
 		if domain.Spec.Ports == nil || len(*domain.Spec.Ports) == 0 {
-			return nil, 0, fmt.Errorf("Domain is not configured correctly, ports are not set")
+			return 0, fmt.Errorf("Domain is not configured correctly, ports are not set")
 		}
 
-		shouldRetry := false
-
 		for pIndex, value := range *domain.Spec.Ports {
 
 			if *value.Number == domainPort && (value.Routes != nil && len(*value.Routes) > 0) {
@@ -292,6 +281,8 @@ func (c *Client) UpdateDomainRoute(domainName string, domainPort int, route *Dom
 					if (_route.Prefix != nil && route.Prefix != nil && *_route.Prefix == *route.Prefix) ||
 						(_route.Regex != nil && route.Regex != nil && *_route.Regex == *route.Regex) {
 
+						routeFound = true
+
 						// Modify existing route
 						(*(*domain.Spec.Ports)[pIndex].Routes)[rIndex].ReplacePrefix = route.ReplacePrefix
 						(*(*domain.Spec.Ports)[pIndex].Routes)[rIndex].WorkloadLink = route.WorkloadLink
@@ -313,71 +304,58 @@ func (c *Client) UpdateDomainRoute(domainName string, domainPort int, route *Dom
 						domain.SpecReplace = DeepCopy(domain.Spec).(*DomainSpec)
 						domain.Spec = nil
 						domain.Status = nil
+						domain.Version = nil
+						domain.LastModified = nil
 
-						code, err := c.UpdateResource(fmt.Sprintf("domain/%s", *domain.Name), domain)
-
-						if err != nil {
-							if code == http.StatusConflict && attempt < maxRetries {
-								lastErr = err
-								time.Sleep(backoff)
-								backoff *= 2
-								shouldRetry = true
-								break
-							}
-							return nil, 0, err
-						}
-
-						// If we got here, then the route has been updated successfully
-						return c.GetDomainRoute(domainName, domainPort, route.Prefix, route.Regex)
+						return c.UpdateResource(ctx, fmt.Sprintf("domain/%s", *domain.Name), domain)
 					}
 				}
 			}
-
-			if shouldRetry {
-				break
-			}
 		}
 
-		if shouldRetry {
-			continue
-		}
+		return 0, nil
+	}, http.StatusConflict)
 
-		// Route not found, return an error
-		routeIdentifier := ""
+	if err != nil {
+		return nil, 0, err
+	}
 
-		if route.Prefix != nil {
-			routeIdentifier = fmt.Sprintf("with prefix '%s'", *route.Prefix)
-		}
+	if routeFound {
+		// If we got here, then the route has been updated successfully
+		return c.GetDomainRoute(ctx, domainName, domainPort, route.Prefix, route.Regex)
+	}
 
-		if route.Regex != nil {
-			routeIdentifier = fmt.Sprintf("with regex '%s'", *route.Regex)
-		}
+	// Route not found, return an error
+	routeIdentifier := ""
 
-		return nil, 0, fmt.Errorf("unable to update route %s for a domain named '%s'. Port '%d' is not set", routeIdentifier, domainName, domainPort)
+	if route.Prefix != nil {
+		routeIdentifier = fmt.Sprintf("with prefix '%s'", *route.Prefix)
 	}
 
-	return nil, 0, fmt.Errorf("update domain route failed after %d attempts due to HTTP 409: %w", maxRetries, lastErr)
+	if route.Regex != nil {
+		routeIdentifier = fmt.Sprintf("with regex '%s'", *route.Regex)
+	}
+
+	return nil, 0, fmt.Errorf("unable to update route %s for a domain named '%s'. Port '%d' is not set", routeIdentifier, domainName, domainPort)
 }
 
-func (c *Client) RemoveDomainRoute(domainName string, domainPort int, prefix *string, regex *string) error {
+func (c *Client) RemoveDomainRoute(ctx context.Context, domainName string, domainPort int, prefix *string, regex *string) error {
 
-	const maxRetries = 5
-	backoff := 2 * time.Second
-	var lastErr error
+	routeFound := false
 
-	for attempt := 1; attempt <= maxRetries; attempt++ {
+	// Remove the route from the latest version of the domain, retrying on HTTP 409 conflicts with concurrent changes
+	_, err := c.Retry(ctx, http.MethodPatch, func() (int, error) {
 
-		domain, _, err := c.GetDomain(domainName)
+		domain, _, err := c.GetDomain(ctx, domainName)
 
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		if domain.Spec.Ports == nil || len(*domain.Spec.Ports) == 0 {
-			return fmt.Errorf("domain is not configured correctly, ports are not set")
+			return 0, fmt.Errorf("domain is not configured correctly, ports are not set")
 		}
 
-		shouldRetry := false
 		routeIndex := -1
 
 		for pIndex, value := range *domain.Spec.Ports {
@@ -395,6 +373,8 @@ func (c *Client) RemoveDomainRoute(domainName string, domainPort int, prefix *st
 
 				if routeIndex != -1 {
 
+					routeFound = true
+
 					// Remove route at index routeIndex
 					*(*domain.Spec.Ports)[pIndex].Routes = append((*(*domain.Spec.Ports)[pIndex].Routes)[:routeIndex], (*(*domain.Spec.Ports)[pIndex].Routes)[routeIndex+1:]...)
 
@@ -402,44 +382,33 @@ func (c *Client) RemoveDomainRoute(domainName string, domainPort int, prefix *st
 					domain.SpecReplace = DeepCopy(domain.Spec).(*DomainSpec)
 					domain.Spec = nil
 					domain.Status = nil
+					domain.Version = nil
+					domain.LastModified = nil
 
-					code, err := c.UpdateResource(fmt.Sprintf("domain/%s", *domain.Name), domain)
-
-					if err != nil {
-						if code == http.StatusConflict && attempt < maxRetries {
-							lastErr = err
-							time.Sleep(backoff)
-							backoff *= 2
-							shouldRetry = true
-							break
-						}
-						return err
-					}
-
-					return nil
+					return c.UpdateResource(ctx, fmt.Sprintf("domain/%s", *domain.Name), domain)
 				}
 			}
 		}
 
-		if shouldRetry {
-			continue
-		}
+		return 0, nil
+	}, http.StatusConflict)
 
-		// Route not found, return an error
-		routeIdentifier := ""
+	if err != nil || routeFound {
+		return err
+	}
 
-		if prefix != nil {
-			routeIdentifier = fmt.Sprintf("with prefix '%s'", *prefix)
-		}
+	// Route not found, return an error
+	routeIdentifier := ""
 
-		if regex != nil {
-			routeIdentifier = fmt.Sprintf("with regex '%s'", *regex)
-		}
+	if prefix != nil {
+		routeIdentifier = fmt.Sprintf("with prefix '%s'", *prefix)
+	}
 
-		return fmt.Errorf("unable to delete route %s for a domain named '%s'. Route not found at port %d", routeIdentifier, domainName, domainPort)
+	if regex != nil {
+		routeIdentifier = fmt.Sprintf("with regex '%s'", *regex)
 	}
 
-	return fmt.Errorf("remove domain route failed after %d attempts due to HTTP 409: %w", maxRetries, lastErr)
+	return fmt.Errorf("unable to delete route %s for a domain named '%s'. Route not found at port %d", routeIdentifier, domainName, domainPort)
 }
 
 func DeepCopy(source interface{}) interface{} {
@@ -0,0 +1,170 @@
+package cpln
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIError is returned for Control Plane API responses with an unexpected HTTP status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Code is the machine-readable error code reported by the API, if any
+	Code string
+	// Message is the human-readable error message reported by the API, if any
+	Message string
+	// Details holds additional error information reported by the API, such as validation failures
+	Details interface{}
+	// RequestID is the correlation ID of the request, if the API reported one
+	RequestID string
+	// Body is the raw response body
+	Body []byte
+	// RetryAfter is the delay requested by the server through the Retry-After header, if any
+	RetryAfter time.Duration
+}
+
+// FieldError describes a validation failure reported by the API for a single field.
+type FieldError struct {
+	// Path is the dotted path of the offending field in the API payload (i.e. spec.defaultOptions.capacityAI)
+	Path string
+	// Message describes why the field was rejected
+	Message string
+}
+
+// apiErrorBody mirrors the JSON error document returned by the Control Plane API.
+type apiErrorBody struct {
+	Status  int         `json:"status,omitempty"`
+	Code    string      `json:"code,omitempty"`
+	Message string      `json:"message,omitempty"`
+	Details interface{} `json:"details,omitempty"`
+	ID      string      `json:"id,omitempty"`
+}
+
+// NewAPIError builds an APIError from an HTTP response and its body, decoding the Control Plane error document when
+// the body contains one.
+func NewAPIError(res *http.Response, body []byte) *APIError {
+	// Start with the information available on every response
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Body:       body,
+		RequestID:  res.Header.Get(requestIDHeader),
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
+	}
+
+	// Decode the error document, ignoring bodies that are not JSON
+	var doc apiErrorBody
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return apiErr
+	}
+
+	// Copy the decoded fields
+	apiErr.Code = doc.Code
+	apiErr.Message = doc.Message
+	apiErr.Details = doc.Details
+
+	// Prefer the header, fall back to the ID reported in the body
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = doc.ID
+	}
+
+	return apiErr
+}
+
+// Error formats the status code along with the response body, or the message for errors without a body.
+func (e *APIError) Error() string {
+	// Errors raised without a response only carry a message
+	if len(e.Body) == 0 && e.Message != "" {
+		return fmt.Sprintf("status: %d, message: %s", e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// FieldErrors extracts the per-field validation failures from the error details. Both a list of failures and an
+// object wrapping such a list under an "errors" key are understood.
+func (e *APIError) FieldErrors() []FieldError {
+	// Unwrap an object holding the list of failures
+	details := e.Details
+	if m, ok := details.(map[string]interface{}); ok {
+		details = m["errors"]
+	}
+
+	// Expect a list of failures
+	items, ok := details.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+
+	for _, item := range items {
+		// Skip entries that are not objects
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// Read the message of the failure
+		message, _ := m["message"].(string)
+
+		// Read the path, given either as a dotted string or as a list of segments
+		var path string
+		switch p := m["path"].(type) {
+		case string:
+			path = p
+		case []interface{}:
+			segments := make([]string, 0, len(p))
+			for _, segment := range p {
+				segments = append(segments, fmt.Sprint(segment))
+			}
+			path = strings.Join(segments, ".")
+		}
+
+		// Skip failures that do not point at a field
+		if path == "" {
+			continue
+		}
+
+		fieldErrors = append(fieldErrors, FieldError{Path: path, Message: message})
+	}
+
+	return fieldErrors
+}
+
+// AsAPIError returns the APIError wrapped by err, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+
+	return nil, false
+}
+
+// IsNotFound reports whether err is an API error for a resource that does not exist.
+func IsNotFound(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsConflict reports whether err is an API error for a request that conflicts with the current state of a resource.
+func IsConflict(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusConflict
+}
+
+// IsQuotaExceeded reports whether err is an API error caused by an exceeded org quota.
+func IsQuotaExceeded(err error) bool {
+	// Only API errors carry quota information
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+
+	// Match the quota error anywhere in the response, since it may be reported through the code or the message
+	return strings.Contains(strings.ToLower(string(apiErr.Body)), "quota")
+}
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -19,9 +20,9 @@ type GroupIdentityMatcher struct {
 }
 
 // GetGroup - Get Group by name
-func (c *Client) GetGroup(name string) (*Group, int, error) {
+func (c *Client) GetGroup(ctx context.Context, name string) (*Group, int, error) {
 
-	group, code, err := c.GetResource(fmt.Sprintf("group/%s", name), new(Group))
+	group, code, err := c.GetResource(ctx, fmt.Sprintf("group/%s", name), new(Group))
 
 	if err != nil {
 		return nil, code, err
@@ -31,28 +32,28 @@ func (c *Client) GetGroup(name string) (*Group, int, error) {
 }
 
 // CreateGroup - Create a new Group
-func (c *Client) CreateGroup(group Group) (*Group, int, error) {
+func (c *Client) CreateGroup(ctx context.Context, group Group) (*Group, int, error) {
 
-	code, err := c.CreateResource("group", *group.Name, group)
+	code, err := c.CreateResource(ctx, "group", *group.Name, group)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetGroup(*group.Name)
+	return c.GetGroup(ctx, *group.Name)
 }
 
 // UpdateGroup - Update an existing Group
-func (c *Client) UpdateGroup(group Group) (*Group, int, error) {
+func (c *Client) UpdateGroup(ctx context.Context, group Group) (*Group, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("group/%s", *group.Name), group)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("group/%s", *group.Name), group)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetGroup(*group.Name)
+	return c.GetGroup(ctx, *group.Name)
 }
 
 // DeleteGroup - Delete Group by name
-func (c *Client) DeleteGroup(name string) error {
-	return c.DeleteResource(fmt.Sprintf("group/%s", name))
+func (c *Client) DeleteGroup(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("group/%s", name))
 }
@@ -1,18 +1,12 @@
 package cpln
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
 )
 
 // Gvcs - GVC's
-type Gvcs struct {
-	Kind  string `json:"kind,omitempty"`
-	Items []Gvc  `json:"items,omitempty"`
-	Links []Link `json:"links,omitempty"`
-	Query Query  `json:"query,omitempty"`
-}
+type Gvcs = QueryResult[Gvc]
 
 // Gvc - Global Virtual Cloud
 type Gvc struct {
@@ -85,34 +79,21 @@ type GvcKeda struct {
 }
 
 // GetGvcs - Get All Gvcs
-func (c *Client) GetGvcs() (*Gvcs, error) {
-
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/org/%s/gvc/-query", c.HostURL, c.Org), nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	body, _, err := c.doRequest(req, "")
-
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) GetGvcs(ctx context.Context) (*Gvcs, error) {
 
-	gvcs := Gvcs{}
-	err = json.Unmarshal(body, &gvcs)
+	gvcs, _, err := QueryKind[Gvc](ctx, c, "gvc", NewQuery("gvc", QueryMatchAll))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &gvcs, nil
+	return gvcs, nil
 }
 
 // GetGvc - Get GVC by name
-func (c *Client) GetGvc(name string) (*Gvc, int, error) {
+func (c *Client) GetGvc(ctx context.Context, name string) (*Gvc, int, error) {
 
-	gvc, code, err := c.GetResource(fmt.Sprintf("gvc/%s", name), new(Gvc))
+	gvc, code, err := c.GetResource(ctx, fmt.Sprintf("gvc/%s", name), new(Gvc))
 
 	if err != nil {
 		return nil, code, err
@@ -122,28 +103,28 @@ func (c *Client) GetGvc(name string) (*Gvc, int, error) {
 }
 
 // CreateGvc - Create a new GVC
-func (c *Client) CreateGvc(gvc Gvc) (*Gvc, int, error) {
+func (c *Client) CreateGvc(ctx context.Context, gvc Gvc) (*Gvc, int, error) {
 
-	code, err := c.CreateResource("gvc", *gvc.Name, gvc)
+	code, err := c.CreateResource(ctx, "gvc", *gvc.Name, gvc)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetGvc(*gvc.Name)
+	return c.GetGvc(ctx, *gvc.Name)
 }
 
 // UpdateGvc - Update an existing GVC
-func (c *Client) UpdateGvc(gvc Gvc) (*Gvc, int, error) {
+func (c *Client) UpdateGvc(ctx context.Context, gvc Gvc) (*Gvc, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("gvc/%s", *gvc.Name), gvc)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("gvc/%s", *gvc.Name), gvc)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetGvc(*gvc.Name)
+	return c.GetGvc(ctx, *gvc.Name)
 }
 
 // DeleteGvc - Delete GVC by name
-func (c *Client) DeleteGvc(name string) error {
-	return c.DeleteResource(fmt.Sprintf("gvc/%s", name))
+func (c *Client) DeleteGvc(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("gvc/%s", name))
 }
@@ -1,13 +1,38 @@
 package cpln
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/downloader"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/strvals"
+	"sigs.k8s.io/yaml"
 )
 
-// HelmCommonConfig holds all common configuration for building helm CLI arguments.
+// helmNotesFileSuffix is the suffix of the template holding the release notes.
+const helmNotesFileSuffix = "NOTES.txt"
+
+// HelmCommonConfig holds all common configuration for rendering a helm chart.
 // Shared between cpln_helm_release (resource) and cpln_helm_template (data source).
 type HelmCommonConfig struct {
 	Gvc                   types.String
@@ -42,21 +67,6 @@ type HelmReleaseState struct {
 	Resources map[string]string
 }
 
-// HelmGetAllResponse represents the JSON output from cpln helm get all <name> -o json.
-type HelmGetAllResponse struct {
-	Name     string         `json:"name"`
-	Version  int            `json:"version"`
-	Manifest string         `json:"manifest"`
-	Gvc      string         `json:"gvc"`
-	Info     HelmGetAllInfo `json:"info"`
-}
-
-// HelmGetAllInfo represents the info section of the helm get all response.
-type HelmGetAllInfo struct {
-	Status      string `json:"status"`
-	Description string `json:"description"`
-}
-
 // HelmManifestResource represents a top-level resource in the helm manifest YAML output.
 type HelmManifestResource struct {
 	Kind string `yaml:"kind"`
@@ -64,178 +74,542 @@ type HelmManifestResource struct {
 	Gvc  string `yaml:"gvc"`
 }
 
-// BuildHelmArgs adds common arguments for helm commands (install, upgrade, template).
-func (c *Client) BuildHelmArgs(args []string, cfg HelmCommonConfig) ([]string, []string, error) {
-	var tempFilePaths []string
+// HelmChartRender holds the output of rendering a chart for a release.
+type HelmChartRender struct {
+	Chart       *chart.Chart
+	Config      map[string]interface{}
+	ValuesFiles []string
+	Manifest    string
+	Notes       string
+}
 
-	// Context options
-	args = c.AppendCplnContextArgs(args, cfg.Gvc.ValueString())
+// RenderHelmChart loads a chart and renders its templates in-process for the given release name and revision. The
+// context cancels the post-renderer and stops the rendering between the download steps.
+func (c *Client) RenderHelmChart(ctx context.Context, releaseName string, chartRef string, cfg HelmCommonConfig, revision int, isUpgrade bool) (*HelmChartRender, error) {
+	// Read the helm settings (repositories, cache and registry configuration) from the environment
+	settings := cli.New()
 
-	// Chart resolution
-	if !cfg.Repository.IsNull() && cfg.Repository.ValueString() != "" {
-		args = append(args, "--repo", cfg.Repository.ValueString())
+	// Resolve and load the chart, including its dependencies
+	chrt, err := loadHelmChart(ctx, chartRef, cfg, settings)
+	if err != nil {
+		return nil, err
 	}
 
-	if !cfg.Version.IsNull() && cfg.Version.ValueString() != "" {
-		args = append(args, "--version", cfg.Version.ValueString())
+	// Stop when the operation was cancelled while the chart was downloaded
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
-	// Values - each entry written to a temp file and passed via --values
-	if !cfg.Values.IsNull() && len(cfg.Values.Elements()) > 0 {
-		for i, elem := range cfg.Values.Elements() {
-			strVal, ok := elem.(types.String)
-			if !ok || strVal.IsNull() || strVal.ValueString() == "" {
-				continue
-			}
+	// Merge the values files and the individual overrides
+	config, valuesFiles, err := buildHelmValues(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Inject the org and GVC the chart is rendered for, overriding any user-defined values
+	config["cpln"] = map[string]interface{}{
+		"org": c.Org,
+		"gvc": cfg.Gvc.ValueString(),
+	}
+
+	// Enable or disable the subcharts according to their conditions and tags, and import their values
+	if err := chartutil.ProcessDependenciesWithMerge(chrt, config); err != nil {
+		return nil, err
+	}
+
+	// Build the values passed to the templates, the GVC plays the role of the namespace
+	renderValues, err := chartutil.ToRenderValues(chrt, config, chartutil.ReleaseOptions{
+		Name:      releaseName,
+		Namespace: cfg.Gvc.ValueString(),
+		Revision:  revision,
+		IsInstall: !isUpgrade,
+		IsUpgrade: isUpgrade,
+	}, chartutil.DefaultCapabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	// Render every template of the chart
+	files, err := engine.Render(chrt, renderValues)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the notes from the manifests
+	manifest, notes := assembleHelmManifest(chrt, files, cfg.RenderSubchartNotes.ValueBool())
+
+	// Pass the manifest through the post-renderer, if any
+	manifest, err = postRenderHelmManifest(ctx, manifest, cfg.Postrender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HelmChartRender{
+		Chart:       chrt,
+		Config:      config,
+		ValuesFiles: valuesFiles,
+		Manifest:    strings.TrimSpace(manifest),
+		Notes:       notes,
+	}, nil
+}
+
+// loadHelmChart resolves a chart reference to a local path, downloading it when needed, and loads it.
+func loadHelmChart(ctx context.Context, chartRef string, cfg HelmCommonConfig, settings *cli.EnvSettings) (*chart.Chart, error) {
+	// Create the registry client used for OCI charts and dependencies
+	registryClient, err := newHelmRegistryClient(cfg, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	// Locate the chart on disk
+	chartPath, err := locateHelmChart(ctx, chartRef, cfg, settings, registryClient)
+	if err != nil {
+		return nil, fmt.Errorf("could not locate chart %s: %w", chartRef, err)
+	}
+
+	// Load the chart
+	chrt, err := loader.Load(chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not load chart %s: %w", chartRef, err)
+	}
+
+	// Library charts cannot be installed
+	if chrt.Metadata.Type != "" && chrt.Metadata.Type != "application" {
+		return nil, fmt.Errorf("chart %s of type %q is not installable", chartRef, chrt.Metadata.Type)
+	}
 
-			valuesFile, err := os.CreateTemp("", fmt.Sprintf("helm-values-%d-*.yaml", i))
-			if err != nil {
-				RemoveTempFiles(tempFilePaths)
-				return nil, nil, fmt.Errorf("failed to create temp values file: %w", err)
+	// Make sure every declared dependency is available
+	if dependencies := chrt.Metadata.Dependencies; dependencies != nil {
+		if err := checkHelmDependencies(chrt, dependencies); err != nil {
+			// Missing dependencies are an error unless they may be updated
+			if !cfg.DependencyUpdate.ValueBool() {
+				return nil, err
 			}
 
-			tempFilePaths = append(tempFilePaths, valuesFile.Name())
+			// Stop before downloading the dependencies when the operation was cancelled
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
 
-			if _, err := valuesFile.WriteString(strVal.ValueString()); err != nil {
-				valuesFile.Close()
-				RemoveTempFiles(tempFilePaths)
-				return nil, nil, fmt.Errorf("failed to write values to temp file: %w", err)
+			// Download the missing dependencies into the charts directory
+			manager := &downloader.Manager{
+				Out:              io.Discard,
+				ChartPath:        chartPath,
+				Keyring:          defaultHelmKeyring(),
+				Getters:          getter.All(settings),
+				RegistryClient:   registryClient,
+				RepositoryConfig: settings.RepositoryConfig,
+				RepositoryCache:  settings.RepositoryCache,
+			}
+			if err := manager.Update(); err != nil {
+				return nil, fmt.Errorf("could not update the dependencies of chart %s: %w", chartRef, err)
 			}
 
-			valuesFile.Close()
-			args = append(args, "--values", valuesFile.Name())
+			// Reload the chart with its dependencies
+			if chrt, err = loader.Load(chartPath); err != nil {
+				return nil, fmt.Errorf("could not load chart %s: %w", chartRef, err)
+			}
 		}
 	}
 
-	if !cfg.Set.IsNull() {
-		for key, value := range cfg.Set.Elements() {
-			if strVal, ok := value.(types.String); ok && !strVal.IsNull() {
-				args = append(args, "--set", fmt.Sprintf("%s=%s", key, strVal.ValueString()))
-			}
+	return chrt, nil
+}
+
+// locateHelmChart returns the local path of a chart, downloading it from a repository or registry when it is not a
+// local path.
+func locateHelmChart(ctx context.Context, chartRef string, cfg HelmCommonConfig, settings *cli.EnvSettings, registryClient *registry.Client) (string, error) {
+	// Read the chart reference and the repository settings
+	name := strings.TrimSpace(chartRef)
+	version := strings.TrimSpace(cfg.Version.ValueString())
+	repoURL := cfg.Repository.ValueString()
+	username := cfg.RepositoryUsername.ValueString()
+	password := cfg.RepositoryPassword.ValueString()
+	certFile := cfg.RepositoryCertFile.ValueString()
+	keyFile := cfg.RepositoryKeyFile.ValueString()
+	caFile := cfg.RepositoryCaFile.ValueString()
+	insecure := cfg.InsecureSkipTLSVerify.ValueBool()
+
+	// Use local charts as they are
+	if _, err := os.Stat(name); err == nil {
+		// Resolve the absolute path of the chart
+		abs, err := filepath.Abs(name)
+		if err != nil {
+			return "", err
 		}
-	}
 
-	if !cfg.SetString.IsNull() {
-		for key, value := range cfg.SetString.Elements() {
-			if strVal, ok := value.(types.String); ok && !strVal.IsNull() {
-				args = append(args, "--set-string", fmt.Sprintf("%s=%s", key, strVal.ValueString()))
+		// Verify the provenance of packaged charts when requested
+		if cfg.Verify.ValueBool() {
+			if _, err := downloader.VerifyChart(abs, defaultHelmKeyring()); err != nil {
+				return "", err
 			}
 		}
+
+		// Return the local chart
+		return abs, nil
 	}
 
-	if !cfg.SetFile.IsNull() {
-		for key, value := range cfg.SetFile.Elements() {
-			if strVal, ok := value.(types.String); ok && !strVal.IsNull() {
-				args = append(args, "--set-file", fmt.Sprintf("%s=%s", key, strVal.ValueString()))
-			}
-		}
+	// Paths that do not exist cannot be resolved remotely
+	if filepath.IsAbs(name) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("path %q not found", name)
+	}
+
+	// Configure the downloader
+	dl := downloader.ChartDownloader{
+		Out:     io.Discard,
+		Keyring: defaultHelmKeyring(),
+		Getters: getter.All(settings),
+		Options: []getter.Option{
+			getter.WithTLSClientConfig(certFile, keyFile, caFile),
+			getter.WithInsecureSkipVerifyTLS(insecure),
+		},
+		RepositoryConfig: settings.RepositoryConfig,
+		RepositoryCache:  settings.RepositoryCache,
+		RegistryClient:   registryClient,
+	}
+
+	// OCI charts are pulled through the registry client
+	if registry.IsOCI(name) {
+		dl.Options = append(dl.Options, getter.WithRegistryClient(registryClient))
 	}
 
-	// Deployment options
-	if !cfg.Wait.IsNull() && cfg.Wait.ValueBool() {
-		args = append(args, "--wait")
+	// Verify the provenance of the downloaded chart when requested
+	if cfg.Verify.ValueBool() {
+		dl.Verify = downloader.VerifyAlways
+	}
 
-		if !cfg.Timeout.IsNull() {
-			args = append(args, "--timeout", fmt.Sprintf("%d", cfg.Timeout.ValueInt32()))
+	// Resolve the chart URL from the repository index
+	if repoURL != "" && !registry.IsOCI(repoURL) {
+		chartURL, err := repo.FindChartInAuthAndTLSAndPassRepoURL(repoURL, username, password, name, version, certFile, keyFile, caFile, insecure, false, getter.All(settings))
+		if err != nil {
+			return "", err
 		}
+
+		// Download the chart from the resolved URL
+		name = chartURL
+	} else if repoURL != "" {
+		// Charts in an OCI repository are addressed by their full reference
+		name = fmt.Sprintf("%s/%s", strings.TrimSuffix(repoURL, "/"), name)
+	}
+
+	// Pass the repository credentials along
+	dl.Options = append(dl.Options, getter.WithBasicAuth(username, password))
+
+	// Stop before downloading the chart when the operation was cancelled during the repository lookup
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	// Make sure the repository cache exists
+	if err := os.MkdirAll(settings.RepositoryCache, 0755); err != nil {
+		return "", err
 	}
 
-	if !cfg.Description.IsNull() && cfg.Description.ValueString() != "" {
-		args = append(args, "--description", cfg.Description.ValueString())
+	// Download the chart, or pull it from the registry, into the repository cache
+	filename, _, err := dl.DownloadTo(name, version, settings.RepositoryCache)
+	if err != nil {
+		return "", err
+	}
+
+	// Return the absolute path of the downloaded chart
+	return filepath.Abs(filename)
+}
+
+// newHelmRegistryClient creates the registry client used to pull OCI charts.
+func newHelmRegistryClient(cfg HelmCommonConfig, settings *cli.EnvSettings) (*registry.Client, error) {
+	// Cache the pulled charts and authenticate with the registry configuration and the repository credentials
+	options := []registry.ClientOption{
+		registry.ClientOptEnableCache(true),
+		registry.ClientOptWriter(io.Discard),
+		registry.ClientOptCredentialsFile(settings.RegistryConfig),
+		registry.ClientOptBasicAuth(cfg.RepositoryUsername.ValueString(), cfg.RepositoryPassword.ValueString()),
+	}
+
+	// Apply the TLS options when any is configured
+	certFile := cfg.RepositoryCertFile.ValueString()
+	keyFile := cfg.RepositoryKeyFile.ValueString()
+	caFile := cfg.RepositoryCaFile.ValueString()
+	if (certFile != "" && keyFile != "") || caFile != "" || cfg.InsecureSkipTLSVerify.ValueBool() {
+		tlsConfig, err := newHelmTLSConfig(certFile, keyFile, caFile, cfg.InsecureSkipTLSVerify.ValueBool())
+		if err != nil {
+			return nil, fmt.Errorf("could not create the TLS configuration of the registry client: %w", err)
+		}
+
+		// Reach the registry with the TLS configuration, keeping the proxy settings of the environment
+		options = append(options, registry.ClientOptHTTPClient(&http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: tlsConfig,
+				Proxy:           http.ProxyFromEnvironment,
+			},
+		}))
 	}
 
-	if !cfg.Verify.IsNull() && cfg.Verify.ValueBool() {
-		args = append(args, "--verify")
+	// Create the registry client
+	return registry.NewClient(options...)
+}
+
+// newHelmTLSConfig builds the TLS configuration used to reach a chart registry.
+func newHelmTLSConfig(certFile, keyFile, caFile string, insecureSkipTLSVerify bool) (*tls.Config, error) {
+	// Skip the verification of the registry certificate when requested
+	config := &tls.Config{InsecureSkipVerify: insecureSkipTLSVerify}
+
+	// Identify the client with its certificate
+	if certFile != "" && keyFile != "" {
+		// Load the certificate and its private key
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		// Present the certificate to the registry
+		config.Certificates = []tls.Certificate{certificate}
 	}
 
-	if !cfg.DependencyUpdate.IsNull() && cfg.DependencyUpdate.ValueBool() {
-		args = append(args, "--dependency-update")
+	// Trust the certificate authorities of the bundle
+	if caFile != "" {
+		// Read the bundle
+		bundle, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+
+		// Collect the certificates of the bundle into a pool
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("failed to append certificates from file: %s", caFile)
+		}
+
+		// Verify the registry certificate against the pool
+		config.RootCAs = pool
 	}
 
-	if !cfg.MaxHistory.IsNull() {
-		args = append(args, "--history-limit", fmt.Sprintf("%d", cfg.MaxHistory.ValueInt32()))
+	// Return the TLS configuration
+	return config, nil
+}
+
+// checkHelmDependencies reports the dependencies declared in Chart.yaml that are missing from the charts directory.
+func checkHelmDependencies(chrt *chart.Chart, dependencies []*chart.Dependency) error {
+	// Collect the names of the missing dependencies
+	var missing []string
+
+	// Look up every declared dependency among the loaded subcharts
+	for _, dependency := range dependencies {
+		// Find a subchart with the name of the dependency
+		found := false
+		for _, subchart := range chrt.Dependencies() {
+			if subchart.Name() == dependency.Name {
+				found = true
+				break
+			}
+		}
+
+		// Record the dependency when no subchart provides it
+		if !found {
+			missing = append(missing, dependency.Name)
+		}
 	}
 
-	// Repository auth
-	if !cfg.RepositoryUsername.IsNull() && cfg.RepositoryUsername.ValueString() != "" {
-		args = append(args, "--username", cfg.RepositoryUsername.ValueString())
+	// Report every missing dependency at once
+	if len(missing) > 0 {
+		return fmt.Errorf("found in Chart.yaml, but missing in charts/ directory: %s", strings.Join(missing, ", "))
 	}
 
-	if !cfg.RepositoryPassword.IsNull() && cfg.RepositoryPassword.ValueString() != "" {
-		args = append(args, "--password", cfg.RepositoryPassword.ValueString())
+	// Every dependency is available
+	return nil
+}
+
+// defaultHelmKeyring returns the keyring used to verify charts, matching the default of the helm CLI.
+func defaultHelmKeyring() string {
+	// Prefer the GnuPG home of the environment
+	if home := os.Getenv("GNUPGHOME"); home != "" {
+		return filepath.Join(home, "pubring.gpg")
 	}
 
-	// TLS options
-	if !cfg.RepositoryCaFile.IsNull() && cfg.RepositoryCaFile.ValueString() != "" {
-		args = append(args, "--ca-file", cfg.RepositoryCaFile.ValueString())
+	// Fall back to the GnuPG home in the user home directory
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".gnupg", "pubring.gpg")
+}
+
+// buildHelmValues merges the values files and the set, set_string and set_file overrides, in that order.
+func buildHelmValues(cfg HelmCommonConfig) (map[string]interface{}, []string, error) {
+	values := map[string]interface{}{}
+	valuesFiles := []string{}
+
+	// Merge every values file, later files taking precedence
+	for _, elem := range cfg.Values.Elements() {
+		strVal, ok := elem.(types.String)
+		if !ok || strVal.IsNull() || strVal.ValueString() == "" {
+			continue
+		}
+
+		current := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(strVal.ValueString()), &current); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse values: %w", err)
+		}
+
+		values = mergeHelmValues(values, current)
+		valuesFiles = append(valuesFiles, strVal.ValueString())
 	}
 
-	if !cfg.RepositoryCertFile.IsNull() && cfg.RepositoryCertFile.ValueString() != "" {
-		args = append(args, "--cert-file", cfg.RepositoryCertFile.ValueString())
+	// Apply the typed overrides
+	for _, entry := range sortedHelmOverrides(cfg.Set) {
+		if err := strvals.ParseInto(entry, values); err != nil {
+			return nil, nil, fmt.Errorf("failed parsing set data: %w", err)
+		}
 	}
 
-	if !cfg.RepositoryKeyFile.IsNull() && cfg.RepositoryKeyFile.ValueString() != "" {
-		args = append(args, "--key-file", cfg.RepositoryKeyFile.ValueString())
+	// Apply the string overrides
+	for _, entry := range sortedHelmOverrides(cfg.SetString) {
+		if err := strvals.ParseIntoString(entry, values); err != nil {
+			return nil, nil, fmt.Errorf("failed parsing set_string data: %w", err)
+		}
 	}
 
-	if !cfg.InsecureSkipTLSVerify.IsNull() && cfg.InsecureSkipTLSVerify.ValueBool() {
-		args = append(args, "--insecure-skip-tls-verify")
+	// Apply the overrides read from files
+	readFile := func(rs []rune) (interface{}, error) {
+		data, err := os.ReadFile(string(rs))
+		return string(data), err
+	}
+	for _, entry := range sortedHelmOverrides(cfg.SetFile) {
+		if err := strvals.ParseIntoFile(entry, values, readFile); err != nil {
+			return nil, nil, fmt.Errorf("failed parsing set_file data: %w", err)
+		}
 	}
 
-	// Rendering options
-	if !cfg.RenderSubchartNotes.IsNull() && cfg.RenderSubchartNotes.ValueBool() {
-		args = append(args, "--render-subchart-notes")
+	return values, valuesFiles, nil
+}
+
+// sortedHelmOverrides returns the key=value pairs of an overrides map, sorted by key so they apply deterministically.
+func sortedHelmOverrides(overrides types.Map) []string {
+	entries := []string{}
+
+	for key, value := range overrides.Elements() {
+		if strVal, ok := value.(types.String); ok && !strVal.IsNull() {
+			entries = append(entries, fmt.Sprintf("%s=%s", key, strVal.ValueString()))
+		}
 	}
 
-	if !cfg.Postrender.IsNull() && !cfg.Postrender.IsUnknown() {
-		attrs := cfg.Postrender.Attributes()
+	sort.Strings(entries)
+	return entries
+}
 
-		if binaryPath, ok := attrs["binary_path"]; ok {
-			if strVal, ok := binaryPath.(types.String); ok && !strVal.IsNull() && strVal.ValueString() != "" {
-				args = append(args, "--post-renderer", strVal.ValueString())
+// mergeHelmValues deeply merges the overrides into the base values, the same way the helm CLI merges values files.
+func mergeHelmValues(base, overrides map[string]interface{}) map[string]interface{} {
+	// Copy the base values, leaving the original map untouched
+	out := make(map[string]interface{}, len(base))
+	for key, value := range base {
+		out[key] = value
+	}
+
+	// Apply every override
+	for key, value := range overrides {
+		// Merge nested tables, replace everything else
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			if baseValue, ok := out[key]; ok {
+				if baseMap, ok := baseValue.(map[string]interface{}); ok {
+					out[key] = mergeHelmValues(baseMap, valueMap)
+					continue
+				}
 			}
 		}
 
-		if postArgs, ok := attrs["args"]; ok {
-			if listVal, ok := postArgs.(types.List); ok && !listVal.IsNull() {
-				for _, arg := range listVal.Elements() {
-					if strVal, ok := arg.(types.String); ok && !strVal.IsNull() {
-						args = append(args, "--post-renderer-args", strVal.ValueString())
-					}
+		// Replace the base value
+		out[key] = value
+	}
+
+	// Return the merged values
+	return out
+}
+
+// assembleHelmManifest joins the rendered templates into a single manifest and extracts the notes.
+func assembleHelmManifest(chrt *chart.Chart, files map[string]string, renderSubchartNotes bool) (string, string) {
+	var manifest bytes.Buffer
+	var notes bytes.Buffer
+
+	// Iterate over the templates in a stable order
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		content := files[name]
+
+		// Keep the notes of the chart, and of its subcharts when requested
+		if strings.HasSuffix(name, helmNotesFileSuffix) {
+			if renderSubchartNotes || name == path.Join(chrt.Name(), "templates", helmNotesFileSuffix) {
+				if notes.Len() > 0 {
+					notes.WriteString("\n")
 				}
+				notes.WriteString(content)
 			}
+			continue
+		}
+
+		// Skip templates that rendered nothing
+		if strings.TrimSpace(content) == "" {
+			continue
 		}
+
+		fmt.Fprintf(&manifest, "---\n# Source: %s\n%s\n", name, content)
 	}
 
-	return args, tempFilePaths, nil
+	return manifest.String(), notes.String()
 }
 
-// AppendCplnAuthArgs appends --org, --token, and --endpoint flags to the args slice.
-func (c *Client) AppendCplnAuthArgs(args []string) []string {
-	args = append(args, "--org", c.Org)
-	args = append(args, "--token", c.Token)
+// postRenderHelmManifest passes the manifest through the configured post-renderer, which is killed when the context is
+// cancelled.
+func postRenderHelmManifest(ctx context.Context, manifest string, postrenderConfig types.Object) (string, error) {
+	// Nothing to do without a post-renderer
+	if postrenderConfig.IsNull() || postrenderConfig.IsUnknown() {
+		return manifest, nil
+	}
+
+	attrs := postrenderConfig.Attributes()
 
-	if c.HostURL != "" && c.HostURL != DefaultClientEndpoint {
-		args = append(args, "--endpoint", c.HostURL)
+	// Read the binary to run
+	binaryPath := ""
+	if strVal, ok := attrs["binary_path"].(types.String); ok {
+		binaryPath = strVal.ValueString()
+	}
+	if binaryPath == "" {
+		return manifest, nil
 	}
 
-	return args
-}
+	// Read its arguments
+	args := []string{}
+	if listVal, ok := attrs["args"].(types.List); ok {
+		for _, arg := range listVal.Elements() {
+			if strVal, ok := arg.(types.String); ok && !strVal.IsNull() {
+				args = append(args, strVal.ValueString())
+			}
+		}
+	}
 
-// AppendCplnContextArgs appends auth flags and optionally --gvc to the args slice.
-func (c *Client) AppendCplnContextArgs(args []string, gvc string) []string {
-	args = c.AppendCplnAuthArgs(args)
+	// Resolve the binary from the PATH, the same way the helm CLI does
+	checkedPath, err := exec.LookPath(binaryPath)
+	if err != nil {
+		return "", fmt.Errorf("unable to find binary at %s: %w", binaryPath, err)
+	}
 
-	if gvc != "" {
-		args = append(args, "--gvc", gvc)
+	// Resolve relative paths to the binary
+	fullPath, err := filepath.Abs(checkedPath)
+	if err != nil {
+		return "", err
 	}
 
-	return args
-}
+	// Feed the manifest to the post-renderer and capture its output
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, fullPath, args...)
+	cmd.Stdin = strings.NewReader(manifest)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
 
-// RemoveTempFiles removes a list of temporary files by their paths.
-func RemoveTempFiles(paths []string) {
-	for _, p := range paths {
-		os.Remove(p)
+	// Run the post-renderer
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("error while running post render on files: error while running command %s. error output:\n%s: %w", fullPath, stderr.String(), err)
 	}
+
+	// Return the post-rendered manifest
+	return stdout.String(), nil
 }
@@ -0,0 +1,654 @@
+package cpln
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	constants "github.com/controlplane-com/terraform-provider-cpln/internal/provider/constants"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// helmReleaseSecretPrefix prefixes the name of the secrets holding the revisions of a release
+	helmReleaseSecretPrefix = "cpln-helm-release-"
+	// helmReleaseTagKey is the tag linking an object to the release that manages it
+	helmReleaseTagKey = "cpln/release"
+	// helmWaitPollInterval is the delay between two readiness checks of the workloads of a release
+	helmWaitPollInterval = 5 * time.Second
+	// helmDefaultWaitTimeout is the default time to wait for the workloads of a release to become ready
+	helmDefaultWaitTimeout = 300 * time.Second
+)
+
+// Helm release statuses, matching the statuses reported by the helm CLI.
+const (
+	HelmStatusDeployed   = "deployed"
+	HelmStatusSuperseded = "superseded"
+	HelmStatusFailed     = "failed"
+)
+
+// helmApplyOrder lists the kinds in the order they are applied, so that objects are created after the objects they
+// reference. Kinds that are not listed are applied last and the order is reversed on removal.
+var helmApplyOrder = []string{"agent", "cloudaccount", "auditctx", "group", "serviceaccount", "secret", "ipset", "location", "mk8s", "gvc", "identity", "volumeset", "workload", "domain", "policy"}
+
+// helmGvcScopedKinds lists the kinds that live within a GVC.
+var helmGvcScopedKinds = []string{"identity", "volumeset", "workload"}
+
+// helmImmutableKeys lists the manifest keys that are never sent when replacing an existing object.
+var helmImmutableKeys = []string{"kind", "name", "id", "version", "links", "created", "lastModified", "status"}
+
+// InstallHelmRelease renders a chart and applies it as the first revision of a release. A release that already
+// exists, such as one left behind by a partial install, is upgraded instead.
+func (c *Client) InstallHelmRelease(ctx context.Context, name string, chartRef string, cfg HelmCommonConfig) (*HelmRelease, error) {
+	// Fall back to an upgrade when the release already exists
+	if _, _, err := c.GetHelmRelease(ctx, name); err == nil {
+		return c.UpgradeHelmRelease(ctx, name, chartRef, cfg)
+	} else if !IsNotFound(err) {
+		return nil, err
+	}
+
+	return c.deployHelmRelease(ctx, name, chartRef, cfg, nil)
+}
+
+// UpgradeHelmRelease renders a chart and applies it as a new revision of an existing release.
+func (c *Client) UpgradeHelmRelease(ctx context.Context, name string, chartRef string, cfg HelmCommonConfig) (*HelmRelease, error) {
+	// Fetch the revision being upgraded, installing the release when it does not exist
+	previous, _, err := c.GetHelmRelease(ctx, name)
+	if IsNotFound(err) {
+		return c.deployHelmRelease(ctx, name, chartRef, cfg, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.deployHelmRelease(ctx, name, chartRef, cfg, previous)
+}
+
+// UninstallHelmRelease removes the objects of a release along with its revision history.
+func (c *Client) UninstallHelmRelease(ctx context.Context, name string) error {
+	// Fetch the latest revision to learn which objects to remove
+	release, _, err := c.GetHelmRelease(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	// Remove the objects of the release
+	if err := c.deleteHelmResources(ctx, release.Info.Resources); err != nil {
+		return err
+	}
+
+	// Remove every revision of the release
+	secrets, err := c.listHelmReleaseSecrets(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets {
+		if err := c.DeleteResource(ctx, fmt.Sprintf("secret/%s", *secret.Name)); err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetHelmRelease returns the latest revision of a release.
+func (c *Client) GetHelmRelease(ctx context.Context, name string) (*HelmRelease, int, error) {
+	// Find the secret holding the latest revision
+	latestSecret, code, err := c.findLatestHelmReleaseSecret(ctx, name, helmReleaseQuery(name))
+	if err != nil {
+		return nil, code, err
+	}
+
+	// Reveal the secret to access its encoded data
+	revealedSecret, code, err := c.revealSecret(ctx, *latestSecret.Name)
+	if err != nil {
+		return nil, code, err
+	}
+
+	// Decode the revision
+	release, err := c.decodeHelmReleaseData(revealedSecret.Data)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not decode release %s: %w", name, err)
+	}
+
+	return release, 0, nil
+}
+
+// deployHelmRelease renders the chart, applies the resulting objects, removes the objects the previous revision no
+// longer renders and records the new revision.
+func (c *Client) deployHelmRelease(ctx context.Context, name string, chartRef string, cfg HelmCommonConfig, previous *HelmRelease) (*HelmRelease, error) {
+	// Determine the revision being deployed
+	revision := 1
+	if previous != nil {
+		revision = previous.Version + 1
+	}
+
+	// Render the chart
+	render, err := c.RenderHelmChart(ctx, name, chartRef, cfg, revision, previous != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Describe the new revision
+	now := time.Now().UTC().Format(time.RFC3339)
+	release := &HelmRelease{
+		Name:        name,
+		Version:     revision,
+		Gvc:         cfg.Gvc.ValueString(),
+		Config:      render.Config,
+		Manifest:    render.Manifest,
+		ValuesFiles: &render.ValuesFiles,
+		Chart: &HelmReleaseChart{
+			Name:       render.Chart.Metadata.Name,
+			Version:    render.Chart.Metadata.Version,
+			AppVersion: render.Chart.Metadata.AppVersion,
+		},
+		Info: HelmReleaseInfo{
+			FirstDeployed: now,
+			LastDeployed:  now,
+			Description:   cfg.Description.ValueString(),
+			Notes:         render.Notes,
+		},
+	}
+
+	// Keep the first deployment time across revisions
+	if previous != nil && previous.Info.FirstDeployed != "" {
+		release.Info.FirstDeployed = previous.Info.FirstDeployed
+	}
+
+	// Apply the objects of the manifest
+	resources, applyErr := c.applyHelmManifest(ctx, name, release.Gvc, render.Manifest)
+	release.Info.Resources = resources
+
+	// Remove the objects that are no longer part of the release
+	if applyErr == nil && previous != nil {
+		applyErr = c.deleteHelmResources(ctx, removedHelmResources(previous.Info.Resources, resources))
+	}
+
+	// Wait for the workloads of the release to become ready when requested
+	if applyErr == nil && cfg.Wait.ValueBool() {
+		applyErr = c.waitForHelmWorkloads(ctx, resources, cfg.Timeout)
+	}
+
+	// Record the outcome of the deployment
+	release.Info.Status = HelmStatusDeployed
+	if applyErr != nil {
+		// Keep tracking the objects of the previous revisions that the failed deployment did not get to remove, so
+		// that the next upgrade or the uninstall still removes them
+		if previous != nil {
+			release.Info.Resources = mergeHelmResources(resources, previous.Info.Resources)
+		}
+
+		release.Info.Status = HelmStatusFailed
+		release.Info.Description = applyErr.Error()
+	} else if release.Info.Description == "" && previous != nil {
+		release.Info.Description = "Upgrade complete"
+	} else if release.Info.Description == "" {
+		release.Info.Description = "Install complete"
+	}
+
+	// Store the new revision
+	if err := c.saveHelmRelease(ctx, release); err != nil {
+		return nil, errors.Join(applyErr, err)
+	}
+
+	// Report the deployment failure once the failed revision is recorded
+	if applyErr != nil {
+		return nil, applyErr
+	}
+
+	// Mark the previous revision as superseded
+	if previous != nil {
+		previous.Info.Status = HelmStatusSuperseded
+		if err := c.updateHelmRelease(ctx, previous); err != nil && !IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	// Prune the oldest revisions beyond the history limit
+	if err := c.pruneHelmReleaseHistory(ctx, name, int(cfg.MaxHistory.ValueInt32())); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+/*** Manifests ***/
+
+// applyHelmManifest creates every object of a manifest, replacing the objects that already exist, and returns the
+// objects that were applied.
+func (c *Client) applyHelmManifest(ctx context.Context, releaseName string, gvc string, manifest string) ([]HelmReleaseResource, error) {
+	// Parse the objects of the manifest
+	objects, err := parseHelmManifestObjects(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the objects in dependency order
+	sort.SliceStable(objects, func(i, j int) bool {
+		return helmKindOrder(objects[i]["kind"].(string)) < helmKindOrder(objects[j]["kind"].(string))
+	})
+
+	resources := []HelmReleaseResource{}
+	for _, object := range objects {
+		resource, err := c.applyHelmObject(ctx, releaseName, gvc, object)
+		if err != nil {
+			return resources, err
+		}
+
+		resources = append(resources, *resource)
+	}
+
+	return resources, nil
+}
+
+// applyHelmObject creates a single object, or replaces it when it already exists, and returns its identity.
+func (c *Client) applyHelmObject(ctx context.Context, releaseName string, gvc string, object map[string]interface{}) (*HelmReleaseResource, error) {
+	kind := object["kind"].(string)
+	name := object["name"].(string)
+
+	// Resolve the collection the object belongs to
+	collection := fmt.Sprintf("/org/%s/%s", c.Org, kind)
+	if slices.Contains(helmGvcScopedKinds, kind) {
+		// Objects within a GVC may name it, otherwise they belong to the GVC of the release
+		if objectGvc, ok := object["gvc"].(string); ok && objectGvc != "" {
+			gvc = objectGvc
+		}
+		if gvc == "" {
+			return nil, fmt.Errorf("%s %s belongs to a GVC, but neither the manifest nor the release specify one", kind, name)
+		}
+
+		collection = fmt.Sprintf("/org/%s/gvc/%s/%s", c.Org, gvc, kind)
+		delete(object, "gvc")
+	}
+	link := fmt.Sprintf("%s/%s", collection, name)
+
+	// Tag the object with the release managing it
+	tags, _ := object["tags"].(map[string]interface{})
+	if tags == nil {
+		tags = map[string]interface{}{}
+	}
+	tags[helmReleaseTagKey] = releaseName
+	tags[constants.ManagedByTerraformTagKey] = "true"
+	tags[constants.TerraformVersionTagKey] = c.ProviderVersion
+	object["tags"] = tags
+
+	// Create the object
+	body, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	_, _, err = c.doRequestWithRetry(ctx, http.MethodPost, c.HostURL+collection, body, "application/json")
+
+	// Replace the object when it already exists, as long as it belongs to the release
+	if IsConflict(err) {
+		if err := c.checkHelmObjectOwner(ctx, releaseName, kind, name, link); err != nil {
+			return nil, err
+		}
+
+		body, err = json.Marshal(helmReplacePatch(object))
+		if err != nil {
+			return nil, err
+		}
+		_, _, err = c.doRequestWithRetry(ctx, http.MethodPatch, c.HostURL+link, body, "application/json")
+	}
+
+	// Handle API errors
+	if err != nil {
+		return nil, fmt.Errorf("could not apply %s %s: %w", kind, name, err)
+	}
+
+	// Read the applied object back to record its identity
+	responseBody, _, err := c.doRequestWithRetry(ctx, http.MethodGet, c.HostURL+link, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s %s: %w", kind, name, err)
+	}
+
+	var applied Base
+	if err := json.Unmarshal(responseBody, &applied); err != nil {
+		return nil, err
+	}
+
+	resource := &HelmReleaseResource{
+		Kind:     kind,
+		Link:     link,
+		Template: Base{Name: &name, Kind: &kind},
+	}
+	if applied.ID != nil {
+		resource.ID = *applied.ID
+	}
+	if applied.Version != nil {
+		resource.Version = *applied.Version
+	}
+
+	return resource, nil
+}
+
+// checkHelmObjectOwner ensures an existing object was applied by the given release, so that objects managed by other
+// releases or by Terraform resources are never taken over.
+func (c *Client) checkHelmObjectOwner(ctx context.Context, releaseName string, kind string, name string, link string) error {
+	// Read the existing object
+	body, _, err := c.doRequestWithRetry(ctx, http.MethodGet, c.HostURL+link, nil, "")
+	if err != nil {
+		return fmt.Errorf("could not read %s %s: %w", kind, name, err)
+	}
+
+	var existing Base
+	if err := json.Unmarshal(body, &existing); err != nil {
+		return err
+	}
+
+	// Refuse to adopt objects of another owner
+	owner := ""
+	if existing.Tags != nil {
+		owner, _ = (*existing.Tags)[helmReleaseTagKey].(string)
+	}
+	if owner != releaseName {
+		return fmt.Errorf("%s %s already exists and is not managed by release %s; delete it or remove it from the chart", kind, name, releaseName)
+	}
+
+	return nil
+}
+
+// deleteHelmResources removes the objects of a release in the reverse order they were applied.
+func (c *Client) deleteHelmResources(ctx context.Context, resources []HelmReleaseResource) error {
+	// Sort a copy so the release is left untouched
+	ordered := slices.Clone(resources)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return helmKindOrder(ordered[i].Kind) > helmKindOrder(ordered[j].Kind)
+	})
+
+	for _, resource := range ordered {
+		_, _, err := c.doRequestWithRetry(ctx, http.MethodDelete, c.HostURL+resource.Link, nil, "", http.StatusConflict)
+
+		// Objects that are already gone have nothing left to remove
+		if err != nil && !IsNotFound(err) {
+			return fmt.Errorf("could not delete %s %s: %w", resource.Kind, resource.Link, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForHelmWorkloads waits until the applied version of every workload of a release is rolled out and ready on all of
+// its locations.
+func (c *Client) waitForHelmWorkloads(ctx context.Context, resources []HelmReleaseResource, timeout types.Int32) error {
+	// Determine the deadline
+	wait := helmDefaultWaitTimeout
+	if !timeout.IsNull() && !timeout.IsUnknown() {
+		wait = time.Duration(timeout.ValueInt32()) * time.Second
+	}
+	deadline := time.Now().Add(wait)
+
+	for _, resource := range resources {
+		// Only workloads report readiness
+		if resource.Kind != "workload" {
+			continue
+		}
+
+		for {
+			// Fetch the latest health of the workload
+			var workload Workload
+			body, _, err := c.doRequestWithRetry(ctx, http.MethodGet, c.HostURL+resource.Link, nil, "")
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(body, &workload); err != nil {
+				return err
+			}
+
+			// Fetch the deployments to learn which version each location runs
+			var deployments WorkloadDeployments
+			body, _, err = c.doRequestWithRetry(ctx, http.MethodGet, c.HostURL+resource.Link+"/deployment", nil, "")
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(body, &deployments); err != nil {
+				return err
+			}
+
+			// Move on once the applied version is rolled out and ready on every location, the health of a previous
+			// rollout does not count
+			rolledOut := len(PendingWorkloadDeployments(deployments.Items, resource.Version)) == 0
+			if health := workload.Health; rolledOut && health != nil && health.TotalLocations != nil && *health.TotalLocations > 0 &&
+				health.ReadyLocations != nil && *health.ReadyLocations >= *health.TotalLocations {
+				break
+			}
+
+			// Give up once the deadline is reached
+			if time.Now().Add(helmWaitPollInterval).After(deadline) {
+				return fmt.Errorf("workload %s did not become ready within %s", resource.Link, wait)
+			}
+
+			// Wait for the next poll unless the operation is cancelled
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(helmWaitPollInterval):
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseHelmManifestObjects decodes every object of a multi-document manifest.
+func parseHelmManifestObjects(manifest string) ([]map[string]interface{}, error) {
+	objects := []map[string]interface{}{}
+	decoder := yaml.NewDecoder(strings.NewReader(manifest))
+
+	for {
+		var object map[string]interface{}
+		err := decoder.Decode(&object)
+
+		// Stop at the end of the manifest
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not parse the rendered manifest: %w", err)
+		}
+
+		// Skip empty documents
+		if len(object) == 0 {
+			continue
+		}
+
+		// Every object must be identified by its kind and name
+		kind, _ := object["kind"].(string)
+		name, _ := object["name"].(string)
+		if kind == "" || name == "" {
+			return nil, fmt.Errorf("every object of the rendered manifest must specify a kind and a name")
+		}
+		object["kind"] = strings.ToLower(kind)
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+// helmReplacePatch builds a patch replacing every field of an existing object with the one of the manifest.
+func helmReplacePatch(object map[string]interface{}) map[string]interface{} {
+	patch := map[string]interface{}{}
+
+	for key, value := range object {
+		// Skip the identity and the read-only fields
+		if slices.Contains(helmImmutableKeys, key) {
+			continue
+		}
+
+		// Replace nested objects and lists entirely instead of merging them
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			patch["$replace/"+key] = value
+		default:
+			patch[key] = value
+		}
+	}
+
+	return patch
+}
+
+// removedHelmResources returns the objects of the previous revision that the new revision no longer applies.
+func removedHelmResources(previous []HelmReleaseResource, current []HelmReleaseResource) []HelmReleaseResource {
+	removed := []HelmReleaseResource{}
+
+	for _, resource := range previous {
+		if !slices.ContainsFunc(current, func(r HelmReleaseResource) bool { return r.Link == resource.Link }) {
+			removed = append(removed, resource)
+		}
+	}
+
+	return removed
+}
+
+// mergeHelmResources returns the current objects along with the previous objects that are not part of them.
+func mergeHelmResources(current []HelmReleaseResource, previous []HelmReleaseResource) []HelmReleaseResource {
+	merged := slices.Clone(current)
+	return append(merged, removedHelmResources(previous, current)...)
+}
+
+// helmKindOrder returns the position of a kind in the apply order.
+func helmKindOrder(kind string) int {
+	if index := slices.Index(helmApplyOrder, kind); index != -1 {
+		return index
+	}
+
+	return len(helmApplyOrder)
+}
+
+/*** Revisions ***/
+
+// saveHelmRelease stores a revision of a release in a new secret.
+func (c *Client) saveHelmRelease(ctx context.Context, release *HelmRelease) error {
+	secret, err := newHelmReleaseSecret(release)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.CreateResource(ctx, "secret", *secret.Name, secret)
+	return err
+}
+
+// updateHelmRelease overwrites the secret holding a revision of a release.
+func (c *Client) updateHelmRelease(ctx context.Context, release *HelmRelease) error {
+	secret, err := newHelmReleaseSecret(release)
+	if err != nil {
+		return err
+	}
+
+	// Replace the data and the tags of the secret
+	secret.DataReplace = secret.Data
+	secret.TagsReplace = secret.Tags
+	secret.Data = nil
+	secret.Tags = nil
+
+	_, err = c.UpdateResource(ctx, fmt.Sprintf("secret/%s", *secret.Name), secret)
+	return err
+}
+
+// pruneHelmReleaseHistory removes the oldest revisions of a release beyond the history limit, zero keeping them all.
+func (c *Client) pruneHelmReleaseHistory(ctx context.Context, name string, maxHistory int) error {
+	if maxHistory <= 0 {
+		return nil
+	}
+
+	// List the revisions from the oldest to the latest
+	secrets, err := c.listHelmReleaseSecrets(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	// Remove the oldest revisions
+	for i := 0; i < len(secrets)-maxHistory; i++ {
+		if err := c.DeleteResource(ctx, fmt.Sprintf("secret/%s", *secrets[i].Name)); err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listHelmReleaseSecrets returns the secrets holding the revisions of a release, from the oldest to the latest.
+func (c *Client) listHelmReleaseSecrets(ctx context.Context, name string) ([]Secret, error) {
+	result, _, err := QueryKind[Secret](ctx, c, "secret", helmReleaseQuery(name))
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := result.Items
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return extractVersionFromTags(secrets[i].Tags) < extractVersionFromTags(secrets[j].Tags)
+	})
+
+	return secrets, nil
+}
+
+// helmReleaseQuery returns the query matching the secrets holding the revisions of a release.
+func helmReleaseQuery(name string) Query {
+	prefix := helmReleaseSecretPrefix
+	return NewQuery("secret", QueryMatchAll,
+		NewPropertyQueryTerm("name", "~", &prefix),
+		NewTagQueryTerm("name", QueryOpEquals, &name),
+	)
+}
+
+// newHelmReleaseSecret builds the secret holding a revision of a release, in the format read by
+// decodeHelmReleaseData.
+func newHelmReleaseSecret(release *HelmRelease) (*Secret, error) {
+	payload, err := encodeHelmReleaseData(release)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%s%s-v%d", helmReleaseSecretPrefix, release.Name, release.Version)
+	secretType := "opaque"
+	tags := map[string]interface{}{
+		"name":    release.Name,
+		"version": strconv.Itoa(release.Version),
+		"status":  release.Info.Status,
+	}
+	var data interface{} = map[string]interface{}{
+		"encoding": "plain",
+		"payload":  payload,
+	}
+
+	return &Secret{
+		Base: Base{Name: &name, Tags: &tags},
+		Type: &secretType,
+		Data: &data,
+	}, nil
+}
+
+// encodeHelmReleaseData encodes a revision as gzipped JSON, base64 encoded.
+func encodeHelmReleaseData(release *HelmRelease) (string, error) {
+	data, err := json.Marshal(release)
+	if err != nil {
+		return "", err
+	}
+
+	// Compress the revision
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write(data); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buffer.Bytes()), nil
+}
@@ -0,0 +1,161 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestHelmReleaseDataRoundTrip verifies the revisions stored by the provider are read back by decodeHelmReleaseData.
+func TestHelmReleaseDataRoundTrip(t *testing.T) {
+	// Describe a revision
+	valuesFiles := []string{"secret:\n  name: my-secret\n"}
+	release := &HelmRelease{
+		Name:        "my-release",
+		Version:     3,
+		Gvc:         "my-gvc",
+		Config:      map[string]interface{}{"secret": map[string]interface{}{"name": "my-secret"}},
+		Manifest:    "---\n# Source: chart/templates/secret.yaml\nkind: secret\nname: my-secret",
+		ValuesFiles: &valuesFiles,
+		Chart:       &HelmReleaseChart{Name: "chart", Version: "1.0.0", AppVersion: "2.0.0"},
+		Info: HelmReleaseInfo{
+			FirstDeployed: "2026-01-01T00:00:00Z",
+			LastDeployed:  "2026-01-02T00:00:00Z",
+			Status:        HelmStatusDeployed,
+			Description:   "Upgrade complete",
+			Resources:     []HelmReleaseResource{{ID: "0000", Kind: "secret", Version: 1, Link: "/org/my-org/secret/my-secret"}},
+		},
+	}
+
+	// Build the secret holding the revision
+	secret, err := newHelmReleaseSecret(release)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Verify the secret is named and tagged the way the revisions are looked up
+	if *secret.Name != "cpln-helm-release-my-release-v3" {
+		t.Errorf("secret name = %s", *secret.Name)
+	}
+	if tags := *secret.Tags; tags["name"] != "my-release" || tags["version"] != "3" || tags["status"] != HelmStatusDeployed {
+		t.Errorf("secret tags = %v", tags)
+	}
+
+	// Decode the revision
+	decoded, err := (&Client{}).decodeHelmReleaseData(secret.Data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Verify the revision is unchanged
+	if !reflect.DeepEqual(decoded, release) {
+		t.Fatalf("decoded release = %+v, want %+v", decoded, release)
+	}
+}
+
+// TestHelmReplacePatch verifies existing objects are replaced field by field, without their identity.
+func TestHelmReplacePatch(t *testing.T) {
+	// Build the patch of an object
+	patch := helmReplacePatch(map[string]interface{}{
+		"kind":        "workload",
+		"name":        "my-workload",
+		"version":     4,
+		"description": "desc",
+		"tags":        map[string]interface{}{"a": "b"},
+		"spec":        map[string]interface{}{"type": "standard"},
+		"items":       []interface{}{"a"},
+	})
+
+	// Verify the nested values are replaced and the identity is left out
+	want := map[string]interface{}{
+		"description":    "desc",
+		"$replace/tags":  map[string]interface{}{"a": "b"},
+		"$replace/spec":  map[string]interface{}{"type": "standard"},
+		"$replace/items": []interface{}{"a"},
+	}
+	if !reflect.DeepEqual(patch, want) {
+		t.Fatalf("patch = %v, want %v", patch, want)
+	}
+}
+
+// TestRemovedHelmResources verifies the objects a new revision drops are found, and that merging keeps tracking them.
+func TestRemovedHelmResources(t *testing.T) {
+	// Describe the objects of two revisions
+	secret := HelmReleaseResource{Kind: "secret", Link: "/org/my-org/secret/a"}
+	workload := HelmReleaseResource{Kind: "workload", Link: "/org/my-org/gvc/my-gvc/workload/b"}
+	identity := HelmReleaseResource{Kind: "identity", Link: "/org/my-org/gvc/my-gvc/identity/c"}
+	previous := []HelmReleaseResource{secret, workload}
+	current := []HelmReleaseResource{workload, identity}
+
+	// Verify only the dropped objects are removed
+	if removed := removedHelmResources(previous, current); !reflect.DeepEqual(removed, []HelmReleaseResource{secret}) {
+		t.Errorf("removed = %v", removed)
+	}
+
+	// Verify nothing is removed from an empty previous revision
+	if removed := removedHelmResources(nil, current); len(removed) != 0 {
+		t.Errorf("removed = %v", removed)
+	}
+
+	// Verify merging keeps the current objects and the dropped ones
+	if merged := mergeHelmResources(current, previous); !reflect.DeepEqual(merged, []HelmReleaseResource{workload, identity, secret}) {
+		t.Errorf("merged = %v", merged)
+	}
+}
+
+// TestApplyHelmObjectOwnership verifies an existing object is only replaced when it belongs to the release.
+func TestApplyHelmObjectOwnership(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name      string
+		owner     string
+		wantPatch bool
+		wantError string
+	}{
+		{name: "object of the release", owner: "my-release", wantPatch: true},
+		{name: "object of another release", owner: "other-release", wantError: "not managed by release my-release"},
+		{name: "object without a release", owner: "", wantError: "not managed by release my-release"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Serve an existing secret owned by the case's release
+			patched := false
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"message":"already exists"}`))
+				case http.MethodPatch:
+					patched = true
+				case http.MethodGet:
+					tags := map[string]interface{}{}
+					if tc.owner != "" {
+						tags[helmReleaseTagKey] = tc.owner
+					}
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "0000", "name": "my-secret", "kind": "secret", "version": 2, "tags": tags})
+				}
+			})
+
+			// Apply the secret
+			resource, err := c.applyHelmObject(context.Background(), "my-release", "", map[string]interface{}{"kind": "secret", "name": "my-secret", "type": "opaque"})
+
+			// Verify the outcome
+			if tc.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantError) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantError, err)
+				}
+			} else if err != nil || resource.Link != "/org/my-org/secret/my-secret" || resource.Version != 2 {
+				t.Fatalf("unexpected result %+v, %v", resource, err)
+			}
+
+			// Verify the object was only replaced when owned by the release
+			if patched != tc.wantPatch {
+				t.Fatalf("patched = %t, want %t", patched, tc.wantPatch)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -113,9 +114,9 @@ type IdentityStatusProvider struct {
 }
 
 // GetIdentity - Get Identity by name
-func (c *Client) GetIdentity(name, gvcName string) (*Identity, int, error) {
+func (c *Client) GetIdentity(ctx context.Context, name, gvcName string) (*Identity, int, error) {
 
-	identity, code, err := c.GetResource(fmt.Sprintf("gvc/%s/identity/%s", gvcName, name), new(Identity))
+	identity, code, err := c.GetResource(ctx, fmt.Sprintf("gvc/%s/identity/%s", gvcName, name), new(Identity))
 	if err != nil {
 		return nil, code, err
 	}
@@ -124,28 +125,28 @@ func (c *Client) GetIdentity(name, gvcName string) (*Identity, int, error) {
 }
 
 // CreateIdentity - Create an Identity
-func (c *Client) CreateIdentity(identity Identity, gvcName string) (*Identity, int, error) {
+func (c *Client) CreateIdentity(ctx context.Context, identity Identity, gvcName string) (*Identity, int, error) {
 
-	code, err := c.CreateResource(fmt.Sprintf("gvc/%s/identity", gvcName), *identity.Name, identity)
+	code, err := c.CreateResource(ctx, fmt.Sprintf("gvc/%s/identity", gvcName), *identity.Name, identity)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetIdentity(*identity.Name, gvcName)
+	return c.GetIdentity(ctx, *identity.Name, gvcName)
 }
 
 // UpdateIdentity - Update an Identity
-func (c *Client) UpdateIdentity(identity Identity, gvcName string) (*Identity, int, error) {
+func (c *Client) UpdateIdentity(ctx context.Context, identity Identity, gvcName string) (*Identity, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("gvc/%s/identity/%s", gvcName, *identity.Name), identity)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("gvc/%s/identity/%s", gvcName, *identity.Name), identity)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetIdentity(*identity.Name, gvcName)
+	return c.GetIdentity(ctx, *identity.Name, gvcName)
 }
 
 // DeleteIdentity - Delete Identity by name
-func (c *Client) DeleteIdentity(name, gvcName string) error {
-	return c.DeleteResource(fmt.Sprintf("gvc/%s/identity/%s", gvcName, name))
+func (c *Client) DeleteIdentity(ctx context.Context, name, gvcName string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("gvc/%s/identity/%s", gvcName, name))
 }
@@ -1,10 +1,9 @@
 package cpln
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 /*** Structs ***/
@@ -31,18 +30,13 @@ type ImageManifestConfig struct {
 	MediaType *string `json:"mediaType,omitempty"`
 }
 
-type ImagesQueryResult struct {
-	Kind  string  `json:"kind,omitempty"`
-	Items []Image `json:"items,omitempty"`
-	Links []Link  `json:"links,omitempty"`
-	Query Query   `json:"query,omitempty"`
-}
+type ImagesQueryResult = QueryResult[Image]
 
 /*** Functions ***/
 
-func (c *Client) GetImage(name string) (*Image, int, error) {
+func (c *Client) GetImage(ctx context.Context, name string) (*Image, int, error) {
 
-	image, code, err := c.GetResource(fmt.Sprintf("image/%s", name), new(Image))
+	image, code, err := c.GetResource(ctx, fmt.Sprintf("image/%s", name), new(Image))
 
 	if err != nil {
 		return nil, code, err
@@ -51,9 +45,9 @@ func (c *Client) GetImage(name string) (*Image, int, error) {
 	return image.(*Image), code, err
 }
 
-func (c *Client) GetLatestImage(name string) (*Image, int, error) {
+func (c *Client) GetLatestImage(ctx context.Context, name string) (*Image, int, error) {
 
-	image, code, err := c.GetResource(fmt.Sprintf("image/-latest/%s", name), new(Image))
+	image, code, err := c.GetResource(ctx, fmt.Sprintf("image/-latest/%s", name), new(Image))
 
 	if err != nil {
 		return nil, code, err
@@ -62,29 +56,10 @@ func (c *Client) GetLatestImage(name string) (*Image, int, error) {
 	return image.(*Image), code, err
 }
 
-func (c *Client) GetImagesQuery(query Query) (*ImagesQueryResult, error) {
-
-	// Marshal query into a JSON byte slice
-	jsonData, jsonError := json.Marshal(query)
-
-	if jsonError != nil {
-		return nil, jsonError
-	}
+func (c *Client) GetImagesQuery(ctx context.Context, query Query) (*ImagesQueryResult, error) {
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/org/%s/image/-query", c.HostURL, c.Org), bytes.NewBuffer(jsonData))
-
-	if err != nil {
-		return nil, err
-	}
-
-	body, _, err := c.doRequest(req, "application/json")
-
-	if err != nil {
-		return nil, err
-	}
-
-	images := ImagesQueryResult{}
-	err = json.Unmarshal(body, &images)
+	// Run the query across all pages
+	images, _, err := QueryKind[Image](ctx, c, "image", query)
 
 	if err != nil {
 		return nil, err
@@ -114,29 +89,5 @@ func (c *Client) GetImagesQuery(query Query) (*ImagesQueryResult, error) {
 		return nil, err
 	}
 
-	nextLink := GetLinkHref(images.Links, "next")
-
-	for {
-
-		if nextLink == nil {
-			break
-		}
-
-		nextPage, _, err := c.Get(*nextLink, new(ImagesQueryResult))
-
-		if err != nil {
-			return nil, err
-		}
-
-		newQueryResult := nextPage.(*ImagesQueryResult)
-		nextLink = GetLinkHref(newQueryResult.Links, "next")
-
-		// Append items of next page
-		images.Items = append(images.Items, newQueryResult.Items...)
-
-		// Make links valid
-		images.Links = newQueryResult.Links
-	}
-
-	return &images, nil
+	return images, nil
 }
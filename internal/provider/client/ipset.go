@@ -1,6 +1,9 @@
 package cpln
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type IpSet struct {
 	Base
@@ -34,9 +37,9 @@ type IpSetIpAddress struct {
 }
 
 // GetIpSet - Get IP Set by name
-func (c *Client) GetIpSet(name string) (*IpSet, int, error) {
+func (c *Client) GetIpSet(ctx context.Context, name string) (*IpSet, int, error) {
 
-	ipSet, code, err := c.GetResource(fmt.Sprintf("ipset/%s", name), new(IpSet))
+	ipSet, code, err := c.GetResource(ctx, fmt.Sprintf("ipset/%s", name), new(IpSet))
 
 	if err != nil {
 		return nil, code, err
@@ -46,30 +49,30 @@ func (c *Client) GetIpSet(name string) (*IpSet, int, error) {
 }
 
 // CreateIpSet - Create a new IP Set
-func (c *Client) CreateIpSet(ipSet IpSet) (*IpSet, int, error) {
+func (c *Client) CreateIpSet(ctx context.Context, ipSet IpSet) (*IpSet, int, error) {
 
-	code, err := c.CreateResource("ipset", *ipSet.Name, ipSet)
+	code, err := c.CreateResource(ctx, "ipset", *ipSet.Name, ipSet)
 
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetIpSet(*ipSet.Name)
+	return c.GetIpSet(ctx, *ipSet.Name)
 }
 
 // UpdateIpSet - Update an existing IP Set
-func (c *Client) UpdateIpSet(ipSet IpSet) (*IpSet, int, error) {
+func (c *Client) UpdateIpSet(ctx context.Context, ipSet IpSet) (*IpSet, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("ipset/%s", *ipSet.Name), ipSet)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("ipset/%s", *ipSet.Name), ipSet)
 
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetIpSet(*ipSet.Name)
+	return c.GetIpSet(ctx, *ipSet.Name)
 }
 
 // DeleteIpSet - Delete IP Set by name
-func (c *Client) DeleteIpSet(name string) error {
-	return c.DeleteResource(fmt.Sprintf("ipset/%s", name))
+func (c *Client) DeleteIpSet(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("ipset/%s", name))
 }
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -47,9 +48,9 @@ type LocationGeo struct {
 }
 
 // GetLocation
-func (c *Client) GetLocation(name string) (*Location, int, error) {
+func (c *Client) GetLocation(ctx context.Context, name string) (*Location, int, error) {
 
-	location, code, err := c.GetResource(fmt.Sprintf("location/%s", name), new(Location))
+	location, code, err := c.GetResource(ctx, fmt.Sprintf("location/%s", name), new(Location))
 
 	if err != nil {
 		return nil, code, err
@@ -59,15 +60,9 @@ func (c *Client) GetLocation(name string) (*Location, int, error) {
 }
 
 // GetLocations
-func (c *Client) GetLocations() (*Locations, error) {
+func (c *Client) GetLocations(ctx context.Context) (*Locations, error) {
 
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/org/%s/location", c.HostURL, c.Org), nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	body, _, err := c.doRequest(req, "")
+	body, _, err := c.doRequestWithRetry(ctx, http.MethodGet, fmt.Sprintf("%s/org/%s/location", c.HostURL, c.Org), nil, "")
 
 	if err != nil {
 		return nil, err
@@ -83,28 +78,28 @@ func (c *Client) GetLocations() (*Locations, error) {
 	return &locations, nil
 }
 
-func (c *Client) CreateCustomLocation(location Location) (*Location, int, error) {
+func (c *Client) CreateCustomLocation(ctx context.Context, location Location) (*Location, int, error) {
 
-	code, err := c.CreateResource("location", *location.Name, location)
+	code, err := c.CreateResource(ctx, "location", *location.Name, location)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetLocation(*location.Name)
+	return c.GetLocation(ctx, *location.Name)
 }
 
-func (c *Client) UpdateLocation(location Location) (*Location, int, error) {
+func (c *Client) UpdateLocation(ctx context.Context, location Location) (*Location, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("location/%s", *location.Name), location)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("location/%s", *location.Name), location)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetLocation(*location.Name)
+	return c.GetLocation(ctx, *location.Name)
 }
 
 // UpdateLocationToDefault patches the specified location to its default state.
-func (c *Client) UpdateLocationToDefault(location Location) (*Location, int, error) {
+func (c *Client) UpdateLocationToDefault(ctx context.Context, location Location) (*Location, int, error) {
 	// Remove the Terraform-managed tag before sending the update
 	c.RemoveManagedByTerraformTag(&location.Base)
 
@@ -117,7 +112,8 @@ func (c *Client) UpdateLocationToDefault(location Location) (*Location, int, err
 	}
 
 	// Build the PATCH request targeting the location endpoint
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodPatch,
 		fmt.Sprintf("%s/org/%s/location/%s", c.HostURL, c.Org, *location.Name),
 		strings.NewReader(string(payload)),
@@ -136,9 +132,9 @@ func (c *Client) UpdateLocationToDefault(location Location) (*Location, int, err
 		return nil, code, err
 	}
 	// Retrieve and return the updated location from the API
-	return c.GetLocation(*location.Name)
+	return c.GetLocation(ctx, *location.Name)
 }
 
-func (c *Client) DeleteCustomLocation(name string) error {
-	return c.DeleteResource(fmt.Sprintf("location/%s", name))
+func (c *Client) DeleteCustomLocation(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("location/%s", name))
 }
@@ -0,0 +1,192 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// requestIDHeader is the response header carrying the API correlation ID.
+const requestIDHeader = "X-Request-Id"
+
+// redactedValue replaces sensitive values in logged payloads.
+const redactedValue = "***"
+
+// sensitiveFieldNames lists the JSON keys, in lower case, whose values are never written to the logs.
+var sensitiveFieldNames = map[string]struct{}{
+	"accesskey":     {},
+	"authorization": {},
+	"clientsecret":  {},
+	"data":          {},
+	"key":           {},
+	"password":      {},
+	"payload":       {},
+	"privatekey":    {},
+	"refreshtoken":  {},
+	"secret":        {},
+	"secretkey":     {},
+	"token":         {},
+}
+
+// attemptContextKey stores the retry attempt number of a request in its context.
+type attemptContextKey struct{}
+
+// withAttempt returns a copy of the context carrying the retry attempt number.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptContextKey{}, attempt)
+}
+
+// attemptFromContext returns the retry attempt number carried by the context, defaulting to the first attempt.
+func attemptFromContext(ctx context.Context) int {
+	// Read the attempt number set by the retry layer
+	if attempt, ok := ctx.Value(attemptContextKey{}).(int); ok {
+		return attempt
+	}
+
+	return 1
+}
+
+// logRequest emits a structured log entry describing a completed API request. The summary is logged at DEBUG level
+// and the redacted request and response bodies at TRACE level.
+func (c *Client) logRequest(req *http.Request, res *http.Response, body []byte, latency time.Duration, err error) {
+	// Never let credentials leak through any logged field or message
+	ctx := c.maskCredentials(req.Context())
+
+	// Describe the request
+	fields := map[string]interface{}{
+		"method":     req.Method,
+		"path":       req.URL.Path,
+		"attempt":    attemptFromContext(req.Context()),
+		"latency_ms": latency.Milliseconds(),
+	}
+
+	// Describe the response when one was received
+	if res != nil {
+		fields["status"] = res.StatusCode
+
+		// Include the correlation ID so failures can be traced on the API side
+		if requestID := res.Header.Get(requestIDHeader); requestID != "" {
+			fields["request_id"] = requestID
+		}
+	}
+
+	// Include the error, logging only the redacted response body for rejected requests
+	if apiErr, ok := AsAPIError(err); ok {
+		fields["response_body"] = redactBody(apiErr.Body)
+	} else if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	// Log the request summary
+	tflog.Debug(ctx, "Control Plane API request", fields)
+
+	// Log the redacted payloads for in-depth troubleshooting
+	tflog.Trace(ctx, "Control Plane API request payload", map[string]interface{}{
+		"method":        req.Method,
+		"path":          req.URL.Path,
+		"request_body":  redactBody(readRequestBody(req)),
+		"response_body": redactBody(body),
+	})
+}
+
+// maskCredentials returns a copy of the context whose logger masks the client tokens.
+func (c *Client) maskCredentials(ctx context.Context) context.Context {
+	// Collect the credentials known to the client
+	var secrets []string
+
+	for _, token := range []string{c.CurrentToken(), c.RefreshToken} {
+		// Mask the token both with and without its bearer prefix
+		token = strings.TrimSpace(token)
+		if len(token) >= len(bearerPrefix) && strings.EqualFold(token[:len(bearerPrefix)], bearerPrefix) {
+			token = token[len(bearerPrefix):]
+		}
+
+		if token != "" {
+			secrets = append(secrets, token)
+		}
+	}
+
+	// Mask the credentials in messages and field values
+	ctx = tflog.MaskMessageStrings(ctx, secrets...)
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, secrets...)
+
+	return ctx
+}
+
+// readRequestBody returns a copy of the request body without consuming it.
+func readRequestBody(req *http.Request) []byte {
+	// Requests without a replayable body have nothing to log
+	if req.GetBody == nil {
+		return nil
+	}
+
+	// Obtain a fresh reader over the body
+	reader, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer reader.Close()
+
+	// Read the body into memory
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil
+	}
+
+	return body
+}
+
+// redactBody returns the payload with the values of sensitive JSON keys replaced. Payloads that are not JSON are
+// omitted entirely since their content cannot be inspected.
+func redactBody(body []byte) string {
+	// Nothing to log for empty payloads
+	if len(body) == 0 {
+		return ""
+	}
+
+	// Parse the payload as JSON
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return redactedValue
+	}
+
+	// Serialize the redacted payload
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return redactedValue
+	}
+
+	return string(redacted)
+}
+
+// redactValue walks a decoded JSON value and replaces the values of sensitive keys.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// Redact sensitive keys and walk the remaining values
+		for key, item := range v {
+			if _, ok := sensitiveFieldNames[strings.ToLower(key)]; ok {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redactValue(item)
+		}
+
+		return v
+	case []interface{}:
+		// Walk every item of the list
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
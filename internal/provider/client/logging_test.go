@@ -0,0 +1,94 @@
+package cpln
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+// TestRedactBody verifies the values of sensitive keys are redacted at any depth, and that payloads which cannot be
+// inspected are omitted.
+func TestRedactBody(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "empty", body: "", want: ""},
+		{name: "not json", body: "token=abc", want: `***`},
+		{name: "truncated json", body: `{"token":"abc"`, want: `***`},
+		{name: "plain keys", body: `{"name":"my-secret","description":"kept"}`, want: `{"description":"kept","name":"my-secret"}`},
+		{name: "sensitive keys", body: `{"password":"p","token":"t","name":"n"}`, want: `{"name":"n","password":"***","token":"***"}`},
+		{name: "case insensitive", body: `{"Authorization":"Bearer abc","RefreshToken":"r"}`, want: `{"Authorization":"***","RefreshToken":"***"}`},
+		{name: "nested keys", body: `{"spec":{"auth":{"clientSecret":"c","clientId":"id"}}}`, want: `{"spec":{"auth":{"clientId":"id","clientSecret":"***"}}}`},
+		{name: "sensitive objects", body: `{"data":{"username":"u","password":"p"}}`, want: `{"data":"***"}`},
+		{name: "arrays", body: `{"keys":[{"key":"k1","name":"a"},{"key":"k2","name":"b"}]}`, want: `{"keys":[{"key":"***","name":"a"},{"key":"***","name":"b"}]}`},
+		{name: "top level array", body: `[{"secretKey":"s"},"plain",3]`, want: `[{"secretKey":"***"},"plain",3]`},
+		{name: "scalar", body: `"plain"`, want: `"plain"`},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			if got := redactBody([]byte(tc.body)); got != tc.want {
+				t.Fatalf("redactBody(%s) = %s, want %s", tc.body, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestLogRequestMasksCredentials verifies the access and refresh tokens never reach the logs, wherever they appear.
+func TestLogRequestMasksCredentials(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name         string
+		token        string
+		refreshToken string
+	}{
+		{name: "raw token", token: "access-secret", refreshToken: "refresh-secret"},
+		{name: "bearer token", token: "Bearer access-secret", refreshToken: "refresh-secret"},
+		{name: "lower case bearer token", token: "bearer access-secret"},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Capture the logs
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+
+			// Build a request whose headers, body and error all carry the credentials
+			c := &Client{Token: tc.token, RefreshToken: tc.refreshToken}
+			body := `{"description":"access-secret refresh-secret","token":"access-secret"}`
+			req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.example.com/org/my-org/secret", strings.NewReader(body))
+			req.Header.Set("Authorization", "Bearer access-secret")
+
+			// Log a failed and a rejected round trip
+			c.logRequest(req, nil, nil, time.Millisecond, errors.New("dial failed for access-secret with refresh-secret"))
+			res := &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{requestIDHeader: []string{"request-123"}}}
+			c.logRequest(req, res, nil, time.Millisecond, &APIError{StatusCode: http.StatusForbidden, Body: []byte(`{"message":"access-secret is not allowed"}`)})
+
+			// Verify the credentials are masked
+			logs := output.String()
+			for _, secret := range []string{"access-secret", tc.refreshToken} {
+				if secret != "" && strings.Contains(logs, secret) {
+					t.Fatalf("logs contain %q:\n%s", secret, logs)
+				}
+			}
+
+			// Verify the request is still described
+			if !strings.Contains(logs, `"request_id":"request-123"`) || !strings.Contains(logs, `"status":403`) {
+				t.Fatalf("logs do not describe the request:\n%s", logs)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package cpln
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -81,12 +82,30 @@ type MarketplaceHelmResponse struct {
 
 // HelmRelease represents the decoded helm release secret data structure.
 type HelmRelease struct {
-	Info        HelmReleaseInfo `json:"info"`
-	ValuesFiles *[]string       `json:"valuesFiles"`
+	Name        string                 `json:"name,omitempty"`
+	Version     int                    `json:"version,omitempty"`
+	Gvc         string                 `json:"gvc,omitempty"`
+	Info        HelmReleaseInfo        `json:"info"`
+	Chart       *HelmReleaseChart      `json:"chart,omitempty"`
+	Config      map[string]interface{} `json:"config,omitempty"`
+	Manifest    string                 `json:"manifest,omitempty"`
+	ValuesFiles *[]string              `json:"valuesFiles"`
 }
 
 type HelmReleaseInfo struct {
-	Resources []HelmReleaseResource `json:"resources"`
+	FirstDeployed string                `json:"firstDeployed,omitempty"`
+	LastDeployed  string                `json:"lastDeployed,omitempty"`
+	Status        string                `json:"status,omitempty"`
+	Description   string                `json:"description,omitempty"`
+	Notes         string                `json:"notes,omitempty"`
+	Resources     []HelmReleaseResource `json:"resources"`
+}
+
+// HelmReleaseChart identifies the chart a helm release was rendered from.
+type HelmReleaseChart struct {
+	Name       string `json:"name,omitempty"`
+	Version    string `json:"version,omitempty"`
+	AppVersion string `json:"appVersion,omitempty"`
 }
 
 type HelmReleaseResource struct {
@@ -117,16 +136,9 @@ func (c *Client) getMarketplaceURL() string {
 }
 
 // GetMarketplaceTemplate gets details for a specific catalog template from the marketplace.
-func (c *Client) GetMarketplaceTemplate(templateName string) (*MarketplaceTemplate, error) {
-	// Construct the HTTP request to fetch template details
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/template/%s", c.getMarketplaceURL(), templateName), nil)
-	if err != nil {
-		// Return error if request construction fails
-		return nil, err
-	}
-
-	// Execute the request through the client's doRequest method
-	body, _, err := c.doRequest(req, "")
+func (c *Client) GetMarketplaceTemplate(ctx context.Context, templateName string) (*MarketplaceTemplate, error) {
+	// Fetch the template details, retrying transient failures
+	body, _, err := c.doRequestWithRetry(ctx, http.MethodGet, fmt.Sprintf("%s/template/%s", c.getMarketplaceURL(), templateName), nil, "")
 	if err != nil {
 		// Return error if the API call fails
 		return nil, err
@@ -145,7 +157,7 @@ func (c *Client) GetMarketplaceTemplate(templateName string) (*MarketplaceTempla
 }
 
 // InstallMarketplaceRelease installs or upgrades a marketplace release.
-func (c *Client) InstallMarketplaceRelease(request MarketplaceInstallRequest) (*MarketplaceHelmResponse, error) {
+func (c *Client) InstallMarketplaceRelease(ctx context.Context, request MarketplaceInstallRequest) (*MarketplaceHelmResponse, error) {
 	// Marshal the request struct into JSON bytes
 	bodyBytes, err := json.Marshal(request)
 	if err != nil {
@@ -154,7 +166,8 @@ func (c *Client) InstallMarketplaceRelease(request MarketplaceInstallRequest) (*
 	}
 
 	// Construct the HTTP POST request with the JSON body
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf("%s/helm/install", c.getMarketplaceURL()),
 		bytes.NewReader(bodyBytes),
@@ -185,7 +198,7 @@ func (c *Client) InstallMarketplaceRelease(request MarketplaceInstallRequest) (*
 }
 
 // UninstallMarketplaceRelease uninstalls a marketplace release.
-func (c *Client) UninstallMarketplaceRelease(request MarketplaceUninstallRequest) (*MarketplaceHelmResponse, error) {
+func (c *Client) UninstallMarketplaceRelease(ctx context.Context, request MarketplaceUninstallRequest) (*MarketplaceHelmResponse, error) {
 	// Marshal the request struct into JSON bytes
 	bodyBytes, err := json.Marshal(request)
 	if err != nil {
@@ -194,7 +207,8 @@ func (c *Client) UninstallMarketplaceRelease(request MarketplaceUninstallRequest
 	}
 
 	// Construct the HTTP POST request with the JSON body
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf("%s/helm/uninstall", c.getMarketplaceURL()),
 		bytes.NewReader(bodyBytes),
@@ -225,10 +239,10 @@ func (c *Client) UninstallMarketplaceRelease(request MarketplaceUninstallRequest
 }
 
 // GetMarketplaceRelease queries the Control Plane API for helm release secrets to get the current state of an installed marketplace release.
-func (c *Client) GetMarketplaceRelease(releaseName string, query Query) (*MarketplaceRelease, int, error) {
+func (c *Client) GetMarketplaceRelease(ctx context.Context, releaseName string, query Query) (*MarketplaceRelease, int, error) {
 	// Find the latest helm release secret using the provided query
-	latestSecret, code, err := c.findLatestHelmReleaseSecret(query)
-	if err != nil || code == 404 {
+	latestSecret, code, err := c.findLatestHelmReleaseSecret(ctx, releaseName, query)
+	if err != nil {
 		return nil, code, err
 	}
 
@@ -248,7 +262,7 @@ func (c *Client) GetMarketplaceRelease(releaseName string, query Query) (*Market
 	}
 
 	// Reveal the secret to access its encoded data
-	revealedSecret, code, err := c.revealSecret(*latestSecret.Name)
+	revealedSecret, code, err := c.revealSecret(ctx, *latestSecret.Name)
 	if err != nil {
 		return nil, code, err
 	}
@@ -272,40 +286,18 @@ func (c *Client) GetMarketplaceRelease(releaseName string, query Query) (*Market
 	return info, 0, nil
 }
 
-// findLatestHelmReleaseSecret queries for helm release secrets and returns the latest one by version tag.
-func (c *Client) findLatestHelmReleaseSecret(query Query) (*Secret, int, error) {
-	// Marshal the query struct into JSON bytes for the POST request
-	jsonData, err := json.Marshal(query)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Construct HTTP POST request to the secret query endpoint
-	req, err := http.NewRequest(
-		http.MethodPost,
-		fmt.Sprintf("%s/org/%s/secret/-query", c.HostURL, c.Org),
-		bytes.NewBuffer(jsonData),
-	)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Execute the query request to find helm release secrets
-	body, _, err := c.doRequest(req, "application/json")
+// findLatestHelmReleaseSecret queries for the helm release secrets of the named release and returns the latest one by
+// version tag, or a not found error when the release has none.
+func (c *Client) findLatestHelmReleaseSecret(ctx context.Context, releaseName string, query Query) (*Secret, int, error) {
+	// Execute the query across all pages to find helm release secrets
+	result, _, err := QueryKind[Secret](ctx, c, "secret", query)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	// Parse the query response into a Secrets struct (list of secrets)
-	var result Secrets
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Check if any secrets were found for this release
+	// Report releases without any revision as not found
 	if len(result.Items) == 0 {
-		return nil, 404, nil
+		return nil, http.StatusNotFound, &APIError{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("release %s not found", releaseName)}
 	}
 
 	// Find the latest secret by version tag
@@ -328,19 +320,9 @@ func findLatestSecretByVersion(secrets []Secret) Secret {
 }
 
 // revealSecret reveals a secret by name and returns the revealed secret data.
-func (c *Client) revealSecret(secretName string) (*Secret, int, error) {
-	// Construct HTTP GET request to reveal the secret
-	revealReq, err := http.NewRequest(
-		http.MethodGet,
-		fmt.Sprintf("%s/org/%s/secret/%s/-reveal", c.HostURL, c.Org, secretName),
-		nil,
-	)
-	if err != nil {
-		return nil, 0, err
-	}
-
+func (c *Client) revealSecret(ctx context.Context, secretName string) (*Secret, int, error) {
 	// Execute the reveal request to get the secret's decrypted data
-	revealBody, code, err := c.doRequest(revealReq, "")
+	revealBody, code, err := c.doRequestWithRetry(ctx, http.MethodGet, fmt.Sprintf("%s/org/%s/secret/%s/-reveal", c.HostURL, c.Org, secretName), nil, "")
 	if err != nil {
 		return nil, code, err
 	}
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"strings"
@@ -825,20 +826,20 @@ type K8sExecEnvVar struct {
 
 /*** Client Functions ***/
 
-func (c *Client) CreateMk8s(mk8s Mk8s) (*Mk8s, int, error) {
+func (c *Client) CreateMk8s(ctx context.Context, mk8s Mk8s) (*Mk8s, int, error) {
 
-	code, err := c.CreateResource("mk8s", *mk8s.Name, mk8s)
+	code, err := c.CreateResource(ctx, "mk8s", *mk8s.Name, mk8s)
 
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetMk8s(*mk8s.Name)
+	return c.GetMk8s(ctx, *mk8s.Name)
 }
 
-func (c *Client) GetMk8s(name string) (*Mk8s, int, error) {
+func (c *Client) GetMk8s(ctx context.Context, name string) (*Mk8s, int, error) {
 
-	mk8s, code, err := c.GetResource(fmt.Sprintf("mk8s/%s", name), new(Mk8s))
+	mk8s, code, err := c.GetResource(ctx, fmt.Sprintf("mk8s/%s", name), new(Mk8s))
 
 	if err != nil {
 		return nil, code, err
@@ -847,79 +848,81 @@ func (c *Client) GetMk8s(name string) (*Mk8s, int, error) {
 	return mk8s.(*Mk8s), code, err
 }
 
-func (c *Client) UpdateMk8s(mk8s Mk8s) (*Mk8s, int, error) {
+func (c *Client) UpdateMk8s(ctx context.Context, mk8s Mk8s) (*Mk8s, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("mk8s/%s", *mk8s.Name), mk8s)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("mk8s/%s", *mk8s.Name), mk8s)
 
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetMk8s(*mk8s.Name)
+	return c.GetMk8s(ctx, *mk8s.Name)
 }
 
-func (c *Client) DeleteMk8s(name string) error {
-	return c.DeleteResource(fmt.Sprintf("mk8s/%s", name))
+func (c *Client) DeleteMk8s(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("mk8s/%s", name))
 }
 
-// CreateMk8sKubeconfig retrieves MK8s cluster info and cacerts, builds a kubeconfig in YAML format, and returns a pointer to the YAML string along with an error.
-func (c *Client) CreateMk8sKubeconfig(mk8sName string, profileName *string, serviceAccountName *string) (*string, error) {
+// CreateMk8sKubeconfig retrieves MK8s cluster info and cacerts, builds a kubeconfig in YAML format, and returns a pointer to the YAML string,
+// the service account key minted for it (nil when a profile is used) and an error.
+func (c *Client) CreateMk8sKubeconfig(ctx context.Context, mk8sName string, profileName *string, serviceAccountName *string) (*string, *ServiceAccountKey, error) {
 	// Construct the /-cacerts link out of the MK8s link
 	cacertsLink := fmt.Sprintf("org/%s/mk8s/%s/-cacerts", c.Org, mk8sName)
 
 	// Get the cluster
-	mk8s, _, err := c.GetResource(fmt.Sprintf("mk8s/%s", mk8sName), new(Mk8s))
+	mk8s, _, err := c.GetResource(ctx, fmt.Sprintf("mk8s/%s", mk8sName), new(Mk8s))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Get the cacerts
-	cacerts, _, err := c.Get(cacertsLink, new(Mk8sCacertsResponse))
+	cacerts, _, err := c.Get(ctx, cacertsLink, new(Mk8sCacertsResponse))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Cacerts cannot be nil
 	if cacerts.(*Mk8sCacertsResponse).Cacerts == nil {
-		return nil, fmt.Errorf("the MK8s cluster '%s' has empty cacerts, please try again later", mk8sName)
+		return nil, nil, fmt.Errorf("the MK8s cluster '%s' has empty cacerts, please try again later", mk8sName)
 	}
 
 	// Build the kubeconfig
-	kubeconfig, err := c.buildKubeconfig(mk8s.(*Mk8s), cacerts.(*Mk8sCacertsResponse), profileName, serviceAccountName)
+	kubeconfig, key, err := c.buildKubeconfig(ctx, mk8s.(*Mk8s), cacerts.(*Mk8sCacertsResponse), profileName, serviceAccountName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Convert kubeconfig to YAML format
 	kubeconfigYamlBytes, err := yaml.Marshal(kubeconfig)
 	if err != nil {
-		return nil, fmt.Errorf("error marshalling to YAML: %v", err)
+		return nil, key, fmt.Errorf("error marshalling to YAML: %v", err)
 	}
 
 	// Convert YAML bytes to YAML string
 	kubeconfigYaml := string(kubeconfigYamlBytes)
 
 	// Return the kubeconfig in YAML format
-	return &kubeconfigYaml, err
+	return &kubeconfigYaml, key, err
 }
 
-// buildKubeconfig constructs a K8sConfig object for the given MK8s cluster using cacerts and user data, and returns it or an error.
-func (c *Client) buildKubeconfig(mk8s *Mk8s, cacerts *Mk8sCacertsResponse, profileName *string, serviceAccountName *string) (*K8sConfig, error) {
+// buildKubeconfig constructs a K8sConfig object for the given MK8s cluster using cacerts and user data, and returns it along with the
+// service account key minted for the user, or an error.
+func (c *Client) buildKubeconfig(ctx context.Context, mk8s *Mk8s, cacerts *Mk8sCacertsResponse, profileName *string, serviceAccountName *string) (*K8sConfig, *ServiceAccountKey, error) {
 	// Extract the server url
 	serverUrl := mk8s.Status.ServerUrl
 
 	// Handle server url does not exist
 	if serverUrl == nil {
-		return nil, fmt.Errorf("the specified MK8s cluster '%s' has no serverUrl", *mk8s.Name)
+		return nil, nil, fmt.Errorf("the specified MK8s cluster '%s' has no serverUrl", *mk8s.Name)
 	}
 
 	// Construct the cluster name
 	clusterName := fmt.Sprintf("%s/%s/%s", c.Org, *mk8s.Name, *mk8s.Alias)
 
 	// Build the Kubernetes user
-	user, err := c.buildK8sUser(*mk8s.Name, clusterName, profileName, serviceAccountName)
+	user, key, err := c.buildK8sUser(ctx, *mk8s.Name, clusterName, profileName, serviceAccountName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Build and return the kubeconfig
@@ -948,14 +951,15 @@ func (c *Client) buildKubeconfig(mk8s *Mk8s, cacerts *Mk8sCacertsResponse, profi
 				},
 			},
 		},
-	}, nil
+	}, key, nil
 }
 
-// buildK8sUser creates a K8sNamedUser based on either a profile token or service account key (ensuring only one is provided) and returns it or an error.
-func (c *Client) buildK8sUser(mk8sName string, clusterName string, profileName *string, serviceAccountName *string) (*K8sNamedUser, error) {
+// buildK8sUser creates a K8sNamedUser based on either a profile token or service account key (ensuring only one is provided) and returns it
+// along with the service account key minted for it, or an error.
+func (c *Client) buildK8sUser(ctx context.Context, mk8sName string, clusterName string, profileName *string, serviceAccountName *string) (*K8sNamedUser, *ServiceAccountKey, error) {
 	// Profile and service account cannot be defined together
 	if profileName != nil && len(*profileName) != 0 && serviceAccountName != nil && len(*serviceAccountName) != 0 {
-		return nil, fmt.Errorf("exactly one of cpln profile or an existing service account can be specified in order to create the kubeconfig")
+		return nil, nil, fmt.Errorf("exactly one of cpln profile or an existing service account can be specified in order to create the kubeconfig")
 	}
 
 	// Create a user using profile name
@@ -963,7 +967,7 @@ func (c *Client) buildK8sUser(mk8sName string, clusterName string, profileName *
 		// Extract token from the specified profile
 		token, err := c.ExtractTokenFromProfile(*profileName)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Remove Bearer from the start
@@ -979,7 +983,7 @@ func (c *Client) buildK8sUser(mk8sName string, clusterName string, profileName *
 				User: K8sUser{
 					Token: *token,
 				},
-			}, nil
+			}, nil, nil
 		}
 
 		// Construct and return the user
@@ -1004,15 +1008,15 @@ func (c *Client) buildK8sUser(mk8sName string, clusterName string, profileName *
 					InteractiveMode:    K8sExecInteractiveModeIfAvailable,
 				},
 			},
-		}, nil
+		}, nil, nil
 	}
 
 	// Create a user using a service account, this will add a new key to the specified service account
 	if serviceAccountName != nil && len(*serviceAccountName) != 0 {
 		// Create a new key for the kubeconfig
-		key, err := c.AddServiceAccountKey(*serviceAccountName, fmt.Sprintf("A Kubeconfig key for cluster '%s'", mk8sName))
+		key, err := c.AddServiceAccountKey(ctx, *serviceAccountName, fmt.Sprintf("A Kubeconfig key for cluster '%s'", mk8sName))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Declare the username
@@ -1024,11 +1028,11 @@ func (c *Client) buildK8sUser(mk8sName string, clusterName string, profileName *
 			User: K8sUser{
 				Token: key.Key,
 			},
-		}, nil
+		}, key, nil
 	}
 
 	// If none of the above, then the user must provide either of them
-	return nil, fmt.Errorf("at lease one of a cpln profile or an existing service account must be specified in order to create the kubeconfig")
+	return nil, nil, fmt.Errorf("at lease one of a cpln profile or an existing service account must be specified in order to create the kubeconfig")
 }
 
 // buildK8sProfileUsername parses the token to extract an email for username (or treats it as a service account token)
@@ -0,0 +1,179 @@
+package cpln
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/imroc/req/v3"
+)
+
+// OidcTokenExchangeGrantType is the OAuth 2.0 token exchange grant type (RFC 8693, section 2.1).
+const OidcTokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+// OidcSubjectTokenType identifies the external OIDC token as a JWT in the token exchange request (RFC 8693, section 3).
+const OidcSubjectTokenType = "urn:ietf:params:oauth:token-type:jwt"
+
+// OidcRequestedTokenType asks the token exchange endpoint for an access token (RFC 8693, section 3).
+const OidcRequestedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+
+// OidcTokenExchangeDiscoveryKey is the discovery endpoint entry used when no token exchange URL is configured.
+const OidcTokenExchangeDiscoveryKey = "token-exchange"
+
+// OidcConfig holds the external OIDC token that is exchanged for a short-lived Control Plane access token.
+type OidcConfig struct {
+	// Token is the external OIDC token itself
+	Token string
+	// TokenFile is the path of a file holding the external OIDC token, read again on every exchange
+	TokenFile string
+	// ExchangeURL is the token exchange endpoint, looked up through the discovery endpoint when empty
+	ExchangeURL string
+}
+
+// IsSet reports whether an external OIDC token was provided.
+func (o *OidcConfig) IsSet() bool {
+	return o != nil && (o.Token != "" || o.TokenFile != "")
+}
+
+// SubjectToken returns the external OIDC token, reading it from the token file when one is configured.
+func (o *OidcConfig) SubjectToken() (string, error) {
+	// Use the token as is when no file is configured
+	if o.TokenFile == "" {
+		return strings.TrimSpace(o.Token), nil
+	}
+
+	// Read the token file, which CI runners may rotate during long jobs
+	content, err := os.ReadFile(o.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the OIDC token file '%s'. Error: %w", o.TokenFile, err)
+	}
+
+	// Handle the case where the file is empty
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("the OIDC token file '%s' is empty", o.TokenFile)
+	}
+
+	// Return the token read from the file
+	return token, nil
+}
+
+// exchangeOidcToken exchanges the external OIDC token for a Control Plane access token. The caller must hold the
+// token mutex.
+//
+// The exchange follows OAuth 2.0 Token Exchange (RFC 8693, https://www.rfc-editor.org/rfc/rfc8693): the request is a
+// form encoded POST carrying the parameters of section 2.1, with the org as the audience, and the response is the JSON
+// object of section 2.2.1 holding access_token and expires_in.
+func (c *Client) exchangeOidcToken() error {
+	// Read the external OIDC token
+	subjectToken, err := c.Oidc.SubjectToken()
+	if err != nil {
+		return err
+	}
+
+	// Handle the case where no token is available
+	if subjectToken == "" {
+		return errors.New("empty OIDC token")
+	}
+
+	// Initialize a new HTTP client based on default configuration
+	client := req.C()
+
+	// Resolve the token exchange endpoint
+	exchangeURL, err := c.resolveOidcTokenExchangeURL(client)
+	if err != nil {
+		return err
+	}
+
+	// Log that the OIDC token will be exchanged
+	log.Println("Exchanging OIDC token")
+
+	// Send the token exchange request
+	response, err := client.R().
+		SetFormData(map[string]string{
+			"grant_type":           OidcTokenExchangeGrantType,
+			"subject_token":        subjectToken,
+			"subject_token_type":   OidcSubjectTokenType,
+			"requested_token_type": OidcRequestedTokenType,
+			"audience":             c.Org,
+		}).
+		Post(exchangeURL)
+
+	// Return on any network or request error
+	if err != nil {
+		return fmt.Errorf("unable to exchange the OIDC token. Error: %w", err)
+	}
+
+	// Report rejected exchanges with the reason given by the endpoint
+	if response.IsErrorState() {
+		return fmt.Errorf("unable to exchange the OIDC token. The token exchange endpoint responded with status %d: %s", response.StatusCode, strings.TrimSpace(response.String()))
+	}
+
+	// Define a local structure to capture the issued access token and its lifespan in seconds
+	var tokenData struct {
+		AccessToken string          `json:"access_token"`
+		ExpiresIn   json.RawMessage `json:"expires_in"`
+	}
+
+	// Unmarshal the JSON response into the tokenData structure
+	if err := response.UnmarshalJson(&tokenData); err != nil {
+		return fmt.Errorf("unable to decode the OIDC token exchange response. Error: %w", err)
+	}
+
+	// Reject responses that carry no token
+	if tokenData.AccessToken == "" {
+		return errors.New("the OIDC token exchange response holds no access token")
+	}
+
+	// Prefix the token with the Bearer scheme and update the client
+	c.Token = bearerPrefix + tokenData.AccessToken
+
+	// Track when the new token expires, preferring its own claim over the advertised lifespan
+	if expires, err := ParseTokenExpiry(tokenData.AccessToken); err == nil {
+		c.tokenExpiresAt = expires
+	} else if expiresIn, err := strconv.ParseInt(strings.Trim(string(tokenData.ExpiresIn), `"`), 10, 64); err == nil {
+		c.tokenExpiresAt = UnixNow() + UnixTime(expiresIn)
+	} else {
+		c.tokenExpiresAt = 0
+	}
+
+	// Indicate successful token exchange
+	return nil
+}
+
+// resolveOidcTokenExchangeURL returns the configured token exchange endpoint, or the one advertised by the discovery endpoint.
+// The data service advertises its endpoints in the "endpoints" map of the discovery document, as it does for billing-ng,
+// and the token exchange endpoint under the OidcTokenExchangeDiscoveryKey entry.
+func (c *Client) resolveOidcTokenExchangeURL(client *req.Client) (string, error) {
+	// Use the configured endpoint
+	if c.Oidc.ExchangeURL != "" {
+		return c.Oidc.ExchangeURL, nil
+	}
+
+	// Send a GET request to retrieve endpoint metadata
+	resp, err := client.R().Get(c.HostURL + "/discovery")
+
+	// Return on any network or request error
+	if err != nil {
+		return "", err
+	}
+
+	// Unmarshal the JSON response into the discovery structure
+	var discovery Discovery
+	if err := resp.UnmarshalJson(&discovery); err != nil {
+		return "", err
+	}
+
+	// Require the endpoint to be advertised
+	exchangeURL := discovery.Endpoints[OidcTokenExchangeDiscoveryKey]
+	if exchangeURL == "" {
+		return "", errors.New("the data service does not advertise a token exchange endpoint. Set oidc_token_exchange_url in the provider configuration")
+	}
+
+	// Return the advertised endpoint
+	return exchangeURL, nil
+}
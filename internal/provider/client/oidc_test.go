@@ -0,0 +1,217 @@
+package cpln
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/imroc/req/v3"
+)
+
+// TestResolveOidcTokenExchangeURL verifies the configured token exchange endpoint takes precedence over the one
+// advertised by the discovery endpoint.
+func TestResolveOidcTokenExchangeURL(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name        string
+		configured  string
+		discovery   string
+		want        string
+		wantErr     string
+		wantLookups int
+	}{
+		{name: "configured", configured: "https://sts.example.com/exchange", want: "https://sts.example.com/exchange"},
+		{name: "advertised", discovery: `{"endpoints":{"billing-ng":"https://billing.example.com","token-exchange":"https://sts.example.com/exchange"}}`, want: "https://sts.example.com/exchange", wantLookups: 1},
+		{name: "not advertised", discovery: `{"endpoints":{"billing-ng":"https://billing.example.com"}}`, wantErr: "oidc_token_exchange_url", wantLookups: 1},
+		{name: "invalid discovery", discovery: `not json`, wantErr: "invalid", wantLookups: 1},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Serve the discovery document
+			lookups := 0
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/discovery" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				lookups++
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, tc.discovery)
+			})
+			c.Oidc = &OidcConfig{Token: "oidc-token", ExchangeURL: tc.configured}
+
+			// Resolve the endpoint
+			got, err := c.resolveOidcTokenExchangeURL(req.C())
+
+			// Verify the outcome
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+			} else if err != nil || got != tc.want {
+				t.Fatalf("resolveOidcTokenExchangeURL() = %q, %v, want %q", got, err, tc.want)
+			}
+
+			// Verify the discovery endpoint is only looked up without a configured endpoint
+			if lookups != tc.wantLookups {
+				t.Fatalf("discovery lookups = %d, want %d", lookups, tc.wantLookups)
+			}
+		})
+	}
+}
+
+// TestExchangeOidcToken verifies the token exchange request carries the RFC 8693 parameters and that the issued token
+// and its lifespan are read from the response.
+func TestExchangeOidcToken(t *testing.T) {
+	// Build a JWT issued by the exchange, whose expiry is read from its claims
+	jwtToken := newTestToken(t, 2*time.Hour)
+
+	// Define the table of cases
+	cases := []struct {
+		name      string
+		status    int
+		response  string
+		wantToken string
+		wantTTL   time.Duration
+		wantErr   string
+	}{
+		{name: "jwt", status: http.StatusOK, response: `{"access_token":"` + jwtToken + `","issued_token_type":"urn:ietf:params:oauth:token-type:access_token","token_type":"Bearer"}`, wantToken: "Bearer " + jwtToken, wantTTL: 2 * time.Hour},
+		{name: "opaque with numeric lifespan", status: http.StatusOK, response: `{"access_token":"opaque","expires_in":3600}`, wantToken: "Bearer opaque", wantTTL: time.Hour},
+		{name: "opaque with string lifespan", status: http.StatusOK, response: `{"access_token":"opaque","expires_in":"600"}`, wantToken: "Bearer opaque", wantTTL: 10 * time.Minute},
+		{name: "rejected", status: http.StatusBadRequest, response: `{"error":"invalid_grant","error_description":"audience mismatch"}`, wantErr: "status 400: {\"error\":\"invalid_grant\""},
+		{name: "without token", status: http.StatusOK, response: `{"token_type":"Bearer"}`, wantErr: "holds no access token"},
+		{name: "invalid response", status: http.StatusOK, response: `not json`, wantErr: "unable to decode"},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Serve the token exchange endpoint and capture the request
+			var form url.Values
+			var contentType string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/exchange" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				contentType = r.Header.Get("Content-Type")
+				r.ParseForm()
+				form = r.PostForm
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				io.WriteString(w, tc.response)
+			})
+			c.Token = ""
+			c.Oidc = &OidcConfig{Token: " oidc-token\n", ExchangeURL: c.HostURL + "/exchange"}
+
+			// Exchange the token
+			err := c.exchangeOidcToken()
+
+			// Verify the request follows RFC 8693, section 2.1
+			if !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+				t.Fatalf("content type = %q, want application/x-www-form-urlencoded", contentType)
+			}
+			want := url.Values{
+				"grant_type":           {"urn:ietf:params:oauth:grant-type:token-exchange"},
+				"subject_token":        {"oidc-token"},
+				"subject_token_type":   {"urn:ietf:params:oauth:token-type:jwt"},
+				"requested_token_type": {"urn:ietf:params:oauth:token-type:access_token"},
+				"audience":             {"my-org"},
+			}
+			if form.Encode() != want.Encode() {
+				t.Fatalf("form = %s, want %s", form.Encode(), want.Encode())
+			}
+
+			// Verify a failed exchange keeps the client without a token
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				if c.Token != "" {
+					t.Fatalf("token = %q, want none", c.Token)
+				}
+				return
+			}
+
+			// Verify the issued token and its expiry
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.Token != tc.wantToken {
+				t.Fatalf("token = %q, want %q", c.Token, tc.wantToken)
+			}
+			if ttl := time.Duration(c.tokenExpiresAt-UnixNow()) * time.Second; ttl < tc.wantTTL-time.Minute || ttl > tc.wantTTL {
+				t.Fatalf("token expires in %s, want about %s", ttl, tc.wantTTL)
+			}
+		})
+	}
+}
+
+// TestOidcAccessToken verifies a client authenticating with an OIDC token file exchanges it at the advertised endpoint,
+// reading the file again on every exchange.
+func TestOidcAccessToken(t *testing.T) {
+	// Write the token file
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("first-token\n"), 0o600); err != nil {
+		t.Fatalf("failed to write the token file: %s", err)
+	}
+
+	// Serve the discovery document and the token exchange endpoint, recording the exchanged tokens
+	var mu sync.Mutex
+	var subjectTokens []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/discovery":
+			io.WriteString(w, `{"endpoints":{"token-exchange":"http://`+r.Host+`/exchange"}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/exchange":
+			r.ParseForm()
+			mu.Lock()
+			subjectTokens = append(subjectTokens, r.PostForm.Get("subject_token"))
+			mu.Unlock()
+			io.WriteString(w, `{"access_token":"access-`+r.PostForm.Get("subject_token")+`","expires_in":60}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	c.Token = ""
+	c.Oidc = &OidcConfig{TokenFile: tokenFile}
+
+	// Obtain the first access token
+	token, err := c.AccessToken()
+	if err != nil || token != "Bearer access-first-token" {
+		t.Fatalf("AccessToken() = %q, %v, want Bearer access-first-token", token, err)
+	}
+
+	// Rotate the token file, as CI runners do, and obtain a token again while the current one is about to expire
+	if err := os.WriteFile(tokenFile, []byte("second-token"), 0o600); err != nil {
+		t.Fatalf("failed to write the token file: %s", err)
+	}
+	token, err = c.AccessToken()
+	if err != nil || token != "Bearer access-second-token" {
+		t.Fatalf("AccessToken() = %q, %v, want Bearer access-second-token", token, err)
+	}
+
+	// Verify the rotated token file was exchanged
+	if strings.Join(subjectTokens, ",") != "first-token,second-token" {
+		t.Fatalf("exchanged tokens = %v, want [first-token second-token]", subjectTokens)
+	}
+
+	// Verify an emptied token file fails the exchange
+	if err := os.WriteFile(tokenFile, nil, 0o600); err != nil {
+		t.Fatalf("failed to write the token file: %s", err)
+	}
+	c.tokenExpiresAt = UnixNow() - 1
+	if _, err := c.AccessToken(); err == nil || !strings.Contains(err.Error(), "is empty") {
+		t.Fatalf("expected an empty token file error, got %v", err)
+	}
+}
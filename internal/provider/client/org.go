@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -198,15 +199,9 @@ type ReplaceTracing struct {
 }
 
 // GetOrg - Get Organization By Name
-func (c *Client) GetOrg() (*Org, int, error) {
-
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/org/%s", c.HostURL, c.Org), nil)
+func (c *Client) GetOrg(ctx context.Context) (*Org, int, error) {
 
-	if err != nil {
-		return nil, 0, err
-	}
-
-	body, code, err := c.doRequest(req, "")
+	body, code, err := c.doRequestWithRetry(ctx, http.MethodGet, fmt.Sprintf("%s/org/%s", c.HostURL, c.Org), nil, "")
 	if err != nil {
 		return nil, code, err
 	}
@@ -221,15 +216,9 @@ func (c *Client) GetOrg() (*Org, int, error) {
 }
 
 // GetSpecificOrg - Get Organization By Name
-func (c *Client) GetSpecificOrg(name string) (*Org, int, error) {
-
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/org/%s", c.HostURL, name), nil)
+func (c *Client) GetSpecificOrg(ctx context.Context, name string) (*Org, int, error) {
 
-	if err != nil {
-		return nil, 0, err
-	}
-
-	body, code, err := c.doRequest(req, "")
+	body, code, err := c.doRequestWithRetry(ctx, http.MethodGet, fmt.Sprintf("%s/org/%s", c.HostURL, name), nil, "")
 	if err != nil {
 		return nil, code, err
 	}
@@ -243,26 +232,20 @@ func (c *Client) GetSpecificOrg(name string) (*Org, int, error) {
 	return &org, code, nil
 }
 
-func (c *Client) GetOrgAccount(orgName string) (*Account, int, error) {
+func (c *Client) GetOrgAccount(ctx context.Context, orgName string) (*Account, int, error) {
 
-	billingNgEndpoint, code, err := c.GetBillingNgEndpoint()
+	billingNgEndpoint, code, err := c.GetBillingNgEndpoint(ctx)
 	if err != nil {
 		return nil, code, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/org/%s/account", billingNgEndpoint, orgName), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/org/%s/account", billingNgEndpoint, orgName), nil)
 
 	if err != nil {
 		return nil, 0, err
 	}
 
-	// Add Bearer prefix if it doesn't exist on the token
-	tokenWithBearer := c.Token
-	if !strings.HasPrefix(strings.ToLower(c.Token), "bearer ") {
-		tokenWithBearer = "Bearer " + c.Token
-	}
-
-	body, code, err := c.doRequest(req, "", tokenWithBearer)
+	body, code, err := c.doRequest(req, "")
 	if err != nil {
 		return nil, code, err
 	}
@@ -277,46 +260,40 @@ func (c *Client) GetOrgAccount(orgName string) (*Account, int, error) {
 }
 
 // CreateOrg - Create Organization
-func (c *Client) CreateOrg(accountId string, createOrg CreateOrgRequest) (*Org, int, error) {
+func (c *Client) CreateOrg(ctx context.Context, accountId string, createOrg CreateOrgRequest) (*Org, int, error) {
 
 	g, err := json.Marshal(createOrg)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	billingNgEndpoint, code, err := c.GetBillingNgEndpoint()
+	billingNgEndpoint, code, err := c.GetBillingNgEndpoint(ctx)
 	if err != nil {
 		return nil, code, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/account/%s/org", billingNgEndpoint, accountId), strings.NewReader(string(g)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/account/%s/org", billingNgEndpoint, accountId), strings.NewReader(string(g)))
 	if err != nil {
 		return nil, 0, err
 	}
 
-	// Add Bearer prefix if it doesn't exist on the token
-	tokenWithBearer := c.Token
-	if !strings.HasPrefix(strings.ToLower(c.Token), "bearer ") {
-		tokenWithBearer = "Bearer " + c.Token
-	}
-
-	_, code, err = c.doRequest(req, "application/json", tokenWithBearer)
+	_, code, err = c.doRequest(req, "application/json")
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetSpecificOrg(*createOrg.Org.Name)
+	return c.GetSpecificOrg(ctx, *createOrg.Org.Name)
 }
 
 // UpdateOrg - Update Organization
-func (c *Client) UpdateOrg(org Org) (*Org, int, error) {
+func (c *Client) UpdateOrg(ctx context.Context, org Org) (*Org, int, error) {
 
 	g, err := json.Marshal(org)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/org/%s", c.HostURL, c.Org), strings.NewReader(string(g)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/org/%s", c.HostURL, c.Org), strings.NewReader(string(g)))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -326,11 +303,11 @@ func (c *Client) UpdateOrg(org Org) (*Org, int, error) {
 		return nil, code, err
 	}
 
-	return c.GetSpecificOrg(c.Org)
+	return c.GetSpecificOrg(ctx, c.Org)
 }
 
 // UpdateOrgLogging - Update an existing Org Logging
-func (c *Client) UpdateOrgLogging(extraLogging *[]Logging) (*Org, int, error) {
+func (c *Client) UpdateOrgLogging(ctx context.Context, extraLogging *[]Logging) (*Org, int, error) {
 
 	var logging *Logging
 
@@ -346,16 +323,16 @@ func (c *Client) UpdateOrgLogging(extraLogging *[]Logging) (*Org, int, error) {
 		},
 	}
 
-	code, err := c.UpdateResource("", spec)
+	code, err := c.UpdateResource(ctx, "", spec)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetOrg()
+	return c.GetOrg(ctx)
 }
 
 // UpdateOrgLogging - Update an existing Org Tracing
-func (c *Client) UpdateOrgTracing(tracing *Tracing) (*Org, int, error) {
+func (c *Client) UpdateOrgTracing(ctx context.Context, tracing *Tracing) (*Org, int, error) {
 
 	spec := UpdateSpec{
 		Spec: ReplaceTracing{
@@ -363,10 +340,10 @@ func (c *Client) UpdateOrgTracing(tracing *Tracing) (*Org, int, error) {
 		},
 	}
 
-	code, err := c.UpdateResource("", spec)
+	code, err := c.UpdateResource(ctx, "", spec)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetOrg()
+	return c.GetOrg(ctx)
 }
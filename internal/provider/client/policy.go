@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -32,9 +33,9 @@ type Binding struct {
 }
 
 // GetPolicy - Get Policy by name
-func (c *Client) GetPolicy(name string) (*Policy, int, error) {
+func (c *Client) GetPolicy(ctx context.Context, name string) (*Policy, int, error) {
 
-	policy, code, err := c.GetResource(fmt.Sprintf("policy/%s", name), new(Policy))
+	policy, code, err := c.GetResource(ctx, fmt.Sprintf("policy/%s", name), new(Policy))
 
 	if err != nil {
 		return nil, code, err
@@ -44,28 +45,28 @@ func (c *Client) GetPolicy(name string) (*Policy, int, error) {
 }
 
 // CreatePolicy - Create an Policy
-func (c *Client) CreatePolicy(policy Policy) (*Policy, int, error) {
+func (c *Client) CreatePolicy(ctx context.Context, policy Policy) (*Policy, int, error) {
 
-	code, err := c.CreateResource("policy", *policy.Name, policy)
+	code, err := c.CreateResource(ctx, "policy", *policy.Name, policy)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetPolicy(*policy.Name)
+	return c.GetPolicy(ctx, *policy.Name)
 }
 
 // UpdatePolicy - Update an Policy
-func (c *Client) UpdatePolicy(policy PolicyUpdate) (*Policy, int, error) {
+func (c *Client) UpdatePolicy(ctx context.Context, policy PolicyUpdate) (*Policy, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("policy/%s", *policy.Name), policy)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("policy/%s", *policy.Name), policy)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetPolicy(*policy.Name)
+	return c.GetPolicy(ctx, *policy.Name)
 }
 
 // DeletePolicy - Delete Policy by name
-func (c *Client) DeletePolicy(name string) error {
-	return c.DeleteResource(fmt.Sprintf("policy/%s", name))
+func (c *Client) DeletePolicy(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("policy/%s", name))
 }
@@ -0,0 +1,164 @@
+package cpln
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DefaultProfileName is the name of the profile the cpln CLI uses when none is marked as default.
+const DefaultProfileName = "default"
+
+// ErrUnrecognizedProfileFormat is returned when a profile file exists but its content is not understood.
+var ErrUnrecognizedProfileFormat = errors.New("unrecognized cpln profile format")
+
+// Profile is the subset of a cpln CLI profile file that the provider understands.
+type Profile struct {
+	Name         string          `json:"name,omitempty"`
+	Default      bool            `json:"default,omitempty"`
+	Request      *ProfileRequest `json:"request,omitempty"`
+	AuthInfo     *ProfileAuth    `json:"authInfo,omitempty"`
+	AccessToken  string          `json:"accessToken,omitempty"`
+	RefreshToken string          `json:"refreshToken,omitempty"`
+}
+
+// ProfileRequest holds the request settings of a profile.
+type ProfileRequest struct {
+	Endpoint string `json:"endpoint,omitempty"`
+	Token    string `json:"token,omitempty"`
+}
+
+// ProfileAuth holds the session a profile obtained with `cpln login`.
+type ProfileAuth struct {
+	AccessToken  string `json:"accessToken,omitempty"`
+	RefreshToken string `json:"refreshToken,omitempty"`
+}
+
+// ProfilesDir returns the directory holding the cpln CLI profiles, honouring XDG_CONFIG_HOME.
+func ProfilesDir() (string, error) {
+	// Prefer the configuration directory set by the user
+	if configHome := os.Getenv("XDG_CONFIG_HOME"); configHome != "" {
+		return filepath.Join(configHome, "cpln", "profiles"), nil
+	}
+
+	// Fall back to the directory the cpln CLI uses on every platform
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".config", "cpln", "profiles"), nil
+}
+
+// ResolveProfileName returns the profile to use: the given one, then CPLN_PROFILE, then the profile marked as default.
+func ResolveProfileName(profileName string) string {
+	// Use the profile that was asked for
+	if profileName != "" {
+		return profileName
+	}
+
+	// Use the profile selected through the environment
+	if envProfile := os.Getenv("CPLN_PROFILE"); envProfile != "" {
+		return envProfile
+	}
+
+	// Look for the profile marked as default
+	dir, err := ProfilesDir()
+	if err != nil {
+		return DefaultProfileName
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return DefaultProfileName
+	}
+
+	// Visit the profiles in a stable order
+	sort.Strings(files)
+
+	for _, file := range files {
+		profile, err := readProfileFile(file)
+		if err == nil && profile.Default {
+			return strings.TrimSuffix(filepath.Base(file), ".json")
+		}
+	}
+
+	// Fall back to the conventional default profile
+	return DefaultProfileName
+}
+
+// ReadProfile reads and decodes the cpln CLI profile with the given name, resolving an empty name as ResolveProfileName does.
+func ReadProfile(profileName string) (*Profile, error) {
+	// Locate the profiles directory
+	dir, err := ProfilesDir()
+	if err != nil {
+		return nil, fmt.Errorf("unable to locate the cpln profiles directory. Error: %w", err)
+	}
+
+	// Read the profile file
+	return readProfileFile(filepath.Join(dir, ResolveProfileName(profileName)+".json"))
+}
+
+// readProfileFile reads and decodes a single profile file.
+func readProfileFile(file string) (*Profile, error) {
+	// Read the raw profile content
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the profile, reporting content that is not a JSON object as an unrecognized format
+	var profile Profile
+	if err := json.Unmarshal(content, &profile); err != nil {
+		return nil, fmt.Errorf("%w in %s: %w", ErrUnrecognizedProfileFormat, file, err)
+	}
+
+	// A profile without an endpoint or any credential is not one the provider understands
+	if profile.Endpoint() == "" && profile.Token() == "" && profile.SessionRefreshToken() == "" {
+		return nil, fmt.Errorf("%w in %s: no endpoint or credentials found", ErrUnrecognizedProfileFormat, file)
+	}
+
+	// Return the decoded profile
+	return &profile, nil
+}
+
+// Endpoint returns the data service endpoint of the profile.
+func (p Profile) Endpoint() string {
+	// Profiles without request settings use the default endpoint
+	if p.Request == nil {
+		return ""
+	}
+
+	return p.Request.Endpoint
+}
+
+// Token returns the token stored in the profile, preferring a service account key over a login session.
+func (p Profile) Token() string {
+	// Service account profiles carry their key in the request settings
+	if p.Request != nil && p.Request.Token != "" {
+		return p.Request.Token
+	}
+
+	// Login sessions carry their access token in the authentication info
+	if p.AuthInfo != nil && p.AuthInfo.AccessToken != "" {
+		return p.AuthInfo.AccessToken
+	}
+
+	// Older profiles carry their access token at the top level
+	return p.AccessToken
+}
+
+// SessionRefreshToken returns the refresh token of the login session stored in the profile, if any.
+func (p Profile) SessionRefreshToken() string {
+	// Prefer the refresh token of the authentication info
+	if p.AuthInfo != nil && p.AuthInfo.RefreshToken != "" {
+		return p.AuthInfo.RefreshToken
+	}
+
+	// Older profiles carry their refresh token at the top level
+	return p.RefreshToken
+}
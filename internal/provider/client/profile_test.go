@@ -0,0 +1,194 @@
+package cpln
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeTestProfiles points the profiles directory to a temporary one holding the given profiles, keyed by name.
+func writeTestProfiles(t *testing.T, profiles map[string]string) {
+	// Use a temporary configuration directory without any profile selected through the environment
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	t.Setenv("CPLN_PROFILE", "")
+
+	// Write every profile
+	dir := filepath.Join(configHome, "cpln", "profiles")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, content := range profiles {
+		if err := os.WriteFile(filepath.Join(dir, name+".json"), []byte(content), 0o600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+// TestResolveProfileName verifies the profile is resolved from the given name, then CPLN_PROFILE, then the profile
+// marked as default, then the conventional default profile.
+func TestResolveProfileName(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name       string
+		profiles   map[string]string
+		envProfile string
+		given      string
+		want       string
+	}{
+		{
+			name:       "given name wins",
+			profiles:   map[string]string{"work": `{"default":true,"request":{"endpoint":"https://work"}}`},
+			envProfile: "env",
+			given:      "mine",
+			want:       "mine",
+		},
+		{
+			name:       "environment before the default profile",
+			profiles:   map[string]string{"work": `{"default":true,"request":{"endpoint":"https://work"}}`},
+			envProfile: "env",
+			want:       "env",
+		},
+		{
+			name:     "profile marked as default",
+			profiles: map[string]string{"a": `{"request":{"endpoint":"https://a"}}`, "work": `{"default":true,"request":{"endpoint":"https://work"}}`},
+			want:     "work",
+		},
+		{
+			name:     "conventional default profile",
+			profiles: map[string]string{"a": `{"request":{"endpoint":"https://a"}}`},
+			want:     DefaultProfileName,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Prepare the profiles and the environment
+			writeTestProfiles(t, tc.profiles)
+			t.Setenv("CPLN_PROFILE", tc.envProfile)
+
+			// Verify the resolved profile
+			if got := ResolveProfileName(tc.given); got != tc.want {
+				t.Fatalf("ResolveProfileName(%q) = %q, want %q", tc.given, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestReadProfile verifies the supported profile layouts are decoded and unknown ones are reported as such.
+func TestReadProfile(t *testing.T) {
+	writeTestProfiles(t, map[string]string{
+		"key":     `{"request":{"endpoint":"https://key","token":"service-account-key"}}`,
+		"session": `{"request":{"endpoint":"https://session"},"authInfo":{"accessToken":"access","refreshToken":"refresh"}}`,
+		"legacy":  `{"accessToken":"access","refreshToken":"refresh"}`,
+		"yaml":    "request:\n  endpoint: https://yaml\n",
+		"empty":   `{"name":"empty"}`,
+	})
+
+	// Define the table of cases
+	cases := []struct {
+		name         string
+		wantEndpoint string
+		wantToken    string
+		wantRefresh  string
+		wantErr      error
+	}{
+		{name: "key", wantEndpoint: "https://key", wantToken: "service-account-key"},
+		{name: "session", wantEndpoint: "https://session", wantToken: "access", wantRefresh: "refresh"},
+		{name: "legacy", wantToken: "access", wantRefresh: "refresh"},
+		{name: "yaml", wantErr: ErrUnrecognizedProfileFormat},
+		{name: "empty", wantErr: ErrUnrecognizedProfileFormat},
+		{name: "missing", wantErr: os.ErrNotExist},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Read the profile
+			profile, err := ReadProfile(tc.name)
+
+			// Verify the failures
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			// Verify the decoded settings
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if profile.Endpoint() != tc.wantEndpoint || profile.Token() != tc.wantToken || profile.SessionRefreshToken() != tc.wantRefresh {
+				t.Fatalf("profile = %q, %q, %q", profile.Endpoint(), profile.Token(), profile.SessionRefreshToken())
+			}
+		})
+	}
+}
+
+// TestExtractHostFromProfile verifies the host is read from the profile, falling back to the default endpoint when the
+// profile is unknown or cannot be decoded, even by the cpln CLI.
+func TestExtractHostFromProfile(t *testing.T) {
+	writeTestProfiles(t, map[string]string{
+		"work":    `{"request":{"endpoint":"https://work","token":"key"}}`,
+		"nohost":  `{"request":{"token":"key"}}`,
+		"unknown": "not a profile",
+	})
+
+	// Make sure the cpln CLI cannot be found
+	t.Setenv("PATH", t.TempDir())
+
+	// Define the table of expectations
+	cases := map[string]string{
+		"work":    "https://work",
+		"nohost":  DefaultClientEndpoint,
+		"unknown": DefaultClientEndpoint,
+		"missing": DefaultClientEndpoint,
+		"":        DefaultClientEndpoint,
+	}
+
+	for name, want := range cases {
+		if got := *ExtractHostFromProfile(&name); got != want {
+			t.Errorf("ExtractHostFromProfile(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+// TestNewClientProfileResolution verifies the token and the host are read from the same profile when no profile is
+// configured.
+func TestNewClientProfileResolution(t *testing.T) {
+	writeTestProfiles(t, map[string]string{
+		"default": `{"request":{"endpoint":"https://default","token":"default-key"}}`,
+		"work":    `{"default":true,"request":{"endpoint":"https://work","token":"work-key"}}`,
+		"env":     `{"request":{"endpoint":"https://env","token":"env-key"}}`,
+	})
+
+	// Define the table of cases
+	cases := []struct {
+		name       string
+		envProfile string
+		wantHost   string
+		wantToken  string
+	}{
+		{name: "profile marked as default", wantHost: "https://work", wantToken: "work-key"},
+		{name: "profile selected through the environment", envProfile: "env", wantHost: "https://env", wantToken: "env-key"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("CPLN_PROFILE", tc.envProfile)
+
+			// Create a client authenticating with the profile
+			org, profile, token, refreshToken := "my-org", "", "", ""
+			c, err := NewClient(&org, nil, &profile, &token, &refreshToken, nil, "test")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Verify the host and the token come from the same profile
+			if c.HostURL != tc.wantHost || c.Token != tc.wantToken {
+				t.Fatalf("client = %q, %q, want %q, %q", c.HostURL, c.Token, tc.wantHost, tc.wantToken)
+			}
+		})
+	}
+}
@@ -1,5 +1,35 @@
 package cpln
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Query fetch modes.
+const (
+	// QueryFetchItems returns the full items matching the query.
+	QueryFetchItems = "items"
+	// QueryFetchLinks returns only the links of the items matching the query.
+	QueryFetchLinks = "links"
+)
+
+// Query match modes.
+const (
+	QueryMatchAll  = "all"
+	QueryMatchAny  = "any"
+	QueryMatchNone = "none"
+)
+
+// Query term operators.
+const (
+	QueryOpEquals    = "="
+	QueryOpNotEquals = "!="
+	QueryOpExists    = "exists"
+	QueryOpNotExists = "!exists"
+)
+
 // Query - Query
 type Query struct {
 	Kind    *string      `json:"kind,omitempty"`
@@ -22,3 +52,109 @@ type QueryTerm struct {
 	Tag      *string `json:"tag,omitempty"`
 	Value    *string `json:"value,omitempty"`
 }
+
+// QueryResult - The aggregated result of a query across all of its pages
+type QueryResult[T any] struct {
+	Kind     string `json:"kind,omitempty"`
+	ItemKind string `json:"itemKind,omitempty"`
+	Items    []T    `json:"items,omitempty"`
+	Links    []Link `json:"links,omitempty"`
+	Query    Query  `json:"query,omitempty"`
+}
+
+/*** Constructors ***/
+
+// NewQuery returns a query for the given kind that fetches the items matching the terms.
+func NewQuery(kind string, match string, terms ...QueryTerm) Query {
+	fetch := QueryFetchItems
+
+	return Query{
+		Kind:  &kind,
+		Fetch: &fetch,
+		Spec: &QuerySpec{
+			Match: &match,
+			Terms: &terms,
+		},
+	}
+}
+
+// NewTagQueryTerm returns a term matching the items whose tag compares to the value using the operator.
+func NewTagQueryTerm(tag string, op string, value *string) QueryTerm {
+	return QueryTerm{Op: &op, Tag: &tag, Value: value}
+}
+
+// NewPropertyQueryTerm returns a term matching the items whose property compares to the value using the operator.
+func NewPropertyQueryTerm(property string, op string, value *string) QueryTerm {
+	return QueryTerm{Op: &op, Property: &property, Value: value}
+}
+
+// NewRelQueryTerm returns a term matching the items whose relation compares to the value using the operator.
+func NewRelQueryTerm(rel string, op string, value *string) QueryTerm {
+	return QueryTerm{Op: &op, Rel: &rel, Value: value}
+}
+
+/*** Functions ***/
+
+// QueryKind runs the query against the org-scoped kind and returns the items of every page.
+func QueryKind[T any](ctx context.Context, c *Client, kind string, query Query) (*QueryResult[T], int, error) {
+	return queryPath[T](ctx, c, kind, fmt.Sprintf("org/%s/%s/-query", c.Org, kind), query)
+}
+
+// QueryGvcKind runs the query against the kind scoped to the given GVC and returns the items of every page.
+func QueryGvcKind[T any](ctx context.Context, c *Client, gvcName string, kind string, query Query) (*QueryResult[T], int, error) {
+	return queryPath[T](ctx, c, kind, fmt.Sprintf("org/%s/gvc/%s/%s/-query", c.Org, gvcName, kind), query)
+}
+
+// queryPath posts the query to the given path and follows the next links until every page has been collected.
+func queryPath[T any](ctx context.Context, c *Client, kind string, path string, query Query) (*QueryResult[T], int, error) {
+	// Default the query kind to the kind being queried
+	if query.Kind == nil {
+		query.Kind = &kind
+	}
+
+	// Marshal query into a JSON byte slice
+	jsonData, err := json.Marshal(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Execute the query request for the first page
+	body, code, err := c.doRequestWithRetry(ctx, http.MethodPost, fmt.Sprintf("%s/%s", c.HostURL, path), jsonData, "application/json")
+	if err != nil {
+		return nil, code, err
+	}
+
+	// Parse the first page
+	result := QueryResult[T]{}
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, code, err
+	}
+
+	// Track the followed next links, so that a page linking back to an earlier one cannot loop forever
+	followed := map[string]bool{}
+
+	// Follow the next links until the last page
+	for nextLink := GetLinkHref(result.Links, "next"); nextLink != nil; {
+		// Stop on a next link that was already followed
+		if followed[*nextLink] {
+			return nil, code, fmt.Errorf("query of %s returned a next link that was already followed: %s", kind, *nextLink)
+		}
+		followed[*nextLink] = true
+
+		// Fetch the next page
+		nextPage, code, err := c.Get(ctx, *nextLink, new(QueryResult[T]))
+		if err != nil {
+			return nil, code, err
+		}
+
+		// Append the items of the next page
+		page := nextPage.(*QueryResult[T])
+		result.Items = append(result.Items, page.Items...)
+
+		// Keep the links of the last page so they remain valid
+		result.Links = page.Links
+		nextLink = GetLinkHref(page.Links, "next")
+	}
+
+	return &result, code, nil
+}
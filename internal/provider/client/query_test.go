@@ -0,0 +1,134 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// TestQueryKindPagination verifies the next links are followed until the last page and that a next link looping back
+// to an earlier page is rejected instead of being followed forever.
+func TestQueryKindPagination(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name      string
+		pages     map[string]string
+		wantNames []string
+		wantErr   string
+	}{
+		{
+			name: "single page",
+			pages: map[string]string{
+				"": `{"items":[{"name":"a"}]}`,
+			},
+			wantNames: []string{"a"},
+		},
+		{
+			name: "two pages",
+			pages: map[string]string{
+				"":       `{"items":[{"name":"a"},{"name":"b"}],"links":[{"rel":"next","href":"/org/my-org/gvc/-query?page=2"}]}`,
+				"page=2": `{"items":[{"name":"c"}],"links":[{"rel":"self","href":"/org/my-org/gvc/-query?page=2"}]}`,
+			},
+			wantNames: []string{"a", "b", "c"},
+		},
+		{
+			name: "next link to itself",
+			pages: map[string]string{
+				"":       `{"items":[{"name":"a"}],"links":[{"rel":"next","href":"/org/my-org/gvc/-query?page=2"}]}`,
+				"page=2": `{"items":[{"name":"b"}],"links":[{"rel":"next","href":"/org/my-org/gvc/-query?page=2"}]}`,
+			},
+			wantErr: "already followed",
+		},
+		{
+			name: "next link to an earlier page",
+			pages: map[string]string{
+				"":       `{"items":[{"name":"a"}],"links":[{"rel":"next","href":"/org/my-org/gvc/-query?page=2"}]}`,
+				"page=2": `{"items":[{"name":"b"}],"links":[{"rel":"next","href":"/org/my-org/gvc/-query?page=3"}]}`,
+				"page=3": `{"items":[{"name":"c"}],"links":[{"rel":"next","href":"/org/my-org/gvc/-query?page=2"}]}`,
+			},
+			wantErr: "already followed",
+		},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Serve the first page to the query and the next pages to the links, counting the requests
+			var requests atomic.Int32
+			var posted Query
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				// Give up on runaway pagination
+				if requests.Add(1) > 10 {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				// The query is posted once, the next pages are fetched
+				if r.URL.Path != "/org/my-org/gvc/-query" || (r.Method == http.MethodPost) != (r.URL.RawQuery == "") {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				// Capture the posted query
+				if r.Method == http.MethodPost {
+					body, _ := io.ReadAll(r.Body)
+					json.Unmarshal(body, &posted)
+				}
+
+				// Serve the requested page
+				page, ok := tc.pages[r.URL.RawQuery]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				io.WriteString(w, page)
+			})
+
+			// Run the query
+			result, _, err := QueryKind[struct {
+				Name string `json:"name"`
+			}](context.Background(), c, "gvc", Query{})
+
+			// Verify a looping next link is rejected
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				if got := requests.Load(); got > int32(len(tc.pages)) {
+					t.Fatalf("requests = %d, want at most %d", got, len(tc.pages))
+				}
+				return
+			}
+
+			// Fail on errors
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Verify the kind of the query defaults to the queried kind
+			if posted.Kind == nil || *posted.Kind != "gvc" {
+				t.Fatalf("posted kind = %v, want gvc", posted.Kind)
+			}
+
+			// Verify the items of every page were collected in order
+			names := []string{}
+			for _, item := range result.Items {
+				names = append(names, item.Name)
+			}
+			if fmt.Sprint(names) != fmt.Sprint(tc.wantNames) {
+				t.Fatalf("items = %v, want %v", names, tc.wantNames)
+			}
+
+			// Verify the links of the last page are kept
+			if GetLinkHref(result.Links, "next") != nil {
+				t.Fatalf("expected no next link, got %v", result.Links)
+			}
+		})
+	}
+}
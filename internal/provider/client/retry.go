@@ -0,0 +1,258 @@
+package cpln
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// DefaultMaxRetries bounds the retry loops when the context carries no deadline and no limit is configured.
+const DefaultMaxRetries = 5
+
+// DefaultInitialBackoff is the backoff used before the first retry.
+const DefaultInitialBackoff = 2 * time.Second
+
+// DefaultMaxBackoff caps the exponential backoff between two attempts.
+const DefaultMaxBackoff = 30 * time.Second
+
+// errRetriesExhausted marks errors returned once the retry policy gave up, so that enclosing retries stop as well.
+var errRetriesExhausted = errors.New("retries exhausted")
+
+// RetryPolicy controls how failed API requests are retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt. A negative value retries until the context
+	// deadline, or DefaultMaxRetries times when the context carries no deadline.
+	MaxRetries int
+	// InitialBackoff is the backoff used before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff between two attempts.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when the provider configuration does not override it.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:     -1,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+	}
+}
+
+// Backoff returns the jittered exponential backoff to wait before the given retry attempt (1-based).
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	// Start from the initial backoff, falling back to the default
+	backoff := p.InitialBackoff
+	if backoff <= 0 {
+		backoff = DefaultInitialBackoff
+	}
+
+	// Resolve the backoff cap, falling back to the default
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+
+	// Double the backoff for every previous attempt without exceeding the cap
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	// Cap the backoff so long deadlines keep polling at a steady pace
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	// Keep at least half of the backoff and randomize the rest to spread concurrent retries apart
+	half := backoff / 2
+
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+// Wait blocks for the given delay and reports whether another attempt should be made. When the context carries a
+// deadline, retries continue for as long as the delay fits before it; otherwise at most DefaultMaxRetries retries are
+// made unless MaxRetries says otherwise. It returns false as soon as the context is done.
+func (p RetryPolicy) Wait(ctx context.Context, attempt int, delay time.Duration) bool {
+	// Honour an explicit retry limit first
+	if p.MaxRetries >= 0 && attempt > p.MaxRetries {
+		return false
+	}
+
+	// Honour the context deadline in place of a fixed attempt count
+	if deadline, ok := ctx.Deadline(); ok {
+		// Give up when the next attempt could not start before the deadline
+		if time.Until(deadline) < delay {
+			return false
+		}
+	} else if p.MaxRetries < 0 && attempt > DefaultMaxRetries {
+		// Without a deadline or a configured limit, fall back to the default attempt count
+		return false
+	}
+
+	// Start a timer for the delay
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	// Wait for either the delay to elapse or the context to be done
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Delay returns how long to wait before the given retry attempt, preferring the server's Retry-After hint.
+func (p RetryPolicy) Delay(attempt int, err error) time.Duration {
+	// Use the Retry-After header when the server sent one
+	if apiErr, ok := AsAPIError(err); ok && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+
+	// Otherwise fall back to the jittered exponential backoff
+	return p.Backoff(attempt)
+}
+
+// IsRetryable reports whether a failed request should be retried. Rate limiting (except quota errors) is always
+// retried, server unavailability and connection resets only for idempotent methods, and any status code listed in
+// retryOn regardless of the method.
+func IsRetryable(method string, code int, err error, retryOn ...int) bool {
+	// Never retry once the caller or a nested retry gave up
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errRetriesExhausted) {
+		return false
+	}
+
+	// Retry the status codes requested by the caller
+	for _, c := range retryOn {
+		if code == c {
+			return true
+		}
+	}
+
+	// Retry rate limiting unless a quota was exceeded, which will not resolve by waiting
+	if code == http.StatusTooManyRequests {
+		return !IsQuotaExceeded(err)
+	}
+
+	// Only idempotent requests are safe to repeat after the server may have processed them
+	if !isIdempotent(method) {
+		return false
+	}
+
+	// Retry transient gateway and availability errors
+	switch code {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	// Retry connections dropped before a response was received
+	return code == 0 && (errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF))
+}
+
+// Retry invokes fn until it succeeds, fails with an error that is not retryable for the given HTTP method, or the
+// retry policy gives up. Status codes listed in retryOn are retried in addition to the policy defaults.
+func (c *Client) Retry(ctx context.Context, method string, fn func() (int, error), retryOn ...int) (int, error) {
+	for attempt := 1; ; attempt++ {
+		// Perform the attempt
+		code, err := fn()
+
+		// Return on success or on errors that will not resolve by retrying
+		if err == nil || !IsRetryable(method, code, err, retryOn...) {
+			return code, err
+		}
+
+		// Wait before the next attempt, or report failure once retries are exhausted
+		if !c.RetryPolicy.Wait(ctx, attempt, c.RetryPolicy.Delay(attempt, err)) {
+			return code, fmt.Errorf("%w after %d attempts: %w", errRetriesExhausted, attempt, err)
+		}
+	}
+}
+
+// doRequestWithRetry builds and executes an HTTP request, retrying it according to the client's retry policy.
+func (c *Client) doRequestWithRetry(ctx context.Context, method string, url string, body []byte, contentType string, retryOn ...int) ([]byte, int, error) {
+	// Keep the response body of the last attempt and count the attempts
+	var respBody []byte
+	attempt := 0
+
+	// Queries are sent as a POST but only read, so they are as safe to repeat as a GET
+	retryMethod := method
+	if isQueryRequest(method, url) {
+		retryMethod = http.MethodGet
+	}
+
+	code, err := c.Retry(ctx, retryMethod, func() (int, error) {
+		// Count the attempt so it can be reported in the request logs
+		attempt++
+
+		// Rebuild the request so every attempt sends the full body
+		var reader io.Reader
+		if body != nil {
+			reader = strings.NewReader(string(body))
+		}
+
+		// Build the HTTP request bound to the context
+		req, err := http.NewRequestWithContext(withAttempt(ctx, attempt), method, url, reader)
+
+		// If request construction fails, abort immediately
+		if err != nil {
+			return 0, err
+		}
+
+		// Execute the HTTP request
+		var code int
+		respBody, code, err = c.doRequest(req, contentType)
+
+		return code, err
+	}, retryOn...)
+
+	// Return the response of the last attempt
+	return respBody, code, err
+}
+
+// isIdempotent reports whether repeating a request with the given method has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// isQueryRequest reports whether the request runs a query, which the API receives as a POST to a "/-query" endpoint.
+func isQueryRequest(method string, url string) bool {
+	return method == http.MethodPost && strings.HasSuffix(url, "/-query")
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	// Ignore missing headers
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	// Accept a number of seconds
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+
+		return time.Duration(seconds) * time.Second
+	}
+
+	// Accept an HTTP date
+	if at, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(at); delay > 0 {
+			return delay
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,234 @@
+package cpln
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// TestBackoff verifies the backoff doubles with every attempt, stays within its cap and keeps at least half of its value.
+func TestBackoff(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name    string
+		policy  RetryPolicy
+		attempt int
+		want    time.Duration
+	}{
+		{name: "first attempt", policy: RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Minute}, attempt: 1, want: time.Second},
+		{name: "third attempt", policy: RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Minute}, attempt: 3, want: 4 * time.Second},
+		{name: "capped", policy: RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}, attempt: 10, want: 5 * time.Second},
+		{name: "defaults", policy: RetryPolicy{}, attempt: 1, want: DefaultInitialBackoff},
+		{name: "default cap", policy: RetryPolicy{}, attempt: 100, want: DefaultMaxBackoff},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Sample the jittered backoff several times
+			for i := 0; i < 50; i++ {
+				if got := tc.policy.Backoff(tc.attempt); got < tc.want/2 || got > tc.want {
+					t.Fatalf("Backoff(%d) = %s, want within [%s, %s]", tc.attempt, got, tc.want/2, tc.want)
+				}
+			}
+		})
+	}
+}
+
+// TestParseRetryAfter verifies the Retry-After header is accepted in seconds or as an HTTP date.
+func TestParseRetryAfter(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name  string
+		value string
+		min   time.Duration
+		max   time.Duration
+	}{
+		{name: "missing", value: "", min: 0, max: 0},
+		{name: "seconds", value: "7", min: 7 * time.Second, max: 7 * time.Second},
+		{name: "padded seconds", value: " 3 ", min: 3 * time.Second, max: 3 * time.Second},
+		{name: "negative seconds", value: "-5", min: 0, max: 0},
+		{name: "future date", value: time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), min: 58 * time.Second, max: time.Minute},
+		{name: "past date", value: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), min: 0, max: 0},
+		{name: "invalid", value: "soon", min: 0, max: 0},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			if got := parseRetryAfter(tc.value); got < tc.min || got > tc.max {
+				t.Fatalf("parseRetryAfter(%q) = %s, want within [%s, %s]", tc.value, got, tc.min, tc.max)
+			}
+		})
+	}
+}
+
+// TestIsRetryable verifies which failures are retried for each kind of request.
+func TestIsRetryable(t *testing.T) {
+	// Build the API errors returned by the server
+	unavailable := &APIError{StatusCode: http.StatusServiceUnavailable}
+	rateLimited := &APIError{StatusCode: http.StatusTooManyRequests}
+	quotaExceeded := &APIError{StatusCode: http.StatusTooManyRequests, Body: []byte(`{"message":"Quota exceeded"}`)}
+	conflict := &APIError{StatusCode: http.StatusConflict}
+
+	// Define the table of cases
+	cases := []struct {
+		name    string
+		method  string
+		code    int
+		err     error
+		retryOn []int
+		want    bool
+	}{
+		{name: "unavailable get", method: http.MethodGet, code: http.StatusServiceUnavailable, err: unavailable, want: true},
+		{name: "unavailable delete", method: http.MethodDelete, code: http.StatusServiceUnavailable, err: unavailable, want: true},
+		{name: "unavailable post", method: http.MethodPost, code: http.StatusServiceUnavailable, err: unavailable, want: false},
+		{name: "unavailable patch", method: http.MethodPatch, code: http.StatusServiceUnavailable, err: unavailable, want: false},
+		{name: "rate limited post", method: http.MethodPost, code: http.StatusTooManyRequests, err: rateLimited, want: true},
+		{name: "quota exceeded", method: http.MethodGet, code: http.StatusTooManyRequests, err: quotaExceeded, want: false},
+		{name: "conflict", method: http.MethodDelete, code: http.StatusConflict, err: conflict, want: false},
+		{name: "conflict requested", method: http.MethodDelete, code: http.StatusConflict, err: conflict, retryOn: []int{http.StatusConflict}, want: true},
+		{name: "connection reset get", method: http.MethodGet, err: fmt.Errorf("read: %w", syscall.ECONNRESET), want: true},
+		{name: "connection reset post", method: http.MethodPost, err: fmt.Errorf("read: %w", syscall.ECONNRESET), want: false},
+		{name: "unexpected eof", method: http.MethodPut, err: io.ErrUnexpectedEOF, want: true},
+		{name: "other transport error", method: http.MethodGet, err: errors.New("no such host"), want: false},
+		{name: "canceled", method: http.MethodGet, code: http.StatusServiceUnavailable, err: fmt.Errorf("%w", context.Canceled), want: false},
+		{name: "exhausted", method: http.MethodGet, code: http.StatusServiceUnavailable, err: fmt.Errorf("%w: %w", errRetriesExhausted, unavailable), want: false},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsRetryable(tc.method, tc.code, tc.err, tc.retryOn...); got != tc.want {
+				t.Fatalf("IsRetryable(%s, %d, %v) = %t, want %t", tc.method, tc.code, tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestRetry verifies requests are retried against a live server until they succeed or the retry policy gives up.
+func TestRetry(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name         string
+		method       string
+		path         string
+		failures     int32
+		status       int
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{name: "get recovers", method: http.MethodGet, path: "/org/my-org/gvc/my-gvc", failures: 2, status: http.StatusServiceUnavailable, wantAttempts: 3},
+		{name: "get gives up", method: http.MethodGet, path: "/org/my-org/gvc/my-gvc", failures: 10, status: http.StatusBadGateway, wantAttempts: 4, wantErr: true},
+		{name: "create is not repeated", method: http.MethodPost, path: "/org/my-org/gvc", failures: 1, status: http.StatusServiceUnavailable, wantAttempts: 1, wantErr: true},
+		{name: "query recovers", method: http.MethodPost, path: "/org/my-org/gvc/-query", failures: 2, status: http.StatusGatewayTimeout, wantAttempts: 3},
+		{name: "rate limited create recovers", method: http.MethodPost, path: "/org/my-org/gvc", failures: 1, status: http.StatusTooManyRequests, wantAttempts: 2},
+		{name: "client error is not retried", method: http.MethodGet, path: "/org/my-org/gvc/my-gvc", failures: 1, status: http.StatusBadRequest, wantAttempts: 1, wantErr: true},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Fail the first requests, then succeed
+			var attempts atomic.Int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) <= tc.failures {
+					w.WriteHeader(tc.status)
+					return
+				}
+				io.WriteString(w, `{}`)
+			})
+
+			// Send the request
+			_, _, err := c.doRequestWithRetry(context.Background(), tc.method, c.HostURL+tc.path, []byte(`{}`), "application/json")
+
+			// Verify the outcome and the number of attempts
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := attempts.Load(); got != tc.wantAttempts {
+				t.Fatalf("attempts = %d, want %d", got, tc.wantAttempts)
+			}
+
+			// Verify the retries stop for good once exhausted
+			if tc.wantErr && tc.wantAttempts > 1 && !errors.Is(err, errRetriesExhausted) {
+				t.Fatalf("expected the retries to be exhausted, got %v", err)
+			}
+		})
+	}
+}
+
+// TestRetryConnectionReset verifies a connection dropped before the response is retried for reads only.
+func TestRetryConnectionReset(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name         string
+		method       string
+		path         string
+		wantAttempts int32
+	}{
+		{name: "get", method: http.MethodGet, path: "/org/my-org/gvc/my-gvc", wantAttempts: 2},
+		{name: "query", method: http.MethodPost, path: "/org/my-org/gvc/-query", wantAttempts: 2},
+		{name: "create", method: http.MethodPost, path: "/org/my-org/gvc", wantAttempts: 1},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Drop the connection of the first request, then succeed
+			var attempts atomic.Int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) == 1 {
+					conn, _, _ := w.(http.Hijacker).Hijack()
+					conn.Close()
+					return
+				}
+				io.WriteString(w, `{}`)
+			})
+
+			// Send the request
+			c.doRequestWithRetry(context.Background(), tc.method, c.HostURL+tc.path, []byte(`{}`), "application/json")
+
+			// Verify the number of attempts
+			if got := attempts.Load(); got != tc.wantAttempts {
+				t.Fatalf("attempts = %d, want %d", got, tc.wantAttempts)
+			}
+		})
+	}
+}
+
+// TestRetryAfterHeader verifies the delay requested by the server is honoured before retrying.
+func TestRetryAfterHeader(t *testing.T) {
+	// Rate limit the first request for one second
+	var attempts atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		io.WriteString(w, `{}`)
+	})
+
+	// Send the request
+	start := time.Now()
+	if _, _, err := c.doRequestWithRetry(context.Background(), http.MethodGet, c.HostURL+"/org/my-org", nil, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Verify the retry waited for the requested delay instead of the short backoff
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("retried after %s, want at least 1s", elapsed)
+	}
+}
@@ -1,16 +1,12 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 )
 
 // Secrets
-type Secrets struct {
-	Kind  string   `json:"kind,omitempty"`
-	Items []Secret `json:"items,omitempty"`
-	Links []Link   `json:"links,omitempty"`
-	Query Query    `json:"query,omitempty"`
-}
+type Secrets = QueryResult[Secret]
 
 // Secret - Secret
 type Secret struct {
@@ -21,9 +17,9 @@ type Secret struct {
 }
 
 // GetSecret - Get secret by name
-func (c *Client) GetSecret(name string) (*Secret, int, error) {
+func (c *Client) GetSecret(ctx context.Context, name string) (*Secret, int, error) {
 
-	secret, code, err := c.GetResource(fmt.Sprintf("secret/%s/-reveal", name), new(Secret))
+	secret, code, err := c.GetResource(ctx, fmt.Sprintf("secret/%s/-reveal", name), new(Secret))
 
 	if err != nil {
 		return nil, code, err
@@ -33,28 +29,28 @@ func (c *Client) GetSecret(name string) (*Secret, int, error) {
 }
 
 // CreateSecret - Create a new Secret
-func (c *Client) CreateSecret(secret Secret) (*Secret, int, error) {
+func (c *Client) CreateSecret(ctx context.Context, secret Secret) (*Secret, int, error) {
 
-	code, err := c.CreateResource("secret", *secret.Name, secret)
+	code, err := c.CreateResource(ctx, "secret", *secret.Name, secret)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetSecret(*secret.Name)
+	return c.GetSecret(ctx, *secret.Name)
 }
 
 // UpdateSecret - Update an existing secret
-func (c *Client) UpdateSecret(secret Secret) (*Secret, int, error) {
+func (c *Client) UpdateSecret(ctx context.Context, secret Secret) (*Secret, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("secret/%s", *secret.Name), secret)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("secret/%s", *secret.Name), secret)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetSecret(*secret.Name)
+	return c.GetSecret(ctx, *secret.Name)
 }
 
 // DeleteSecret - Delete secret by name
-func (c *Client) DeleteSecret(name string) error {
-	return c.DeleteResource(fmt.Sprintf("secret/%s", name))
+func (c *Client) DeleteSecret(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("secret/%s", name))
 }
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,9 +24,9 @@ type ServiceAccountKey struct {
 }
 
 // GetServiceAccount - Get Service Account by name
-func (c *Client) GetServiceAccount(name string) (*ServiceAccount, int, error) {
+func (c *Client) GetServiceAccount(ctx context.Context, name string) (*ServiceAccount, int, error) {
 
-	serviceAccount, code, err := c.GetResource(fmt.Sprintf("serviceaccount/%s", name), new(ServiceAccount))
+	serviceAccount, code, err := c.GetResource(ctx, fmt.Sprintf("serviceaccount/%s", name), new(ServiceAccount))
 
 	if err != nil {
 		return nil, code, err
@@ -35,18 +36,18 @@ func (c *Client) GetServiceAccount(name string) (*ServiceAccount, int, error) {
 }
 
 // CreateServiceAccount - Create a new Service Account
-func (c *Client) CreateServiceAccount(serviceaccount ServiceAccount) (*ServiceAccount, int, error) {
+func (c *Client) CreateServiceAccount(ctx context.Context, serviceaccount ServiceAccount) (*ServiceAccount, int, error) {
 
-	code, err := c.CreateResource("serviceaccount", *serviceaccount.Name, serviceaccount)
+	code, err := c.CreateResource(ctx, "serviceaccount", *serviceaccount.Name, serviceaccount)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetServiceAccount(*serviceaccount.Name)
+	return c.GetServiceAccount(ctx, *serviceaccount.Name)
 }
 
 // AddServiceAccountKey - Add Service Account Key
-func (c *Client) AddServiceAccountKey(serviceAccountName, description string) (*ServiceAccountKey, error) {
+func (c *Client) AddServiceAccountKey(ctx context.Context, serviceAccountName, description string) (*ServiceAccountKey, error) {
 
 	key := make(map[string]string)
 
@@ -57,7 +58,7 @@ func (c *Client) AddServiceAccountKey(serviceAccountName, description string) (*
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/org/%s/serviceaccount/%s/-addKey", c.HostURL, c.Org, serviceAccountName), strings.NewReader(string(s)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/org/%s/serviceaccount/%s/-addKey", c.HostURL, c.Org, serviceAccountName), strings.NewReader(string(s)))
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +81,7 @@ func (c *Client) AddServiceAccountKey(serviceAccountName, description string) (*
 }
 
 // RemoveServiceAccountKey = Remove Service Account Key
-func (c *Client) RemoveServiceAccountKey(serviceAccountName, keyName string) error {
+func (c *Client) RemoveServiceAccountKey(ctx context.Context, serviceAccountName, keyName string) error {
 
 	removeKey := make(map[string][]string)
 
@@ -93,7 +94,7 @@ func (c *Client) RemoveServiceAccountKey(serviceAccountName, keyName string) err
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/org/%s/serviceaccount/%s", c.HostURL, c.Org, serviceAccountName), strings.NewReader(string(s)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/org/%s/serviceaccount/%s", c.HostURL, c.Org, serviceAccountName), strings.NewReader(string(s)))
 	if err != nil {
 		return err
 	}
@@ -107,17 +108,17 @@ func (c *Client) RemoveServiceAccountKey(serviceAccountName, keyName string) err
 }
 
 // UpdateServiceAccount - Update an existing ServiceAccount
-func (c *Client) UpdateServiceAccount(serviceaccount ServiceAccount) (*ServiceAccount, int, error) {
+func (c *Client) UpdateServiceAccount(ctx context.Context, serviceaccount ServiceAccount) (*ServiceAccount, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("serviceaccount/%s", *serviceaccount.Name), serviceaccount)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("serviceaccount/%s", *serviceaccount.Name), serviceaccount)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetServiceAccount(*serviceaccount.Name)
+	return c.GetServiceAccount(ctx, *serviceaccount.Name)
 }
 
 // DeleteServiceAccount - Delete ServiceAccount by name
-func (c *Client) DeleteServiceAccount(name string) error {
-	return c.DeleteResource(fmt.Sprintf("serviceaccount/%s", name))
+func (c *Client) DeleteServiceAccount(ctx context.Context, name string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("serviceaccount/%s", name))
 }
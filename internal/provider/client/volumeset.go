@@ -1,6 +1,9 @@
 package cpln
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type VolumeSet struct {
 	Base
@@ -70,9 +73,9 @@ type VolumeSetMountOptionsResources struct {
 }
 
 // GetVolumeSet - Get volume set by name
-func (c *Client) GetVolumeSet(name string, gvc string) (*VolumeSet, int, error) {
+func (c *Client) GetVolumeSet(ctx context.Context, name string, gvc string) (*VolumeSet, int, error) {
 
-	volumeSet, code, err := c.GetResource(fmt.Sprintf("gvc/%s/volumeset/%s", gvc, name), new(VolumeSet))
+	volumeSet, code, err := c.GetResource(ctx, fmt.Sprintf("gvc/%s/volumeset/%s", gvc, name), new(VolumeSet))
 	if err != nil {
 		return nil, code, err
 	}
@@ -81,28 +84,28 @@ func (c *Client) GetVolumeSet(name string, gvc string) (*VolumeSet, int, error)
 }
 
 // CreateVolumeSet - Create a new volume set by name
-func (c *Client) CreateVolumeSet(volumeSet VolumeSet, gvc string) (*VolumeSet, int, error) {
+func (c *Client) CreateVolumeSet(ctx context.Context, volumeSet VolumeSet, gvc string) (*VolumeSet, int, error) {
 
-	code, err := c.CreateResource(fmt.Sprintf("gvc/%s/volumeset", gvc), *volumeSet.Name, volumeSet)
+	code, err := c.CreateResource(ctx, fmt.Sprintf("gvc/%s/volumeset", gvc), *volumeSet.Name, volumeSet)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetVolumeSet(*volumeSet.Name, gvc)
+	return c.GetVolumeSet(ctx, *volumeSet.Name, gvc)
 }
 
 // UpdateVolumeSet - Update an existing volume set
-func (c *Client) UpdateVolumeSet(volumeSet VolumeSet, gvc string) (*VolumeSet, int, error) {
+func (c *Client) UpdateVolumeSet(ctx context.Context, volumeSet VolumeSet, gvc string) (*VolumeSet, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("gvc/%s/volumeset/%s", gvc, *volumeSet.Name), volumeSet)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("gvc/%s/volumeset/%s", gvc, *volumeSet.Name), volumeSet)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetVolumeSet(*volumeSet.Name, gvc)
+	return c.GetVolumeSet(ctx, *volumeSet.Name, gvc)
 }
 
 // DeleteVolumeSet - Delete volume set by name
-func (c *Client) DeleteVolumeSet(name string, gvc string) error {
-	return c.DeleteResource(fmt.Sprintf("gvc/%s/volumeset/%s", gvc, name))
+func (c *Client) DeleteVolumeSet(ctx context.Context, name string, gvc string) error {
+	return c.DeleteResource(ctx, fmt.Sprintf("gvc/%s/volumeset/%s", gvc, name))
 }
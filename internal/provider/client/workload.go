@@ -1,18 +1,14 @@
 package cpln
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
 // Workloads - GVC Workloads
-type Workloads struct {
-	Kind     string     `json:"kind,omitempty"`
-	ItemKind string     `json:"itemKind,omitempty"`
-	Links    []Link     `json:"links,omitempty"`
-	Items    []Workload `json:"items,omitempty"`
-}
+type Workloads = QueryResult[Workload]
 
 // Workload - GVC Workload
 type Workload struct {
@@ -488,31 +484,20 @@ type WorkloadStatusLoadBalancer struct {
 }
 
 // GetWorkloads - Get Workloads by GVC name
-func (c *Client) GetWorkloads(gvcName string) (*[]Workload, int, error) {
-
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/org/%s/gvc/%s/workload", c.HostURL, c.Org, gvcName), nil)
-	if err != nil {
-		return nil, 0, err
-	}
+func (c *Client) GetWorkloads(ctx context.Context, gvcName string) (*[]Workload, int, error) {
 
-	body, code, err := c.doRequest(req, "")
+	workloads, code, err := QueryGvcKind[Workload](ctx, c, gvcName, "workload", NewQuery("workload", QueryMatchAll))
 	if err != nil {
 		return nil, code, err
 	}
 
-	workloads := Workloads{}
-	err = json.Unmarshal(body, &workloads)
-	if err != nil {
-		return nil, 0, err
-	}
-
 	return &workloads.Items, code, nil
 }
 
 // GetWorkload - Get Workload by name
-func (c *Client) GetWorkload(name, gvcName string) (*Workload, int, error) {
+func (c *Client) GetWorkload(ctx context.Context, name, gvcName string) (*Workload, int, error) {
 
-	workload, code, err := c.GetResource(fmt.Sprintf("gvc/%s/workload/%s", gvcName, name), new(Workload))
+	workload, code, err := c.GetResource(ctx, fmt.Sprintf("gvc/%s/workload/%s", gvcName, name), new(Workload))
 	if err != nil {
 		return nil, code, err
 	}
@@ -523,33 +508,100 @@ func (c *Client) GetWorkload(name, gvcName string) (*Workload, int, error) {
 }
 
 // CreateWorkload - Create a new Workload
-func (c *Client) CreateWorkload(workload Workload, gvcName string) (*Workload, int, error) {
+func (c *Client) CreateWorkload(ctx context.Context, workload Workload, gvcName string) (*Workload, int, error) {
 
 	// log.Printf("[INFO] About to create Workload with Name: %s", workload.Name)
 
-	code, err := c.CreateResource(fmt.Sprintf("gvc/%s/workload", gvcName), *workload.Name, workload)
+	code, err := c.CreateResource(ctx, fmt.Sprintf("gvc/%s/workload", gvcName), *workload.Name, workload)
 	if err != nil {
 		return nil, code, err
 	}
 
 	// log.Printf("[INFO] Created Workload with Name: %s", workload.Name)
 
-	return c.GetWorkload(*workload.Name, gvcName)
+	return c.GetWorkload(ctx, *workload.Name, gvcName)
 }
 
 // UpdateWorkload - Update an existing workload
-func (c *Client) UpdateWorkload(workload Workload, gvcName string) (*Workload, int, error) {
+func (c *Client) UpdateWorkload(ctx context.Context, workload Workload, gvcName string) (*Workload, int, error) {
 
-	code, err := c.UpdateResource(fmt.Sprintf("gvc/%s/workload/%s", gvcName, *workload.Name), workload)
+	code, err := c.UpdateResource(ctx, fmt.Sprintf("gvc/%s/workload/%s", gvcName, *workload.Name), workload)
 	if err != nil {
 		return nil, code, err
 	}
 
-	return c.GetWorkload(*workload.Name, gvcName)
+	return c.GetWorkload(ctx, *workload.Name, gvcName)
 }
 
 // DeleteWorkload - Delete Workload by name
-func (c *Client) DeleteWorkload(name, gvcName string) error {
+func (c *Client) DeleteWorkload(ctx context.Context, name, gvcName string) error {
 	// log.Printf("[INFO] Deleting Workload with name: %s", name)
-	return c.DeleteResource(fmt.Sprintf("gvc/%s/workload/%s", gvcName, name))
+	return c.DeleteResource(ctx, fmt.Sprintf("gvc/%s/workload/%s", gvcName, name))
+}
+
+// WorkloadDeployments - Deployments of a workload, one per location
+type WorkloadDeployments struct {
+	Kind  string               `json:"kind,omitempty"`
+	Items []WorkloadDeployment `json:"items,omitempty"`
+}
+
+// WorkloadDeployment - Deployment of a workload to a single location (read-only)
+type WorkloadDeployment struct {
+	Name   *string                   `json:"name,omitempty"`
+	Status *WorkloadDeploymentStatus `json:"status,omitempty"`
+}
+
+// WorkloadDeploymentStatus - Deployment Status
+type WorkloadDeploymentStatus struct {
+	Ready    *bool                        `json:"ready,omitempty"`
+	Message  *string                      `json:"message,omitempty"`
+	Versions *[]WorkloadDeploymentVersion `json:"versions,omitempty"`
+}
+
+// WorkloadDeploymentVersion - Workload version running within a deployment
+type WorkloadDeploymentVersion struct {
+	Name     *string `json:"name,omitempty"`
+	Workload *int    `json:"workload,omitempty"`
+	Ready    *bool   `json:"ready,omitempty"`
+	Message  *string `json:"message,omitempty"`
+}
+
+// GetWorkloadDeployments - Get the deployments of a workload
+func (c *Client) GetWorkloadDeployments(ctx context.Context, name, gvcName string) ([]WorkloadDeployment, error) {
+	body, _, err := c.doRequestWithRetry(ctx, http.MethodGet, fmt.Sprintf("%s/org/%s/gvc/%s/workload/%s/deployment", c.HostURL, c.Org, gvcName, name), nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := WorkloadDeployments{}
+	if err := json.Unmarshal(body, &deployments); err != nil {
+		return nil, err
+	}
+
+	return deployments.Items, nil
+}
+
+// PendingWorkloadDeployments returns the locations that do not run a ready deployment of the given workload version
+// or a newer one yet. Until then, the health of the workload still describes the previous rollout.
+func PendingWorkloadDeployments(deployments []WorkloadDeployment, version int) []string {
+	pending := []string{}
+
+	for _, deployment := range deployments {
+		// Look for a ready deployment of the version or a newer one
+		rolledOut := false
+		if deployment.Status != nil && deployment.Status.Versions != nil {
+			for _, deployed := range *deployment.Status.Versions {
+				if deployed.Workload != nil && *deployed.Workload >= version && deployed.Ready != nil && *deployed.Ready {
+					rolledOut = true
+					break
+				}
+			}
+		}
+
+		if !rolledOut && deployment.Name != nil {
+			pending = append(pending, *deployment.Name)
+		}
+	}
+
+	return pending
 }
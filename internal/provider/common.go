@@ -1,27 +1,39 @@
 package cpln
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/common"
 	domainmodel "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/domain"
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/modifiers"
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,6 +45,12 @@ type HasEntityID interface {
 	GetID() types.String
 }
 
+// HasEntityOrg defines an interface for types that provide the org an entity belongs to.
+type HasEntityOrg interface {
+	// GetOrg returns the org the entity belongs to as a types.String.
+	GetOrg() types.String
+}
+
 /*** Entity Base Model ***/
 
 // EntityBaseModel holds the shared attributes for Terraform entities.
@@ -43,6 +61,7 @@ type EntityBaseModel struct {
 	Description types.String `tfsdk:"description"`
 	Tags        types.Map    `tfsdk:"tags"`
 	SelfLink    types.String `tfsdk:"self_link"`
+	Org         types.String `tfsdk:"org"`
 }
 
 // Fill updates the api client from EntityBaseModel.
@@ -67,12 +86,23 @@ func (b *EntityBaseModel) From(api client.Base) {
 	b.SelfLink = FlattenSelfLink(api.Links)
 }
 
+// IsImported reports whether the model holds the state of a freshly imported resource, where only the ID is known.
+func (b EntityBaseModel) IsImported() bool {
+	return !b.ID.IsNull() && b.Name.IsNull()
+}
+
 // GetID returns the ID field from the entity base model.
 func (b EntityBaseModel) GetID() types.String {
 	// Return the stored ID value
 	return b.ID
 }
 
+// GetOrg returns the org field from the entity base model.
+func (b EntityBaseModel) GetOrg() types.String {
+	// Return the stored org value
+	return b.Org
+}
+
 /*** Entity Base ***/
 
 // EntityBase is the base entity (resource/data-source) implementation.
@@ -105,6 +135,45 @@ func (r *EntityBase) EntityBaseConfigure(ctx context.Context, providerData any,
 	r.client = c
 }
 
+// ModifyPlan fails the plan of an entity that was changed outside of Terraform, when configured to.
+func (r *EntityBase) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.CheckExternalChanges(ctx, req, resp)
+}
+
+// CheckExternalChanges reports the changes recorded by the last read as an error, unless the entity is being destroyed.
+// Resources implementing their own ModifyPlan must call it.
+func (r *EntityBase) CheckExternalChanges(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Never block the destruction of the entity
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Report the changes recorded by the last read
+	ReportExternalChanges(ctx, &resp.Diagnostics, req.Private)
+}
+
+// ReportExternalChanges adds an error describing the changes recorded in the private state by the last read, if any.
+func ReportExternalChanges(ctx context.Context, diags *diag.Diagnostics, private PrivateStateReader) {
+	// Load the changes recorded by the last read, if any
+	payload, getDiags := private.GetKey(ctx, driftReportPrivateStateKey)
+	diags.Append(getDiags...)
+	if len(payload) == 0 {
+		return
+	}
+
+	var report DriftReport
+	if err := json.Unmarshal(payload, &report); err != nil {
+		return
+	}
+
+	// Fail the plan
+	diags.AddError(
+		"External Changes Detected",
+		report.Detail+" Revert these changes, or update the configuration to match them and run "+
+			"`terraform apply -refresh-only` to record them in the state.",
+	)
+}
+
 // EntityBaseAttributes returns a map of attributes for a given entity name.
 func (r *EntityBase) EntityBaseAttributes(entityName string) map[string]schema.Attribute {
 	return map[string]schema.Attribute{
@@ -143,6 +212,7 @@ func (r *EntityBase) EntityBaseAttributes(entityName string) map[string]schema.A
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"org": r.OrgSchema(entityName),
 	}
 }
 
@@ -221,6 +291,24 @@ func (r *EntityBase) DescriptionSchema(entityName string) schema.StringAttribute
 	}
 }
 
+// OrgSchema returns a StringAttribute schema for the org the entity belongs to, defaulting to the provider org.
+// The org is resolved once at creation and kept from the state afterwards, so changing the provider org never moves or
+// replaces existing resources, while changing the attribute itself does.
+func (r *EntityBase) OrgSchema(entityName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The org the %s belongs to. Defaults to the org configured in the provider when the resource is created. Existing resources keep the org recorded in the state when the provider org changes. Changing it replaces the resource.", entityName),
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
 // QuerySchema returns the nested block schema for query configuration.
 func (r *EntityBase) QuerySchema() schema.NestedBlockObject {
 	return schema.NestedBlockObject{
@@ -380,6 +468,16 @@ func (r *EntityBase) CustomTagsTracingSchema() schema.MapAttribute {
 	}
 }
 
+// TimeoutsSchema returns the timeouts block that configures per-operation deadlines for a resource.
+func (r *EntityBase) TimeoutsSchema(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
 // Validators //
 
 // GetPortValidators returns an Int32 validator that enforces a valid TCP port range (80–65535)
@@ -390,6 +488,39 @@ func (r *EntityBase) GetPortValidators() []validator.Int32 {
 	}
 }
 
+/*** Timeouts ***/
+
+// Default per-operation timeouts, applied when the timeouts block leaves the operation unset.
+const (
+	DefaultCreateTimeout = 20 * time.Minute
+	DefaultReadTimeout   = 5 * time.Minute
+	DefaultUpdateTimeout = 20 * time.Minute
+	DefaultDeleteTimeout = 20 * time.Minute
+)
+
+// TimeoutsSource is implemented by tfsdk.Plan, tfsdk.State and tfsdk.Config.
+type TimeoutsSource interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// TimeoutResolver resolves a single operation timeout, e.g. timeouts.Value.Create.
+type TimeoutResolver func(t timeouts.Value, ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics)
+
+// WithOperationTimeout derives a context bounded by the timeout configured in the timeouts block of the source, so
+// that client retry and polling loops stop at the deadline. The returned cancel function must always be called.
+func WithOperationTimeout(ctx context.Context, diags *diag.Diagnostics, source TimeoutsSource, resolve TimeoutResolver, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	// Read the timeouts block from the source
+	var configured timeouts.Value
+	diags.Append(source.GetAttribute(ctx, path.Root("timeouts"), &configured)...)
+
+	// Resolve the operation timeout, falling back to the default when unset
+	timeout, d := resolve(configured, ctx, defaultTimeout)
+	diags.Append(d...)
+
+	// Bound the context by the resolved timeout
+	return context.WithTimeout(ctx, timeout)
+}
+
 /*** Entity Operator Interface ***/
 
 // EntityOperatorInterface is a generic interface for entity operations.
@@ -641,12 +772,217 @@ func (eo *EntityOperator[Plan]) FlattenLinksSet(state types.Set, input *[]string
 	return FlattenLinkSet(eo.Diags, state, input, org)
 }
 
+/*** Entity Identity ***/
+
+// EntityIdentity describes how Terraform addresses an entity without relying on its state.
+type EntityIdentity struct {
+	Kind        string
+	IsGvcScoped bool
+}
+
+// Schema returns the identity schema of the entity.
+func (ei EntityIdentity) Schema() identityschema.Schema {
+	// Define the attributes shared by every entity
+	attributes := map[string]identityschema.Attribute{
+		"org": identityschema.StringAttribute{
+			Description:       "The org the entity belongs to. Defaults to the org configured in the provider.",
+			OptionalForImport: true,
+		},
+		"kind": identityschema.StringAttribute{
+			Description:       fmt.Sprintf("The kind of the entity, always `%s`.", ei.Kind),
+			OptionalForImport: true,
+		},
+		"name": identityschema.StringAttribute{
+			Description:       "The name of the entity.",
+			RequiredForImport: true,
+		},
+	}
+
+	// Entities scoped to a GVC are only unique within it
+	if ei.IsGvcScoped {
+		attributes["gvc"] = identityschema.StringAttribute{
+			Description:       "The name of the GVC the entity belongs to.",
+			RequiredForImport: true,
+		}
+	}
+
+	// Return the constructed identity schema
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// Set populates the identity of the entity with the given name within the org and GVC.
+func (ei EntityIdentity) Set(ctx context.Context, identity *tfsdk.ResourceIdentity, org string, gvc string, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Set the attributes shared by every entity
+	diags.Append(identity.SetAttribute(ctx, path.Root("org"), types.StringValue(org))...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("kind"), types.StringValue(ei.Kind))...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("name"), types.StringValue(name))...)
+
+	// Set the GVC when the entity is scoped to one
+	if ei.IsGvcScoped {
+		diags.Append(identity.SetAttribute(ctx, path.Root("gvc"), types.StringValue(gvc))...)
+	}
+
+	// Return the collected diagnostics
+	return diags
+}
+
+// SetFromState populates the identity of the entity from the name and GVC persisted in its state.
+func (ei EntityIdentity) SetFromState(ctx context.Context, identity *tfsdk.ResourceIdentity, state tfsdk.State, org string) diag.Diagnostics {
+	var name, gvc types.String
+
+	// Read the name of the entity from the state
+	diags := state.GetAttribute(ctx, path.Root("name"), &name)
+
+	// Read the GVC of the entity from the state when it is scoped to one
+	if ei.IsGvcScoped {
+		diags.Append(state.GetAttribute(ctx, path.Root("gvc"), &gvc)...)
+	}
+
+	// Abort if the state could not be read
+	if diags.HasError() {
+		return diags
+	}
+
+	// Populate the identity within the org persisted in the state, if any
+	diags.Append(ei.Set(ctx, identity, StateOrg(ctx, state, org), gvc.ValueString(), name.ValueString())...)
+
+	// Return the collected diagnostics
+	return diags
+}
+
+// ImportState maps the identity provided in an import block to the "id" and "gvc" attributes in the state.
+func (ei EntityIdentity) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, org string) {
+	var orgName, gvc, name types.String
+
+	// Read the identity attributes
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("org"), &orgName)...)
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	// Read the GVC when the entity is scoped to one
+	if ei.IsGvcScoped {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("gvc"), &gvc)...)
+	}
+
+	// Abort if the identity could not be read
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Manage the entity within the org of the identity when it differs from the provider org
+	if !orgName.IsNull() && orgName.ValueString() != "" && orgName.ValueString() != org {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org"), orgName)...)
+		org = orgName.ValueString()
+	}
+
+	// Set the ID attribute in the Terraform state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+
+	// Set the GVC attribute in the Terraform state when the entity is scoped to one
+	if ei.IsGvcScoped {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gvc"), gvc)...)
+	}
+
+	// Complete the identity with the attributes the practitioner left out
+	resp.Diagnostics.Append(ei.Set(ctx, resp.Identity, org, gvc.ValueString(), name.ValueString())...)
+}
+
+/*** Import ***/
+
+// ImportGvcScopedState maps the import ID of a resource within a GVC to the "id" and "gvc" attributes in the state.
+// The ID is either "GVC_NAME:NAME" or the full or org-relative self link of the resource.
+func ImportGvcScopedState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, kind string, org string) {
+	// Parse the import ID
+	linkOrg, gvc, name, err := ParseGvcScopedImportId(req.ID, kind)
+
+	// Report the accepted formats when the import ID cannot be parsed
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Invalid import identifier: %s. Expected one of the formats: "+
+					"'GVC_NAME:%[2]s_NAME', '/org/%[3]s/gvc/GVC_NAME/%[4]s/%[2]s_NAME' or '//gvc/GVC_NAME/%[4]s/%[2]s_NAME'.",
+				err.Error(), strings.ToUpper(kind), org, kind,
+			),
+		)
+
+		// Abort import operation on error
+		return
+	}
+
+	// Set the generated ID attribute in the Terraform state
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(name))...,
+	)
+
+	// Set the GVC attribute in the Terraform state
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("gvc"), types.StringValue(gvc))...,
+	)
+
+	// Manage the entity within the org of the self link when it differs from the provider org
+	if linkOrg != "" && linkOrg != org {
+		SetStateOrg(ctx, &resp.Diagnostics, &resp.State, linkOrg)
+	}
+}
+
 /*** Entity Operations ***/
 
 // EntityOperations bundles the provider-specific callbacks.
 type EntityOperations[Plan any, APIObject any] struct {
 	IdFromPlan  func(plan Plan) string
 	NewOperator func(ctx context.Context, diags *diag.Diagnostics, plan Plan) EntityOperatorInterface[Plan, APIObject]
+
+	// Org and Identity populate the resource identity after every operation, when the resource supports one
+	Org      string
+	Identity *EntityIdentity
+
+	// DriftIgnoredPaths lists the API field paths that other resources are expected to change, such as "spec.ports[*].routes"
+	DriftIgnoredPaths     []string
+	FailOnExternalChanges bool
+
+	// APIFieldAttributes maps the API fields whose attribute is not named after them, such as "spec.containers" to "container"
+	APIFieldAttributes map[string]string
+}
+
+// AttributeSchema is satisfied by the schema of plans and states.
+type AttributeSchema interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// AttributePathResolver returns a resolver mapping the API fields named in validation failures to the attributes of the
+// schema, reporting fields without a matching attribute as unresolved.
+func (ops EntityOperations[Plan, APIObject]) AttributePathResolver(ctx context.Context, schema AttributeSchema) func(apiPath string) (path.Path, bool) {
+	return func(apiPath string) (path.Path, bool) {
+		// Map the field to the attribute configuring it
+		attributePath := APIPathToAttributePath(apiPath, ops.APIFieldAttributes)
+
+		// Only attach failures to attributes the schema actually has
+		if _, diags := schema.TypeAtPath(ctx, attributePath); diags.HasError() {
+			return path.Empty(), false
+		}
+
+		return attributePath, true
+	}
+}
+
+// OrgOf returns the org the planned entity belongs to, falling back to the provider org.
+func (ops EntityOperations[Plan, APIObject]) OrgOf(plan Plan) string {
+	return EntityOrg(plan, ops.Org)
+}
+
+// SetIdentity populates the resource identity from the state, if the resource supports one.
+func (ops EntityOperations[Plan, APIObject]) SetIdentity(ctx context.Context, diags *diag.Diagnostics, identity *tfsdk.ResourceIdentity, state tfsdk.State) {
+	// Skip resources without an identity
+	if ops.Identity == nil || identity == nil {
+		return
+	}
+
+	// Populate the identity from the state
+	diags.Append(ops.Identity.SetFromState(ctx, identity, state, ops.Org)...)
 }
 
 // NewEntityOperations initializes a new EntityOperations instance.
@@ -656,15 +992,371 @@ func NewEntityOperations[
 	client *client.Client,
 	prototype Operator,
 ) EntityOperations[Plan, APIObject] {
+	// Resolve the org the entities belong to and how changes made outside of Terraform are reported
+	org := ""
+	failOnExternalChanges := false
+	if client != nil {
+		org = client.Org
+		failOnExternalChanges = client.FailOnExternalChanges
+	}
+
 	return EntityOperations[Plan, APIObject]{
-		IdFromPlan: func(p Plan) string { return p.GetID().ValueString() },
+		Org:                   org,
+		FailOnExternalChanges: failOnExternalChanges,
+		IdFromPlan:            func(p Plan) string { return p.GetID().ValueString() },
 		NewOperator: func(ctx context.Context, diags *diag.Diagnostics, plan Plan) EntityOperatorInterface[Plan, APIObject] {
-			prototype.Init(ctx, diags, client, plan)
+			// Target the org the entity belongs to
+			prototype.Init(ctx, diags, client.WithOrg(EntityOrg(plan, org)), plan)
 			return prototype
 		},
 	}
 }
 
+// EntityOrg returns the org set in the given model, or the default org when the model leaves it out.
+func EntityOrg(model any, defaultOrg string) string {
+	// Models without an org always belong to the default org
+	entity, ok := model.(HasEntityOrg)
+	if !ok {
+		return defaultOrg
+	}
+
+	// Fall back to the default org when the org is not known yet
+	org := entity.GetOrg()
+	if org.IsNull() || org.IsUnknown() || org.ValueString() == "" {
+		return defaultOrg
+	}
+
+	// Return the org set in the model
+	return org.ValueString()
+}
+
+// StateOrg returns the org persisted in the state, or the default org when the state holds none.
+func StateOrg(ctx context.Context, state tfsdk.State, defaultOrg string) string {
+	var org types.String
+
+	// Schemas without an org always belong to the default org
+	if _, diags := state.Schema.TypeAtPath(ctx, path.Root("org")); diags.HasError() {
+		return defaultOrg
+	}
+
+	// Read the org from the state
+	if diags := state.GetAttribute(ctx, path.Root("org"), &org); diags.HasError() || org.IsNull() || org.IsUnknown() || org.ValueString() == "" {
+		return defaultOrg
+	}
+
+	// Return the persisted org
+	return org.ValueString()
+}
+
+// SetStateOrg persists the org the entity belongs to, when the schema exposes one.
+func SetStateOrg(ctx context.Context, diags *diag.Diagnostics, state *tfsdk.State, org string) {
+	// Skip schemas without an org
+	if _, typeDiags := state.Schema.TypeAtPath(ctx, path.Root("org")); typeDiags.HasError() {
+		return
+	}
+
+	// Persist the org
+	diags.Append(state.SetAttribute(ctx, path.Root("org"), types.StringValue(org))...)
+}
+
+/*** Drift Detection ***/
+
+// driftBaselinePrivateStateKey is the private state key holding the object as the provider last saw it.
+const driftBaselinePrivateStateKey = "drift_baseline"
+
+// driftReportPrivateStateKey is the private state key holding the changes that must fail the next plan.
+const driftReportPrivateStateKey = "drift_report"
+
+// driftReportedFieldsLimit caps the number of changed fields named in a drift diagnostic.
+const driftReportedFieldsLimit = 10
+
+// driftIgnoredFields lists the top-level API fields that change without any user action.
+var driftIgnoredFields = []string{"id", "kind", "version", "created", "lastModified", "links", "status"}
+
+// driftIndexPattern matches the list indexes within an API field path.
+var driftIndexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// PrivateStateReader is satisfied by the private state of read requests.
+type PrivateStateReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// PrivateStateWriter is satisfied by the private state of create, read and update responses.
+type PrivateStateWriter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// DriftReport describes the changes made outside of Terraform that fail the next plan.
+type DriftReport struct {
+	Detail string `json:"detail"`
+}
+
+// DriftBaseline captures the object as the provider last saw it.
+type DriftBaseline struct {
+	Version      *int              `json:"version,omitempty"`
+	LastModified *string           `json:"lastModified,omitempty"`
+	Fields       map[string]string `json:"fields"`
+}
+
+// NewDriftBaseline captures the version and a digest of every field of the given API object.
+func NewDriftBaseline(apiObject any, ignoredPaths []string) (*DriftBaseline, error) {
+	// Convert the API object into its generic JSON representation
+	payload, err := json.Marshal(apiObject)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]any
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+
+	baseline := DriftBaseline{
+		Fields: map[string]string{},
+	}
+
+	// Capture the version of the object
+	if version, ok := object["version"].(json.Number); ok {
+		if value, err := version.Int64(); err == nil {
+			baseline.Version = IntPointer(int(value))
+		}
+	}
+
+	// Capture the last modification timestamp of the object
+	if lastModified, ok := object["lastModified"].(string); ok {
+		baseline.LastModified = &lastModified
+	}
+
+	// Capture a digest of every field the user is able to change
+	for key, value := range object {
+		// Skip the fields maintained by the platform
+		if slices.Contains(driftIgnoredFields, key) {
+			continue
+		}
+
+		collectDriftFields(baseline.Fields, key, value, ignoredPaths)
+	}
+
+	// Return the captured baseline
+	return &baseline, nil
+}
+
+// IsOutdatedBy reports whether the object has been modified since the baseline was captured.
+func (b DriftBaseline) IsOutdatedBy(current DriftBaseline) bool {
+	// Compare the versions when both are known
+	if b.Version != nil && current.Version != nil && *b.Version != *current.Version {
+		return true
+	}
+
+	// Compare the last modification timestamps when both are known
+	if b.LastModified != nil && current.LastModified != nil && *b.LastModified != *current.LastModified {
+		return true
+	}
+
+	// The object has not been modified, or carries no version metadata to tell
+	return false
+}
+
+// ChangedFields returns the sorted paths of the fields that differ between the baseline and the current object.
+func (b DriftBaseline) ChangedFields(current DriftBaseline) []string {
+	changed := []string{}
+
+	// Collect the fields that were modified or removed
+	for path, digest := range b.Fields {
+		if currentDigest, ok := current.Fields[path]; !ok || currentDigest != digest {
+			changed = append(changed, path)
+		}
+	}
+
+	// Collect the fields that were added
+	for path := range current.Fields {
+		if _, ok := b.Fields[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+
+	// Sort the fields for a stable output
+	sort.Strings(changed)
+
+	return changed
+}
+
+// collectDriftFields records a digest of every leaf value under the given path.
+func collectDriftFields(fields map[string]string, path string, value any, ignoredPaths []string) {
+	// Skip the paths that other resources are expected to change
+	if isDriftIgnoredPath(path, ignoredPaths) {
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		// Record empty objects so that clearing or populating them is detected
+		if len(v) == 0 {
+			break
+		}
+
+		for key, item := range v {
+			// Skip the tags maintained by the platform
+			if path == "tags" && shouldIgnoreTag(key) {
+				continue
+			}
+
+			collectDriftFields(fields, path+"."+key, item, ignoredPaths)
+		}
+
+		return
+	case []any:
+		// Record empty lists so that clearing or populating them is detected
+		if len(v) == 0 {
+			break
+		}
+
+		for index, item := range v {
+			collectDriftFields(fields, fmt.Sprintf("%s[%d]", path, index), item, ignoredPaths)
+		}
+
+		return
+	}
+
+	// Record a digest rather than the value itself to keep secrets out of the private state
+	payload, _ := json.Marshal(value)
+	digest := sha256.Sum256(payload)
+
+	fields[path] = hex.EncodeToString(digest[:])
+}
+
+// isDriftIgnoredPath reports whether the path falls under one of the ignored paths, where "[*]" matches any list index.
+func isDriftIgnoredPath(path string, ignoredPaths []string) bool {
+	// Generalize the list indexes of the path
+	generalized := driftIndexPattern.ReplaceAllString(path, "[*]")
+
+	for _, ignored := range ignoredPaths {
+		if generalized == ignored || strings.HasPrefix(generalized, ignored+".") || strings.HasPrefix(generalized, ignored+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// formatDriftFields lists the changed fields, naming at most driftReportedFieldsLimit of them.
+func formatDriftFields(fields []string) string {
+	// Name every field when there are only a few
+	if len(fields) <= driftReportedFieldsLimit {
+		return strings.Join(fields, ", ")
+	}
+
+	// Summarize the remaining fields
+	return fmt.Sprintf("%s and %d more", strings.Join(fields[:driftReportedFieldsLimit], ", "), len(fields)-driftReportedFieldsLimit)
+}
+
+// RecordDriftBaseline stores the object the provider has just written as the baseline of the next drift detection.
+func (ops EntityOperations[Plan, APIObject]) RecordDriftBaseline(ctx context.Context, diags *diag.Diagnostics, private PrivateStateWriter, apiResp *APIObject) {
+	// Capture the baseline of the object
+	baseline, err := NewDriftBaseline(apiResp, ops.DriftIgnoredPaths)
+
+	// A missing baseline only disables the drift detection, so do not fail the operation
+	if err != nil {
+		return
+	}
+
+	// Persist the baseline in the private state
+	payload, err := json.Marshal(baseline)
+	if err != nil {
+		return
+	}
+
+	diags.Append(private.SetKey(ctx, driftBaselinePrivateStateKey, payload)...)
+}
+
+// RecordedVersion returns the version of the object recorded by the last operation, if any.
+func RecordedVersion(ctx context.Context, private PrivateStateReader) *int {
+	// Load the baseline recorded by the last operation
+	payload, _ := private.GetKey(ctx, driftBaselinePrivateStateKey)
+	if len(payload) == 0 {
+		return nil
+	}
+
+	var baseline DriftBaseline
+	if err := json.Unmarshal(payload, &baseline); err != nil {
+		return nil
+	}
+
+	return baseline.Version
+}
+
+// DetectDrift compares the object read from the API with the stored baseline and reports the fields changed outside of Terraform.
+func (ops EntityOperations[Plan, APIObject]) DetectDrift(ctx context.Context, diags *diag.Diagnostics, previous PrivateStateReader, private PrivateStateWriter, id string, apiResp *APIObject) {
+	// Load the baseline recorded by the last operation
+	payload, getDiags := previous.GetKey(ctx, driftBaselinePrivateStateKey)
+	diags.Append(getDiags...)
+
+	// Capture the current state of the object
+	current, err := NewDriftBaseline(apiResp, ops.DriftIgnoredPaths)
+
+	// A missing baseline only disables the drift detection, so do not fail the read
+	if err != nil {
+		return
+	}
+
+	// Collect the changes that must fail the next plan, if any
+	var report *DriftReport
+
+	// Compare against the stored baseline, if any; imported objects and older states only record one
+	if len(payload) != 0 {
+		var baseline DriftBaseline
+
+		if err := json.Unmarshal(payload, &baseline); err == nil && baseline.IsOutdatedBy(*current) {
+			// Report the fields that were changed outside of Terraform
+			if changed := baseline.ChangedFields(*current); len(changed) != 0 {
+				// Name the object in the diagnostic
+				subject := fmt.Sprintf("%q", id)
+				if ops.Identity != nil {
+					subject = fmt.Sprintf("%s %q", ops.Identity.Kind, id)
+				}
+
+				detail := fmt.Sprintf(
+					"The %s was modified outside of Terraform since it was last applied. Changed fields: %s.",
+					subject, formatDriftFields(changed),
+				)
+
+				// Defer the failure to the plan when requested, so that destroy and refresh-only runs are not blocked
+				if ops.FailOnExternalChanges {
+					report = &DriftReport{Detail: detail}
+				} else {
+					diags.AddWarning(
+						"External Changes Detected",
+						detail+" Terraform will plan to revert these changes unless the configuration is updated to match them.",
+					)
+				}
+			}
+		}
+	}
+
+	// Record the changes that must fail the next plan, clearing the ones reported by a previous read
+	var reportPayload []byte
+	if report != nil {
+		reportPayload, err = json.Marshal(report)
+		if err != nil {
+			return
+		}
+	}
+
+	diags.Append(private.SetKey(ctx, driftReportPrivateStateKey, reportPayload)...)
+
+	// Store the current object as the baseline of the next drift detection
+	encoded, err := json.Marshal(current)
+	if err != nil {
+		return
+	}
+
+	diags.Append(private.SetKey(ctx, driftBaselinePrivateStateKey, encoded)...)
+}
+
 /*** Generic Functions ***/
 
 // CreateGeneric executes the create operation for a given resource using provided operations.
@@ -674,6 +1366,10 @@ func CreateGeneric[Plan any, APIObject any](
 	resp *resource.CreateResponse,
 	ops EntityOperations[Plan, APIObject],
 ) {
+	// Bound the create operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Create, DefaultCreateTimeout)
+	defer cancel()
+
 	// Declare variable to store desired resource plan
 	var plan Plan
 
@@ -693,10 +1389,10 @@ func CreateGeneric[Plan any, APIObject any](
 	apiReq := operator.NewAPIRequest(false)
 
 	// Invoke API to create resource and capture response, status code, and error
-	apiResp, code, err := operator.InvokeCreate(apiReq)
+	apiResp, _, err := operator.InvokeCreate(apiReq)
 
 	// Handle conflict when resource already exists
-	if code == 409 {
+	if client.IsConflict(err) {
 		// Report resource conflict with guidance
 		resp.Diagnostics.AddError("Resource already exists", "Use `terraform import` to bring it under management.")
 
@@ -707,7 +1403,7 @@ func CreateGeneric[Plan any, APIObject any](
 	// Handle API invocation errors
 	if err != nil {
 		// Report API error
-		resp.Diagnostics.AddError("API error", err.Error())
+		AddAttributeAPIError(&resp.Diagnostics, "API error", err, ops.AttributePathResolver(ctx, req.Plan.Schema))
 
 		// Abort on API error
 		return
@@ -723,6 +1419,17 @@ func CreateGeneric[Plan any, APIObject any](
 
 	// Persist new state into Terraform
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	// Persist the org the resource was created in
+	SetStateOrg(ctx, &resp.Diagnostics, &resp.State, ops.OrgOf(plan))
+
+	// Remember the written object to detect changes made outside of Terraform
+	if resp.Private != nil {
+		ops.RecordDriftBaseline(ctx, &resp.Diagnostics, resp.Private, apiResp)
+	}
+
+	// Populate the resource identity from the persisted state
+	ops.SetIdentity(ctx, &resp.Diagnostics, resp.Identity, resp.State)
 }
 
 // ReadGeneric handles reading the entity state and removes entity on 404.
@@ -732,6 +1439,10 @@ func ReadGeneric[Plan any, APIObject any](
 	resp *resource.ReadResponse,
 	ops EntityOperations[Plan, APIObject],
 ) {
+	// Bound the read operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Read, DefaultReadTimeout)
+	defer cancel()
+
 	// Declare variable to hold existing state
 	var state Plan
 
@@ -751,10 +1462,10 @@ func ReadGeneric[Plan any, APIObject any](
 	id := ops.IdFromPlan(state)
 
 	// Invoke API to read resource details
-	apiResp, code, err := operator.InvokeRead(id)
+	apiResp, _, err := operator.InvokeRead(id)
 
 	// Remove resource from state if not found
-	if code == 404 {
+	if client.IsNotFound(err) {
 		// Drop resource from Terraform state
 		resp.State.RemoveResource(ctx)
 		return
@@ -763,12 +1474,22 @@ func ReadGeneric[Plan any, APIObject any](
 	// Handle API invocation errors
 	if err != nil {
 		// Report API error
-		resp.Diagnostics.AddError("API error", err.Error())
+		AddAPIError(&resp.Diagnostics, "API error", err)
 
 		// Exit on API error
 		return
 	}
 
+	// Report the changes made outside of Terraform since the last operation
+	if resp.Private != nil {
+		ops.DetectDrift(ctx, &resp.Diagnostics, req.Private, resp.Private, id, apiResp)
+	}
+
+	// Abort if the private state could not be updated
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build new state from API response
 	newState := operator.MapResponseToState(apiResp, true)
 
@@ -779,6 +1500,12 @@ func ReadGeneric[Plan any, APIObject any](
 
 	// Persist updated state into Terraform
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	// Persist the org the resource was read from
+	SetStateOrg(ctx, &resp.Diagnostics, &resp.State, ops.OrgOf(state))
+
+	// Populate the resource identity from the persisted state
+	ops.SetIdentity(ctx, &resp.Diagnostics, resp.Identity, resp.State)
 }
 
 // UpdateGeneric handles updating the resource based on the planned changes.
@@ -788,6 +1515,10 @@ func UpdateGeneric[Plan any, APIObject any](
 	resp *resource.UpdateResponse,
 	ops EntityOperations[Plan, APIObject],
 ) {
+	// Bound the update operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Update, DefaultUpdateTimeout)
+	defer cancel()
+
 	// Declare variable to store planned changes
 	var plan Plan
 
@@ -811,7 +1542,7 @@ func UpdateGeneric[Plan any, APIObject any](
 	// Handle API invocation errors
 	if err != nil {
 		// Report API error
-		resp.Diagnostics.AddError("API error", err.Error())
+		AddAttributeAPIError(&resp.Diagnostics, "API error", err, ops.AttributePathResolver(ctx, req.Plan.Schema))
 
 		// Exit on API error
 		return
@@ -827,6 +1558,17 @@ func UpdateGeneric[Plan any, APIObject any](
 
 	// Persist updated state into Terraform
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	// Persist the org the resource was updated in
+	SetStateOrg(ctx, &resp.Diagnostics, &resp.State, ops.OrgOf(plan))
+
+	// Remember the written object to detect changes made outside of Terraform
+	if resp.Private != nil {
+		ops.RecordDriftBaseline(ctx, &resp.Diagnostics, resp.Private, apiResp)
+	}
+
+	// Populate the resource identity from the persisted state
+	ops.SetIdentity(ctx, &resp.Diagnostics, resp.Identity, resp.State)
 }
 
 // DeleteGeneric handles deleting the resource and removing it from the state.
@@ -836,6 +1578,10 @@ func DeleteGeneric[Plan any, APIObject any](
 	resp *resource.DeleteResponse,
 	ops EntityOperations[Plan, APIObject],
 ) {
+	// Bound the delete operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Delete, DefaultDeleteTimeout)
+	defer cancel()
+
 	// Declare variable to hold existing state
 	var state Plan
 
@@ -856,7 +1602,7 @@ func DeleteGeneric[Plan any, APIObject any](
 	// Invoke API to delete resource by ID
 	if err := operator.InvokeDelete(id); err != nil {
 		// Report API error
-		resp.Diagnostics.AddError("API error", err.Error())
+		AddAPIError(&resp.Diagnostics, "API error", err)
 
 		// Exit on API error
 		return
@@ -1,13 +1,76 @@
 package cpln
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	testresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneResourceIdentity_basic verifies that resources within and outside a GVC can be imported by their identity.
+func TestAccControlPlaneResourceIdentity_basic(t *testing.T) {
+	// Generate unique names for the resources
+	random := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-identity-%s", random)
+
+	// Run the acceptance test case, covering import by identity
+	testresource.Test(t, testresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t, "RESOURCE_IDENTITY") },
+		ProtoV6ProviderFactories: GetProviderServer(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []testresource.TestStep{
+			// Create
+			{
+				Config: ResourceIdentityHcl(name),
+				Check: testresource.ComposeAggregateTestCheckFunc(
+					testresource.TestCheckResourceAttr("cpln_group.new", "name", name),
+					testresource.TestCheckResourceAttr("cpln_service_account.new", "name", name),
+					testresource.TestCheckResourceAttr("cpln_volume_set.new", "gvc", name),
+				),
+			},
+			// Import the group by its identity
+			{
+				ResourceName:    "cpln_group.new",
+				ImportState:     true,
+				ImportStateKind: testresource.ImportBlockWithResourceIdentity,
+			},
+			// Import the service account by its identity
+			{
+				ResourceName:    "cpln_service_account.new",
+				ImportState:     true,
+				ImportStateKind: testresource.ImportBlockWithResourceIdentity,
+			},
+			// Import the volume set by its identity
+			{
+				ResourceName:    "cpln_volume_set.new",
+				ImportState:     true,
+				ImportStateKind: testresource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 /*** Unit Tests ***/
 
 // TestFlattenLinkString exercises every relevant decision branch of the single-string link normalizer.
@@ -180,3 +243,837 @@ func TestFlattenLinkSet(t *testing.T) {
 		})
 	}
 }
+
+// TestWithOperationTimeout verifies that operation deadlines follow the timeouts block and fall back to the defaults.
+func TestWithOperationTimeout(t *testing.T) {
+	// Use a background context for the schema helpers
+	ctx := context.Background()
+
+	// Build a schema that only holds the timeouts block
+	s := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": (&EntityBase{}).TimeoutsSchema(ctx),
+		},
+	}
+
+	// Resolve the Terraform type of the timeouts block
+	timeoutsType := s.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["timeouts"]
+
+	// Helper to construct a plan with the given create timeout, or a null block when empty
+	newPlan := func(create string) tfsdk.Plan {
+		value := tftypes.NewValue(timeoutsType, nil)
+
+		if create != "" {
+			value = tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, create),
+				"read":   tftypes.NewValue(tftypes.String, nil),
+				"update": tftypes.NewValue(tftypes.String, nil),
+				"delete": tftypes.NewValue(tftypes.String, nil),
+			})
+		}
+
+		return tfsdk.Plan{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{"timeouts": value}),
+		}
+	}
+
+	// Define the table of cases
+	cases := []struct {
+		name           string
+		plan           tfsdk.Plan
+		resolve        TimeoutResolver
+		defaultTimeout time.Duration
+		want           time.Duration
+	}{
+		{
+			name:           "null block falls back to the default",
+			plan:           newPlan(""),
+			resolve:        timeouts.Value.Create,
+			defaultTimeout: DefaultCreateTimeout,
+			want:           DefaultCreateTimeout,
+		},
+		{
+			name:           "configured create timeout is honoured",
+			plan:           newPlan("45m"),
+			resolve:        timeouts.Value.Create,
+			defaultTimeout: DefaultCreateTimeout,
+			want:           45 * time.Minute,
+		},
+		{
+			name:           "unset operation falls back to the default",
+			plan:           newPlan("45m"),
+			resolve:        timeouts.Value.Update,
+			defaultTimeout: DefaultUpdateTimeout,
+			want:           DefaultUpdateTimeout,
+		},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Allocate a fresh diagnostics container for this case
+			diags := diag.Diagnostics{}
+
+			// Invoke the helper
+			before := time.Now()
+			opCtx, cancel := WithOperationTimeout(ctx, &diags, tc.plan, tc.resolve, tc.defaultTimeout)
+			defer cancel()
+
+			// Verify that the helper did not raise any diagnostics
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			// Verify the derived deadline matches the expected timeout
+			deadline, ok := opCtx.Deadline()
+			if !ok {
+				t.Fatalf("expected a deadline on the derived context")
+			}
+
+			if got := deadline.Sub(before); got < tc.want || got > tc.want+time.Minute {
+				t.Fatalf("deadline in %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestAddAPIError verifies that API validation failures are attached to the attributes of the kind, that other errors are
+// reported as a whole, and that every diagnostic keeps the request ID.
+func TestAddAPIError(t *testing.T) {
+	ctx := context.Background()
+
+	// Resolve the API fields against the workload schema and its explicitly mapped fields
+	workloadResource := &WorkloadResource{}
+	workloadResource.Configure(ctx, resource.ConfigureRequest{}, &resource.ConfigureResponse{})
+	schemaResp := resource.SchemaResponse{}
+	workloadResource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resolve := workloadResource.Operations.AttributePathResolver(ctx, schemaResp.Schema)
+
+	// Define the table of cases
+	cases := []struct {
+		name      string
+		err       error
+		resolve   func(apiPath string) (path.Path, bool)
+		wantPaths []path.Path
+	}{
+		{
+			name:      "plain errors are reported without an attribute",
+			err:       errors.New("boom"),
+			resolve:   resolve,
+			wantPaths: []path.Path{{}},
+		},
+		{
+			name:      "api errors without details are reported without an attribute",
+			err:       &client.APIError{StatusCode: 400, Message: "bad request", RequestID: "request-123"},
+			resolve:   resolve,
+			wantPaths: []path.Path{{}},
+		},
+		{
+			name: "field errors are attached to the attributes of the kind",
+			err: &client.APIError{StatusCode: 400, Message: "invalid", RequestID: "request-123", Details: []interface{}{
+				map[string]interface{}{"path": "spec.containers.0.image", "message": "required"},
+				map[string]interface{}{"path": "spec.defaultOptions.capacityAI", "message": "not allowed"},
+				map[string]interface{}{"path": "spec.localOptions", "message": "invalid"},
+				map[string]interface{}{"path": []interface{}{"description"}, "message": "too long"},
+			}},
+			resolve:   resolve,
+			wantPaths: []path.Path{path.Root("container"), path.Root("options"), path.Root("local_options"), path.Root("description")},
+		},
+		{
+			name: "field errors wrapped in an errors object are understood",
+			err: fmt.Errorf("wrapped: %w", &client.APIError{StatusCode: 400, RequestID: "request-123", Details: map[string]interface{}{
+				"errors": []interface{}{map[string]interface{}{"path": "spec.loadBalancer", "message": "invalid"}},
+			}}),
+			resolve:   resolve,
+			wantPaths: []path.Path{path.Root("load_balancer")},
+		},
+		{
+			name: "field errors without an attribute are also reported as a whole",
+			err: &client.APIError{StatusCode: 400, RequestID: "request-123", Details: []interface{}{
+				map[string]interface{}{"path": "spec.containers.0.image", "message": "required"},
+				map[string]interface{}{"path": "spec.unknownField", "message": "not allowed"},
+			}},
+			resolve:   resolve,
+			wantPaths: []path.Path{path.Root("container"), {}},
+		},
+		{
+			name: "field errors are reported as a whole without a resolver",
+			err: &client.APIError{StatusCode: 400, RequestID: "request-123", Details: []interface{}{
+				map[string]interface{}{"path": "spec.containers.0.image", "message": "required"},
+			}},
+			wantPaths: []path.Path{{}},
+		},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Allocate a fresh diagnostics container for this case
+			diags := diag.Diagnostics{}
+
+			// Invoke the helper
+			AddAttributeAPIError(&diags, "API error", tc.err, tc.resolve)
+
+			// Verify the number of diagnostics
+			if len(diags) != len(tc.wantPaths) {
+				t.Fatalf("got %d diagnostics, want %d: %v", len(diags), len(tc.wantPaths), diags)
+			}
+
+			// Verify each diagnostic points at the expected attribute
+			for i, want := range tc.wantPaths {
+				var got path.Path
+				if withPath, ok := diags[i].(diag.DiagnosticWithPath); ok {
+					got = withPath.Path()
+				}
+
+				if !got.Equal(want) {
+					t.Fatalf("diagnostic %d path = %q, want %q", i, got, want)
+				}
+
+				// Verify the request ID is kept for API errors
+				if _, ok := client.AsAPIError(tc.err); ok && !strings.Contains(diags[i].Detail(), "request-123") {
+					t.Fatalf("diagnostic %d does not name the request ID: %s", i, diags[i].Detail())
+				}
+			}
+		})
+	}
+}
+
+// TestAPIPathToAttributePath verifies API fields map to the attribute named after them unless the kind maps them explicitly.
+func TestAPIPathToAttributePath(t *testing.T) {
+	// Define the fields a kind maps explicitly
+	fieldAttributes := map[string]string{"spec": "spec", "spec.containers": "container"}
+
+	// Define the table of cases
+	cases := []struct {
+		apiPath         string
+		fieldAttributes map[string]string
+		want            path.Path
+	}{
+		{apiPath: "description", want: path.Root("description")},
+		{apiPath: "spec.localOptions.capacityAI", want: path.Root("local_options")},
+		{apiPath: "targetLinks", want: path.Root("target_links")},
+		{apiPath: "spec.containers.0.image", fieldAttributes: fieldAttributes, want: path.Root("container")},
+		{apiPath: "spec.dnsMode", fieldAttributes: fieldAttributes, want: path.Root("spec")},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		if got := APIPathToAttributePath(tc.apiPath, tc.fieldAttributes); !got.Equal(tc.want) {
+			t.Errorf("APIPathToAttributePath(%q) = %q, want %q", tc.apiPath, got, tc.want)
+		}
+	}
+}
+
+// TestAPIFieldAttributes verifies every API field a kind maps explicitly points at an attribute of its schema.
+func TestAPIFieldAttributes(t *testing.T) {
+	ctx := context.Background()
+
+	// Initialize the resources mapping fields explicitly
+	domainResource, gvcResource, identityResource := &DomainResource{}, &GvcResource{}, &IdentityResource{}
+	ipSetResource, locationResource, policyResource, workloadResource := &IpSetResource{}, &LocationResource{}, &PolicyResource{}, &WorkloadResource{}
+
+	// Pair each resource with the fields it maps, once configured
+	cases := []struct {
+		resource        resource.ResourceWithConfigure
+		fieldAttributes func() map[string]string
+	}{
+		{domainResource, func() map[string]string { return domainResource.Operations.APIFieldAttributes }},
+		{gvcResource, func() map[string]string { return gvcResource.Operations.APIFieldAttributes }},
+		{identityResource, func() map[string]string { return identityResource.Operations.APIFieldAttributes }},
+		{ipSetResource, func() map[string]string { return ipSetResource.Operations.APIFieldAttributes }},
+		{locationResource, func() map[string]string { return locationResource.Operations.APIFieldAttributes }},
+		{policyResource, func() map[string]string { return policyResource.Operations.APIFieldAttributes }},
+		{workloadResource, func() map[string]string { return workloadResource.Operations.APIFieldAttributes }},
+	}
+
+	// Check each resource
+	for _, tc := range cases {
+		// Configure the resource and retrieve its schema
+		tc.resource.Configure(ctx, resource.ConfigureRequest{}, &resource.ConfigureResponse{})
+		schemaResp := resource.SchemaResponse{}
+		tc.resource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		// Verify the resource maps fields at all
+		fieldAttributes := tc.fieldAttributes()
+		if len(fieldAttributes) == 0 {
+			t.Errorf("%T does not map any field", tc.resource)
+		}
+
+		// Verify every mapped attribute exists
+		for field, name := range fieldAttributes {
+			if _, diags := schemaResp.Schema.TypeAtPath(ctx, path.Root(name)); diags.HasError() {
+				t.Errorf("%T maps %q to the unknown attribute %q", tc.resource, field, name)
+			}
+		}
+	}
+}
+
+// TestParseGvcScopedImportId verifies the import ID forms accepted for resources within a GVC.
+func TestParseGvcScopedImportId(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name     string
+		id       string
+		wantOrg  string
+		wantGvc  string
+		wantName string
+		wantErr  bool
+	}{
+		{name: "gvc and name pair", id: "my-gvc:my-workload", wantGvc: "my-gvc", wantName: "my-workload"},
+		{name: "full self link", id: "/org/my-org/gvc/my-gvc/workload/my-workload", wantOrg: "my-org", wantGvc: "my-gvc", wantName: "my-workload"},
+		{name: "self link of another org", id: "/org/other-org/gvc/my-gvc/workload/my-workload", wantOrg: "other-org", wantGvc: "my-gvc", wantName: "my-workload"},
+		{name: "relative self link", id: "//gvc/my-gvc/workload/my-workload", wantGvc: "my-gvc", wantName: "my-workload"},
+		{name: "name only", id: "my-workload", wantErr: true},
+		{name: "empty gvc", id: ":my-workload", wantErr: true},
+		{name: "empty name", id: "my-gvc:", wantErr: true},
+		{name: "too many separators", id: "my-gvc:my-workload:extra", wantErr: true},
+		{name: "pair with slashes", id: "gvc/my-gvc:my-workload", wantErr: true},
+		{name: "self link of another kind", id: "//gvc/my-gvc/identity/my-identity", wantErr: true},
+		{name: "self link without gvc", id: "//workload/my-workload", wantErr: true},
+		{name: "malformed self link", id: "/gvc/my-gvc/workload/my-workload", wantErr: true},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Parse the import ID
+			org, gvc, name, err := ParseGvcScopedImportId(tc.id, "workload")
+
+			// Verify the error expectation
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseGvcScopedImportId(%q) error = %v, wantErr %v", tc.id, err, tc.wantErr)
+			}
+
+			// Verify the parsed components
+			if org != tc.wantOrg || gvc != tc.wantGvc || name != tc.wantName {
+				t.Fatalf("ParseGvcScopedImportId(%q) = (%q, %q, %q), want (%q, %q, %q)", tc.id, org, gvc, name, tc.wantOrg, tc.wantGvc, tc.wantName)
+			}
+		})
+	}
+}
+
+// TestImportGvcScopedState verifies the import ID is mapped to the state, and that invalid IDs report the accepted forms.
+func TestImportGvcScopedState(t *testing.T) {
+	ctx := context.Background()
+
+	// Build a minimal schema holding the attributes the import populates
+	importSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":  schema.StringAttribute{Computed: true},
+			"gvc": schema.StringAttribute{Required: true},
+			"org": schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+
+	// newResponse returns an import response with an empty state
+	newResponse := func() *resource.ImportStateResponse {
+		objectType := importSchema.Type().TerraformType(ctx)
+		return &resource.ImportStateResponse{State: tfsdk.State{Schema: importSchema, Raw: tftypes.NewValue(objectType, nil)}}
+	}
+
+	// Import a valid self link
+	resp := newResponse()
+	ImportGvcScopedState(ctx, resource.ImportStateRequest{ID: "/org/my-org/gvc/my-gvc/volumeset/my-volume-set"}, resp, "volumeset", "my-org")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// Verify the state attributes, leaving the org to the provider
+	var id, gvc, org types.String
+	resp.State.GetAttribute(ctx, path.Root("id"), &id)
+	resp.State.GetAttribute(ctx, path.Root("gvc"), &gvc)
+	resp.State.GetAttribute(ctx, path.Root("org"), &org)
+	if id.ValueString() != "my-volume-set" || gvc.ValueString() != "my-gvc" || !org.IsNull() {
+		t.Fatalf("state = (id %q, gvc %q, org %s), want (id %q, gvc %q, org null)", id.ValueString(), gvc.ValueString(), org, "my-volume-set", "my-gvc")
+	}
+
+	// Import a self link from another org
+	resp = newResponse()
+	ImportGvcScopedState(ctx, resource.ImportStateRequest{ID: "/org/other-org/gvc/my-gvc/volumeset/my-volume-set"}, resp, "volumeset", "my-org")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// Verify the entity is managed within the org of the self link
+	resp.State.GetAttribute(ctx, path.Root("org"), &org)
+	if org.ValueString() != "other-org" {
+		t.Fatalf("state org = %s, want %q", org, "other-org")
+	}
+
+	// Import an invalid identifier
+	resp = newResponse()
+	ImportGvcScopedState(ctx, resource.ImportStateRequest{ID: "my-volume-set"}, resp, "volumeset", "my-org")
+
+	// Verify the error lists the accepted formats
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an identifier without a GVC")
+	}
+
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "'GVC_NAME:VOLUMESET_NAME'") || !strings.Contains(detail, "'//gvc/GVC_NAME/volumeset/VOLUMESET_NAME'") {
+		t.Fatalf("error detail does not list the accepted formats: %s", detail)
+	}
+}
+
+// TestEntityBaseModelIsImported verifies a model is reported as imported only when the ID is its single known attribute.
+func TestEntityBaseModelIsImported(t *testing.T) {
+	// An imported resource only holds the ID
+	if !(EntityBaseModel{ID: types.StringValue("name"), Name: types.StringNull()}).IsImported() {
+		t.Fatal("expected a model holding only the ID to be imported")
+	}
+
+	// A created resource holds the name as well
+	if (EntityBaseModel{ID: types.StringValue("name"), Name: types.StringValue("name")}).IsImported() {
+		t.Fatal("expected a model holding the name not to be imported")
+	}
+
+	// A planned resource has no ID yet
+	if (EntityBaseModel{ID: types.StringUnknown(), Name: types.StringValue("name")}).IsImported() {
+		t.Fatal("expected a planned model not to be imported")
+	}
+}
+
+// TestEntityOrg verifies the org of a model falls back to the default org until it is known.
+func TestEntityOrg(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name     string
+		model    any
+		expected string
+	}{
+		{"configured org", EntityBaseModel{Org: types.StringValue("other")}, "other"},
+		{"null org", EntityBaseModel{Org: types.StringNull()}, "default"},
+		{"unknown org", EntityBaseModel{Org: types.StringUnknown()}, "default"},
+		{"empty org", EntityBaseModel{Org: types.StringValue("")}, "default"},
+		{"model without an org", struct{}{}, "default"},
+	}
+
+	// Iterate over every case
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := EntityOrg(c.model, "default"); actual != c.expected {
+				t.Fatalf("expected org %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+// TestStateOrg verifies the org is persisted to and read from the state, and ignored by schemas without one.
+func TestStateOrg(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	// Build a state whose schema exposes an org
+	withOrg := tfsdk.State{
+		Schema: schema.Schema{Attributes: map[string]schema.Attribute{"org": schema.StringAttribute{Optional: true, Computed: true}}},
+		Raw:    tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"org": tftypes.String}}, map[string]tftypes.Value{"org": tftypes.NewValue(tftypes.String, nil)}),
+	}
+
+	// A null org falls back to the default org
+	if org := StateOrg(ctx, withOrg, "default"); org != "default" {
+		t.Fatalf("expected the default org, got %s", org)
+	}
+
+	// A persisted org is read back
+	SetStateOrg(ctx, &diags, &withOrg, "other")
+	if org := StateOrg(ctx, withOrg, "default"); org != "other" {
+		t.Fatalf("expected org other, got %s", org)
+	}
+
+	// Build a state whose schema has no org
+	withoutOrg := tfsdk.State{
+		Schema: schema.Schema{Attributes: map[string]schema.Attribute{"name": schema.StringAttribute{Required: true}}},
+		Raw:    tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "name")}),
+	}
+
+	// Setting the org is a no-op and the default org is returned
+	SetStateOrg(ctx, &diags, &withoutOrg, "other")
+	if org := StateOrg(ctx, withoutOrg, "default"); org != "default" {
+		t.Fatalf("expected the default org, got %s", org)
+	}
+
+	// Fail on diagnostics errors
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+// TestClientWithOrg verifies a client derived for another org shares the access token of the provider client.
+func TestClientWithOrg(t *testing.T) {
+	// Initialize the provider client
+	providerClient := &client.Client{Org: "default", Token: "token"}
+
+	// The provider client is reused when no other org is requested
+	if providerClient.WithOrg("") != providerClient || providerClient.WithOrg("default") != providerClient {
+		t.Fatal("expected the provider client to be reused for its own org")
+	}
+
+	// Derive clients for other orgs, including one derived from a derived client
+	orgClient := providerClient.WithOrg("other")
+	nestedClient := orgClient.WithOrg("another")
+
+	// Verify the derived clients target the requested orgs
+	if orgClient.Org != "other" || nestedClient.Org != "another" || providerClient.Org != "default" {
+		t.Fatalf("unexpected orgs: %s, %s, %s", providerClient.Org, orgClient.Org, nestedClient.Org)
+	}
+
+	// Verify a token refreshed by the provider client is picked up by the derived clients
+	providerClient.Token = "refreshed"
+	if orgClient.CurrentToken() != "refreshed" || nestedClient.CurrentToken() != "refreshed" {
+		t.Fatalf("expected the derived clients to share the access token, got %s and %s", orgClient.CurrentToken(), nestedClient.CurrentToken())
+	}
+}
+
+// TestEntityOrgSchema verifies the org keeps its state value before deciding whether the resource must be replaced.
+func TestEntityOrgSchema(t *testing.T) {
+	attribute := (&EntityBase{}).OrgSchema("entity")
+
+	// Verify the org can be configured and defaults to the provider org
+	if !attribute.Optional || !attribute.Computed {
+		t.Fatal("expected the org to be optional and computed")
+	}
+
+	// Verify the plan modifiers run in order
+	if len(attribute.PlanModifiers) != 2 {
+		t.Fatalf("expected 2 plan modifiers, got %d", len(attribute.PlanModifiers))
+	}
+	if desc := attribute.PlanModifiers[0].Description(context.Background()); !strings.Contains(desc, "will not change") {
+		t.Fatalf("expected the state value to be used first, got %q", desc)
+	}
+}
+
+// TestPreserveJSONFormatting verifies the JSON formatting of the plan is kept, and that the API value is used without one.
+func TestPreserveJSONFormatting(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name string
+		raw  interface{}
+		plan types.String
+		want types.String
+	}{
+		{name: "equal json keeps the plan formatting", raw: `{"a":1}`, plan: types.StringValue("{ \"a\": 1 }"), want: types.StringValue("{ \"a\": 1 }")},
+		{name: "different json uses the api value", raw: `{"a":2}`, plan: types.StringValue(`{"a":1}`), want: types.StringValue(`{"a":2}`)},
+		{name: "imported string uses the api value", raw: `{"a":1}`, plan: types.StringNull(), want: types.StringValue(`{"a":1}`)},
+		{name: "non string value keeps the plan", raw: map[string]interface{}{"a": 2}, plan: types.StringValue(`{"a":1}`), want: types.StringValue(`{"a":1}`)},
+		{name: "imported non string value is serialized", raw: map[string]interface{}{"a": 1}, plan: types.StringNull(), want: types.StringValue(`{"a":1}`)},
+		{name: "imported nil value stays null", raw: nil, plan: types.StringNull(), want: types.StringNull()},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			if got := PreserveJSONFormatting(tc.raw, tc.plan); !got.Equal(tc.want) {
+				t.Fatalf("PreserveJSONFormatting() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+// fakePrivateState is an in-memory private state used to exercise the drift detection.
+type fakePrivateState struct {
+	data map[string][]byte
+}
+
+// GetKey returns the value stored under the key.
+func (f *fakePrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return f.data[key], nil
+}
+
+// SetKey stores the value under the key.
+func (f *fakePrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	f.data[key] = value
+	return nil
+}
+
+// driftTestGroup builds a group as returned by the API at the given version.
+func driftTestGroup(version int, description string, members []string) *client.Group {
+	return &client.Group{
+		Base: client.Base{
+			ID:           StringPointer("0000"),
+			Name:         StringPointer("group"),
+			Kind:         StringPointer("group"),
+			Version:      IntPointer(version),
+			Description:  StringPointer(description),
+			Tags:         &map[string]interface{}{"team": "a", "cpln/managedByTerraform": "true"},
+			LastModified: StringPointer(fmt.Sprintf("2026-01-0%dT00:00:00.000Z", version)),
+		},
+		MemberLinks: &members,
+	}
+}
+
+// TestNewDriftBaseline verifies the baseline captures the version metadata and skips the platform-maintained fields.
+func TestNewDriftBaseline(t *testing.T) {
+	baseline, err := NewDriftBaseline(driftTestGroup(2, "desc", []string{"/org/o/user/a"}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The version metadata is captured
+	if baseline.Version == nil || *baseline.Version != 2 || baseline.LastModified == nil {
+		t.Fatalf("expected version 2 and a last modified timestamp, got %+v", baseline)
+	}
+
+	// Only the user-controlled fields are captured
+	for _, field := range []string{"name", "description", "tags.team", "memberLinks[0]"} {
+		if _, ok := baseline.Fields[field]; !ok {
+			t.Fatalf("expected field %q in %v", field, baseline.Fields)
+		}
+	}
+
+	for _, field := range []string{"id", "kind", "version", "lastModified", "tags.cpln/managedByTerraform"} {
+		if _, ok := baseline.Fields[field]; ok {
+			t.Fatalf("expected field %q to be skipped", field)
+		}
+	}
+
+	// Values are stored as digests only
+	if baseline.Fields["description"] == "desc" {
+		t.Fatal("expected the description to be stored as a digest")
+	}
+}
+
+// TestDriftBaselineChangedFields verifies modified, added and removed fields are reported, except for ignored paths.
+func TestDriftBaselineChangedFields(t *testing.T) {
+	previous, _ := NewDriftBaseline(driftTestGroup(1, "desc", []string{"/org/o/user/a", "/org/o/user/b"}), nil)
+	current, _ := NewDriftBaseline(driftTestGroup(2, "changed", []string{"/org/o/user/c"}), nil)
+
+	// The version bump marks the baseline as outdated
+	if !previous.IsOutdatedBy(*current) || previous.IsOutdatedBy(*previous) {
+		t.Fatal("expected only a different version to outdate the baseline")
+	}
+
+	// Every changed field is named
+	want := "description, memberLinks[0], memberLinks[1]"
+	if got := strings.Join(previous.ChangedFields(*current), ", "); got != want {
+		t.Fatalf("ChangedFields() = %q, want %q", got, want)
+	}
+
+	// Ignored paths are never reported
+	previous, _ = NewDriftBaseline(driftTestGroup(1, "desc", []string{"/org/o/user/a"}), []string{"memberLinks"})
+	current, _ = NewDriftBaseline(driftTestGroup(2, "desc", []string{"/org/o/user/c"}), []string{"memberLinks"})
+
+	if changed := previous.ChangedFields(*current); len(changed) != 0 {
+		t.Fatalf("expected no changed fields, got %v", changed)
+	}
+}
+
+// TestIsDriftIgnoredPath verifies list indexes match the "[*]" wildcard and nested fields of ignored paths are ignored.
+func TestIsDriftIgnoredPath(t *testing.T) {
+	ignored := []string{"spec.ports[*].routes", "spec.logging"}
+
+	cases := map[string]bool{
+		"spec.ports[0].routes":               true,
+		"spec.ports[12].routes[3].prefix":    true,
+		"spec.logging.s3.bucket":             true,
+		"spec.ports[0].number":               false,
+		"spec.loggingEnabled":                false,
+		"spec.ports[0].routesWithOtherNames": false,
+	}
+
+	for path, want := range cases {
+		if got := isDriftIgnoredPath(path, ignored); got != want {
+			t.Errorf("isDriftIgnoredPath(%q) = %t, want %t", path, got, want)
+		}
+	}
+}
+
+// TestFormatDriftFields verifies long lists of changed fields are summarized.
+func TestFormatDriftFields(t *testing.T) {
+	if got := formatDriftFields([]string{"a", "b"}); got != "a, b" {
+		t.Fatalf("formatDriftFields() = %q", got)
+	}
+
+	fields := make([]string, driftReportedFieldsLimit+3)
+	for i := range fields {
+		fields[i] = fmt.Sprintf("f%d", i)
+	}
+
+	if got := formatDriftFields(fields); !strings.HasSuffix(got, "f9 and 3 more") {
+		t.Fatalf("formatDriftFields() = %q", got)
+	}
+}
+
+// TestDetectDrift verifies changes made outside of Terraform produce a warning, or fail the next plan when configured to.
+func TestDetectDrift(t *testing.T) {
+	ctx := context.Background()
+	identity := EntityIdentity{Kind: "group"}
+
+	// Record the object as written by the provider
+	private := &fakePrivateState{data: map[string][]byte{}}
+	ops := EntityOperations[GroupResourceModel, client.Group]{Identity: &identity}
+
+	var diags diag.Diagnostics
+	ops.RecordDriftBaseline(ctx, &diags, private, driftTestGroup(1, "desc", []string{}))
+
+	// Reading the same object reports nothing
+	ops.DetectDrift(ctx, &diags, private, private, "group", driftTestGroup(1, "desc", []string{}))
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+
+	// Reading a modified object reports a warning naming the changed field
+	ops.DetectDrift(ctx, &diags, private, private, "group", driftTestGroup(2, "changed", []string{}))
+	if diags.WarningsCount() != 1 || !strings.Contains(diags[0].Detail(), `group "group"`) || !strings.Contains(diags[0].Detail(), "description") {
+		t.Fatalf("expected a warning naming the description, got %v", diags)
+	}
+
+	// The warning is reported once, as the baseline now holds the modified object
+	diags = nil
+	ops.DetectDrift(ctx, &diags, private, private, "group", driftTestGroup(2, "changed", []string{}))
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+
+	// Failing on external changes defers the error to the plan, recording the changes instead of warning
+	ops.FailOnExternalChanges = true
+	diags = nil
+	ops.DetectDrift(ctx, &diags, private, private, "group", driftTestGroup(3, "again", []string{}))
+	if len(diags) != 0 || len(private.data[driftReportPrivateStateKey]) == 0 {
+		t.Fatalf("expected silently recorded changes, got %v", diags)
+	}
+
+	// The recorded changes fail the plan
+	ReportExternalChanges(ctx, &diags, private)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "description") {
+		t.Fatalf("expected an error naming the description, got %v", diags)
+	}
+
+	// A read without changes clears the recorded changes, so the plan passes again
+	diags = nil
+	ops.DetectDrift(ctx, &diags, private, private, "group", driftTestGroup(3, "again", []string{}))
+	ReportExternalChanges(ctx, &diags, private)
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+
+	// Objects without a baseline, such as imported ones, only record one
+	empty := &fakePrivateState{data: map[string][]byte{}}
+	diags = nil
+	ops.DetectDrift(ctx, &diags, empty, empty, "group", driftTestGroup(3, "again", []string{}))
+	if len(diags) != 0 || len(empty.data[driftBaselinePrivateStateKey]) == 0 {
+		t.Fatalf("expected a silently recorded baseline, got %v", diags)
+	}
+}
+
+// TestDriftIgnoredPaths verifies revealed secret data and service account keys, which are changed by other resources,
+// never reach the drift baseline.
+func TestDriftIgnoredPaths(t *testing.T) {
+	ctx := context.Background()
+
+	// Configure the resources without a provider
+	secretResource := &SecretResource{}
+	secretResource.Configure(ctx, resource.ConfigureRequest{}, &resource.ConfigureResponse{})
+	serviceAccountResource := &ServiceAccountResource{}
+	serviceAccountResource.Configure(ctx, resource.ConfigureRequest{}, &resource.ConfigureResponse{})
+
+	// Capture the baseline of a revealed secret
+	var data interface{} = map[string]interface{}{"payload": "password"}
+	secret, err := NewDriftBaseline(&client.Secret{Base: client.Base{Name: StringPointer("secret")}, Type: StringPointer("opaque"), Data: &data}, secretResource.Operations.DriftIgnoredPaths)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Capture the baseline of a service account with a key
+	keys := []client.ServiceAccountKey{{Name: "key", Description: StringPointer("desc")}}
+	serviceAccount, err := NewDriftBaseline(&client.ServiceAccount{Base: client.Base{Name: StringPointer("sa")}, Keys: &keys}, serviceAccountResource.Operations.DriftIgnoredPaths)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Verify the ignored fields are left out
+	for field := range secret.Fields {
+		if strings.HasPrefix(field, "data") {
+			t.Errorf("secret baseline holds %s", field)
+		}
+	}
+	for field := range serviceAccount.Fields {
+		if strings.HasPrefix(field, "keys") {
+			t.Errorf("service account baseline holds %s", field)
+		}
+	}
+
+	// Verify the other fields are still captured
+	if _, ok := secret.Fields["type"]; !ok {
+		t.Errorf("secret baseline misses the type, got %v", secret.Fields)
+	}
+}
+
+// TestEntityIdentitySchemas verifies every resource supporting identities declares the org, kind and name attributes,
+// plus the GVC for resources that belong to one.
+func TestEntityIdentitySchemas(t *testing.T) {
+	ctx := context.Background()
+
+	// Iterate over every resource of the provider
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		// Retrieve the type name of the resource
+		metadata := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "cpln"}, &metadata)
+
+		// Skip resources that do not support identities
+		withIdentity, ok := r.(resource.ResourceWithIdentity)
+		if !ok {
+			continue
+		}
+
+		// Retrieve the identity schema and the resource schema
+		identityResp := resource.IdentitySchemaResponse{}
+		withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+		schemaResp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		// Validate the identity schema
+		if diags := identityResp.IdentitySchema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("%s: invalid identity schema: %v", metadata.TypeName, diags)
+		}
+
+		// Verify the attributes shared by every entity
+		for _, name := range []string{"org", "kind", "name"} {
+			if _, ok := identityResp.IdentitySchema.Attributes[name]; !ok {
+				t.Fatalf("%s: identity schema is missing the %q attribute", metadata.TypeName, name)
+			}
+		}
+
+		// Verify the GVC is part of the identity exactly when the resource requires one
+		gvc, hasGvc := schemaResp.Schema.Attributes["gvc"]
+		requiresGvc := hasGvc && gvc.IsRequired()
+		if _, ok := identityResp.IdentitySchema.Attributes["gvc"]; ok != requiresGvc {
+			t.Fatalf("%s: identity schema has a gvc attribute = %t, resource requires a gvc = %t", metadata.TypeName, ok, requiresGvc)
+		}
+	}
+}
+
+// ResourceIdentityHcl returns a configuration with resources within and outside a GVC.
+func ResourceIdentityHcl(name string) string {
+	return fmt.Sprintf(`
+resource "cpln_gvc" "new" {
+  name        = "%[1]s"
+  description = "resource identity"
+  locations   = ["aws-eu-central-1"]
+}
+
+resource "cpln_group" "new" {
+  name        = "%[1]s"
+  description = "resource identity"
+}
+
+resource "cpln_service_account" "new" {
+  name        = "%[1]s"
+  description = "resource identity"
+}
+
+resource "cpln_volume_set" "new" {
+  name              = "%[1]s"
+  gvc               = cpln_gvc.new.name
+  initial_capacity  = 10
+  performance_class = "general-purpose-ssd"
+  file_system_type  = "ext4"
+}
+`, name)
+}
@@ -72,6 +72,7 @@ func (d *GvcDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				Description: "Full link to this resource. Can be referenced by other resources.",
 				Computed:    true,
 			},
+			"org": DataSourceOrgSchema("GVC"),
 			"alias": schema.StringAttribute{
 				Description: "The alias name of the GVC.",
 				Computed:    true,
@@ -312,7 +313,7 @@ func (d *GvcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	var state GvcResourceModel
 
 	// Populate state from request and capture diagnostics
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	resp.Diagnostics.Append(GetResourceModelConfig(ctx, req.Config, &state)...)
 
 	// Abort if diagnostics errors occurred
 	if resp.Diagnostics.HasError() {
@@ -324,10 +325,10 @@ func (d *GvcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	operator := d.Operations.NewOperator(ctx, &resp.Diagnostics, state)
 
 	// Invoke API to read resource details
-	apiResp, code, err := operator.InvokeRead(state.Name.ValueString())
+	apiResp, _, err := operator.InvokeRead(state.Name.ValueString())
 
 	// Remove resource from state if not found
-	if code == 404 {
+	if client.IsNotFound(err) {
 		// Drop resource from Terraform state
 		resp.State.RemoveResource(ctx)
 		return
@@ -350,6 +351,9 @@ func (d *GvcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
+	// Expose the org the GVC was read from
+	newState.Org = types.StringValue(d.Operations.OrgOf(state))
+
 	// Persist updated state into Terraform
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(SetResourceModelState(ctx, &resp.State, &newState)...)
 }
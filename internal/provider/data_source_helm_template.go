@@ -3,7 +3,6 @@ package cpln
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -22,6 +21,7 @@ type HelmTemplateDataSourceModel struct {
 	ID                    types.String `tfsdk:"id"`
 	Name                  types.String `tfsdk:"name"`
 	Gvc                   types.String `tfsdk:"gvc"`
+	Org                   types.String `tfsdk:"org"`
 	Chart                 types.String `tfsdk:"chart"`
 	Repository            types.String `tfsdk:"repository"`
 	Version               types.String `tfsdk:"version"`
@@ -79,7 +79,7 @@ func (d *HelmTemplateDataSource) Configure(ctx context.Context, req datasource.C
 // Schema defines the schema for the data source.
 func (d *HelmTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Renders Helm chart templates using the `cpln helm template` command without installing. Useful for previewing rendered manifests or feeding them into other resources.",
+		MarkdownDescription: "Renders Helm chart templates locally without installing, so the `cpln` CLI is not required. Useful for previewing rendered manifests or feeding them into other resources.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier for this data source (same as name).",
@@ -93,6 +93,11 @@ func (d *HelmTemplateDataSource) Schema(ctx context.Context, req datasource.Sche
 				Description: "The GVC (Global Virtual Cloud) context for rendering the helm chart templates. Required only if the chart contains GVC-scoped resources and the GVC is not defined within the chart manifests.",
 				Optional:    true,
 			},
+			"org": schema.StringAttribute{
+				Description: "The org context for rendering the helm chart templates. Defaults to the org configured in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
 			"chart": schema.StringAttribute{
 				Description: "Path to the chart. This can be a local path to a chart directory or packaged chart, or a URL/path when used with --repo.",
 				Required:    true,
@@ -194,7 +199,7 @@ func postrenderDataSourceSchemaAttributes() map[string]schema.Attribute {
 	}
 }
 
-// Read executes cpln helm template and stores the rendered manifest.
+// Read renders the chart templates and stores the rendered manifest.
 func (d *HelmTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Read the config from the request
 	var config HelmTemplateDataSourceModel
@@ -203,9 +208,6 @@ func (d *HelmTemplateDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	// Build the command arguments
-	args := []string{"helm", "template", config.Name.ValueString(), config.Chart.ValueString()}
-
 	// Build the common config from the data source model
 	cfg := client.HelmCommonConfig{
 		Gvc:                   config.Gvc,
@@ -228,16 +230,11 @@ func (d *HelmTemplateDataSource) Read(ctx context.Context, req datasource.ReadRe
 		DependencyUpdate:      config.DependencyUpdate,
 	}
 
-	// Build and validate the full argument list
-	commonArgs, tempFiles, err := d.client.BuildHelmArgs(args, cfg)
-	defer client.RemoveTempFiles(tempFiles)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build helm template arguments", err.Error())
-		return
-	}
+	// Target the org the templates are rendered for
+	orgClient := d.client.WithOrg(config.Org.ValueString())
 
-	// Execute helm template
-	output, err := ExecuteCplnCommand(commonArgs)
+	// Render the templates as the first revision of a new release
+	rendered, err := orgClient.RenderHelmChart(ctx, config.Name.ValueString(), config.Chart.ValueString(), cfg, 1, false)
 	if err != nil {
 		resp.Diagnostics.AddError("Helm template failed", err.Error())
 		return
@@ -245,7 +242,8 @@ func (d *HelmTemplateDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	// Set computed fields
 	config.ID = config.Name
-	config.Manifest = types.StringValue(strings.TrimSpace(output))
+	config.Org = types.StringValue(orgClient.Org)
+	config.Manifest = types.StringValue(rendered.Manifest)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
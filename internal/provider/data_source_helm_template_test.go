@@ -1,9 +1,16 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -210,3 +217,159 @@ type HelmTemplateDataSourceTestCase struct {
 	Chart           string
 	ValuesFile      string
 }
+
+/*** Unit Tests ***/
+
+// TestHelmTemplateRenderChart verifies the sample chart is rendered locally with values files and overrides applied in
+// order of precedence.
+func TestHelmTemplateRenderChart(t *testing.T) {
+	// Read the values files passed to the chart
+	initial, err := os.ReadFile("../../testdata/helm/values/initial.yaml")
+	if err != nil {
+		t.Fatalf("failed to read values file: %v", err)
+	}
+	override, err := os.ReadFile("../../testdata/helm/values/override.yaml")
+	if err != nil {
+		t.Fatalf("failed to read values file: %v", err)
+	}
+
+	// Build the configuration of the chart
+	cfg := client.HelmCommonConfig{
+		Gvc:       types.StringValue("my-gvc"),
+		Values:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue(string(initial)), types.StringValue(string(override))}),
+		Set:       types.MapNull(types.StringType),
+		SetString: types.MapValueMust(types.StringType, map[string]attr.Value{"secret.name": types.StringValue("my-release-secret")}),
+		SetFile:   types.MapNull(types.StringType),
+	}
+
+	// Render the chart
+	rendered, err := (&client.Client{Org: "my-org"}).RenderHelmChart(context.Background(), "my-release", "../../testdata/helm/sample-chart", cfg, 1, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Verify the manifest reflects the values and overrides
+	for _, want := range []string{"# Source: sample-chart/templates/secret.yaml", "kind: secret", "name: my-release-secret", "payload: override-from-values"} {
+		if !strings.Contains(rendered.Manifest, want) {
+			t.Errorf("manifest does not contain %q:\n%s", want, rendered.Manifest)
+		}
+	}
+
+	// Verify the org and GVC are injected into the values
+	if want := map[string]interface{}{"org": "my-org", "gvc": "my-gvc"}; fmt.Sprint(rendered.Config["cpln"]) != fmt.Sprint(want) {
+		t.Errorf("cpln values = %v, want %v", rendered.Config["cpln"], want)
+	}
+
+	// Verify every values file is recorded
+	if len(rendered.ValuesFiles) != 2 {
+		t.Errorf("values files = %d, want 2", len(rendered.ValuesFiles))
+	}
+}
+
+// TestHelmTemplateRenderChartErrors verifies invalid charts and values are reported.
+func TestHelmTemplateRenderChartErrors(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name      string
+		chart     string
+		values    string
+		cancelled bool
+	}{
+		{name: "missing local chart", chart: "../../testdata/helm/missing-chart", values: ""},
+		{name: "invalid values", chart: "../../testdata/helm/sample-chart", values: "secret: ["},
+		{name: "cancelled", chart: "../../testdata/helm/sample-chart", values: "", cancelled: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Build the configuration of the chart
+			cfg := client.HelmCommonConfig{
+				Gvc:       types.StringValue("my-gvc"),
+				Values:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue(tc.values)}),
+				Set:       types.MapNull(types.StringType),
+				SetString: types.MapNull(types.StringType),
+				SetFile:   types.MapNull(types.StringType),
+			}
+
+			// Cancel the rendering up front when requested
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.cancelled {
+				cancel()
+			}
+
+			// Render the chart and expect a failure
+			if _, err := (&client.Client{Org: "my-org"}).RenderHelmChart(ctx, "my-release", tc.chart, cfg, 1, false); err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
+
+// TestHelmTemplateRenderChartPostrender verifies the manifest is passed through the post-renderer and that a
+// post-renderer outliving the context is killed.
+func TestHelmTemplateRenderChartPostrender(t *testing.T) {
+	// Define the table of cases
+	cases := []struct {
+		name       string
+		binaryPath string
+		args       []string
+		wantErr    bool
+	}{
+		{name: "passthrough", binaryPath: "cat"},
+		{name: "missing binary", binaryPath: "missing-post-renderer", wantErr: true},
+		{name: "outliving the context", binaryPath: "sleep", args: []string{"30"}, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Build the arguments of the post-renderer
+			args := []attr.Value{}
+			for _, arg := range tc.args {
+				args = append(args, types.StringValue(arg))
+			}
+
+			// Build the configuration of the chart
+			cfg := client.HelmCommonConfig{
+				Gvc:       types.StringValue("my-gvc"),
+				Values:    types.ListNull(types.StringType),
+				Set:       types.MapNull(types.StringType),
+				SetString: types.MapValueMust(types.StringType, map[string]attr.Value{"secret.name": types.StringValue("my-release-secret")}),
+				SetFile:   types.MapNull(types.StringType),
+				Postrender: types.ObjectValueMust(map[string]attr.Type{"binary_path": types.StringType, "args": types.ListType{ElemType: types.StringType}}, map[string]attr.Value{
+					"binary_path": types.StringValue(tc.binaryPath),
+					"args":        types.ListValueMust(types.StringType, args),
+				}),
+			}
+
+			// Bound the rendering
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			// Render the chart
+			start := time.Now()
+			rendered, err := (&client.Client{Org: "my-org"}).RenderHelmChart(ctx, "my-release", "../../testdata/helm/sample-chart", cfg, 1, false)
+
+			// Verify the post-renderer was stopped with the context
+			if elapsed := time.Since(start); elapsed > 10*time.Second {
+				t.Fatalf("rendering took %s, want it stopped with the context", elapsed)
+			}
+
+			// Verify the outcome
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Verify the manifest went through the post-renderer
+			if !strings.Contains(rendered.Manifest, "name: my-release-secret") {
+				t.Errorf("manifest does not contain the rendered secret:\n%s", rendered.Manifest)
+			}
+		})
+	}
+}
@@ -82,6 +82,7 @@ func (d *ImageDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Description: "Full link to this resource. Can be referenced by other resources.",
 				Computed:    true,
 			},
+			"org": DataSourceOrgSchema("image"),
 			"tag": schema.StringAttribute{
 				Description: "Tag of the image.",
 				Computed:    true,
@@ -134,10 +135,10 @@ func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	operator := d.Operations.NewOperator(ctx, &resp.Diagnostics, state)
 
 	// Invoke API to read resource details
-	apiResp, code, err := operator.InvokeRead(state.Name.ValueString())
+	apiResp, _, err := operator.InvokeRead(state.Name.ValueString())
 
 	// Remove resource from state if not found
-	if code == 404 {
+	if client.IsNotFound(err) {
 		// Drop resource from Terraform state
 		resp.State.RemoveResource(ctx)
 		return
@@ -160,6 +161,9 @@ func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	// Expose the org the image was read from
+	newState.Org = types.StringValue(d.Operations.OrgOf(state))
+
 	// Persist updated state into Terraform
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
@@ -237,9 +241,9 @@ func (ieo *ImageDataSourceOperator) InvokeRead(name string) (*client.Image, int,
 
 	// Use GetImage when a specific tag is provided, otherwise fetch the latest image
 	if hasColon {
-		image, code, err = ieo.Client.GetImage(name)
+		image, code, err = ieo.Client.GetImage(ieo.Ctx, name)
 	} else {
-		image, code, err = ieo.Client.GetLatestImage(name)
+		image, code, err = ieo.Client.GetLatestImage(ieo.Ctx, name)
 	}
 
 	// Return the obtained image, status code, and error (if any)
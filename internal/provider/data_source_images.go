@@ -24,8 +24,9 @@ var (
 
 // ImagesDataSourceModel holds the Terraform state for the data source.
 type ImagesDataSourceModel struct {
-	Images types.List `tfsdk:"images"`
-	Query  types.List `tfsdk:"query"`
+	Images types.List   `tfsdk:"images"`
+	Query  types.List   `tfsdk:"query"`
+	Org    types.String `tfsdk:"org"`
 }
 
 /*** Data Source Configuration ***/
@@ -54,6 +55,11 @@ func (d *ImagesDataSource) Configure(ctx context.Context, req datasource.Configu
 func (d *ImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"org": schema.StringAttribute{
+				Description: "The org to list the images of. Defaults to the org configured in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
 			"images": schema.ListNestedAttribute{
 				Description: "List of all images of the org.",
 				Computed:    true,
@@ -201,7 +207,7 @@ func (d *ImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	operator := ImagesDataSourceOperator{
 		Ctx:    ctx,
 		Diags:  &resp.Diagnostics,
-		Client: d.client,
+		Client: d.client.WithOrg(state.Org.ValueString()),
 		Plan:   state,
 	}
 
@@ -225,6 +231,9 @@ func (d *ImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	// Expose the org the images were listed from
+	newState.Org = types.StringValue(operator.Client.Org)
+
 	// Persist updated state into Terraform
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
@@ -300,7 +309,7 @@ func (ieo *ImagesDataSourceOperator) InvokeRead() (*client.ImagesQueryResult, er
 	}
 
 	// Execute the image query and return the result with any error encountered
-	return ieo.Client.GetImagesQuery(query)
+	return ieo.Client.GetImagesQuery(ieo.Ctx, query)
 }
 
 // Flatteners //
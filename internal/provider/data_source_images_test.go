@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -202,7 +203,7 @@ func (idstc *ImagesDataSourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Execute the external API call to fetch images matching the query
-		_images, err := TestProvider.client.GetImagesQuery(idstc.Query)
+		_images, err := TestProvider.client.GetImagesQuery(context.Background(), idstc.Query)
 
 		// Propagate any errors from the external API call
 		if err != nil {
@@ -69,6 +69,7 @@ func (d *LocationDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Description: "Full link to this resource. Can be referenced by other resources.",
 				Computed:    true,
 			},
+			"org": DataSourceOrgSchema("location"),
 			"origin": schema.StringAttribute{
 				Description: "Origin of the location. Valid values: `builtin`, `default`, `custom`.",
 				Computed:    true,
@@ -132,7 +133,7 @@ func (d *LocationDataSource) Read(ctx context.Context, req datasource.ReadReques
 	var state LocationResourceModel
 
 	// Populate state from request and capture diagnostics
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	resp.Diagnostics.Append(GetResourceModelConfig(ctx, req.Config, &state)...)
 
 	// Abort if diagnostics errors occurred
 	if resp.Diagnostics.HasError() {
@@ -144,10 +145,10 @@ func (d *LocationDataSource) Read(ctx context.Context, req datasource.ReadReques
 	operator := d.Operations.NewOperator(ctx, &resp.Diagnostics, state)
 
 	// Invoke API to read resource details
-	apiResp, code, err := operator.InvokeRead(state.Name.ValueString())
+	apiResp, _, err := operator.InvokeRead(state.Name.ValueString())
 
 	// Remove resource from state if not found
-	if code == 404 {
+	if client.IsNotFound(err) {
 		// Drop resource from Terraform state
 		resp.State.RemoveResource(ctx)
 		return
@@ -170,6 +171,9 @@ func (d *LocationDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	// Expose the org the location was read from
+	newState.Org = types.StringValue(d.Operations.OrgOf(state))
+
 	// Persist updated state into Terraform
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(SetResourceModelState(ctx, &resp.State, &newState)...)
 }
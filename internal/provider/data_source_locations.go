@@ -21,7 +21,8 @@ var (
 
 // LocationsDataSourceModel holds the Terraform state for the data source.
 type LocationsDataSourceModel struct {
-	Locations types.List `tfsdk:"locations"`
+	Locations types.List   `tfsdk:"locations"`
+	Org       types.String `tfsdk:"org"`
 }
 
 /*** Data Source Configuration ***/
@@ -50,6 +51,11 @@ func (d *LocationsDataSource) Configure(ctx context.Context, req datasource.Conf
 func (d *LocationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"org": schema.StringAttribute{
+				Description: "The org to list the locations of. Defaults to the org configured in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
 			"locations": schema.ListNestedAttribute{
 				Description: "List of all images of the org.",
 				Computed:    true,
@@ -155,7 +161,7 @@ func (d *LocationsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	operator := LocationsDataSourceOperator{
 		Ctx:    ctx,
 		Diags:  &resp.Diagnostics,
-		Client: d.client,
+		Client: d.client.WithOrg(state.Org.ValueString()),
 		Plan:   state,
 	}
 
@@ -179,6 +185,9 @@ func (d *LocationsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	// Expose the org the locations were listed from
+	newState.Org = types.StringValue(operator.Client.Org)
+
 	// Persist updated state into Terraform
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
@@ -207,7 +216,7 @@ func (leo *LocationsDataSourceOperator) MapResponseToState(locations *client.Loc
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (leo *LocationsDataSourceOperator) InvokeRead() (*client.Locations, error) {
-	return leo.Client.GetLocations()
+	return leo.Client.GetLocations(leo.Ctx)
 }
 
 // Flatteners //
@@ -69,6 +69,7 @@ func (d *OrgDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				Description: "Full link to this resource. Can be referenced by other resources.",
 				Computed:    true,
 			},
+			"org": DataSourceOrgSchema("org"),
 			"account_id": schema.StringAttribute{
 				Description: "The associated account ID that will be used when creating the org. Only used on org creation. The account ID can be obtained from the `Org Management & Billing` page.",
 				Computed:    true,
@@ -221,7 +222,7 @@ func (d *OrgDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	var state OrgResourceModel
 
 	// Populate state from request and capture diagnostics
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	resp.Diagnostics.Append(GetResourceModelConfig(ctx, req.Config, &state)...)
 
 	// Abort if diagnostics errors occurred
 	if resp.Diagnostics.HasError() {
@@ -233,10 +234,10 @@ func (d *OrgDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	operator := d.Operations.NewOperator(ctx, &resp.Diagnostics, state)
 
 	// Invoke API to read resource details
-	apiResp, code, err := operator.InvokeRead(d.client.Org)
+	apiResp, _, err := operator.InvokeRead(d.Operations.OrgOf(state))
 
 	// Remove resource from state if not found
-	if code == 404 {
+	if client.IsNotFound(err) {
 		// Drop resource from Terraform state
 		resp.State.RemoveResource(ctx)
 		return
@@ -259,6 +260,9 @@ func (d *OrgDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
+	// Expose the org the org was read from
+	newState.Org = types.StringValue(d.Operations.OrgOf(state))
+
 	// Persist updated state into Terraform
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(SetResourceModelState(ctx, &resp.State, &newState)...)
 }
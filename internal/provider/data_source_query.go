@@ -0,0 +1,414 @@
+package cpln
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/common"
+	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure data source implements required interfaces.
+var (
+	_ datasource.DataSource              = &QueryDataSource{}
+	_ datasource.DataSourceWithConfigure = &QueryDataSource{}
+)
+
+/*** Data Source Configuration ***/
+
+// QueryDataSource is a data source listing the metadata of every item of a kind that matches a query.
+type QueryDataSource struct {
+	EntityBase
+	Kind        string
+	TypeName    string
+	ItemsName   string
+	EntityName  string
+	IsGvcScoped bool
+}
+
+// NewWorkloadsDataSource returns a data source listing the workloads of a GVC.
+func NewWorkloadsDataSource() datasource.DataSource {
+	return &QueryDataSource{Kind: "workload", TypeName: "cpln_workloads", ItemsName: "workloads", EntityName: "workload", IsGvcScoped: true}
+}
+
+// NewGvcsDataSource returns a data source listing the GVCs of the org.
+func NewGvcsDataSource() datasource.DataSource {
+	return &QueryDataSource{Kind: "gvc", TypeName: "cpln_gvcs", ItemsName: "gvcs", EntityName: "GVC"}
+}
+
+// NewSecretsDataSource returns a data source listing the secrets of the org, without revealing their values.
+func NewSecretsDataSource() datasource.DataSource {
+	return &QueryDataSource{Kind: "secret", TypeName: "cpln_secrets", ItemsName: "secrets", EntityName: "secret"}
+}
+
+// NewIdentitiesDataSource returns a data source listing the identities of a GVC.
+func NewIdentitiesDataSource() datasource.DataSource {
+	return &QueryDataSource{Kind: "identity", TypeName: "cpln_identities", ItemsName: "identities", EntityName: "identity", IsGvcScoped: true}
+}
+
+// NewDomainsDataSource returns a data source listing the domains of the org.
+func NewDomainsDataSource() datasource.DataSource {
+	return &QueryDataSource{Kind: "domain", TypeName: "cpln_domains", ItemsName: "domains", EntityName: "domain"}
+}
+
+// NewPoliciesDataSource returns a data source listing the policies of the org.
+func NewPoliciesDataSource() datasource.DataSource {
+	return &QueryDataSource{Kind: "policy", TypeName: "cpln_policies", ItemsName: "policies", EntityName: "policy"}
+}
+
+// NewGroupsDataSource returns a data source listing the groups of the org.
+func NewGroupsDataSource() datasource.DataSource {
+	return &QueryDataSource{Kind: "group", TypeName: "cpln_groups", ItemsName: "groups", EntityName: "group"}
+}
+
+// Metadata provides the data source type name.
+func (d *QueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+// Configure configures the data source before use.
+func (d *QueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the data source.
+func (d *QueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Define the attributes shared by every kind
+	attributes := map[string]schema.Attribute{
+		d.ItemsName: schema.ListNestedAttribute{
+			Description: fmt.Sprintf("List of every %s matching the query.", d.EntityName),
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"cpln_id": schema.StringAttribute{
+						Description: fmt.Sprintf("The ID, in GUID format, of the %s.", d.EntityName),
+						Computed:    true,
+					},
+					"name": schema.StringAttribute{
+						Description: fmt.Sprintf("Name of the %s.", d.EntityName),
+						Computed:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: fmt.Sprintf("Description of the %s.", d.EntityName),
+						Computed:    true,
+					},
+					"tags": schema.MapAttribute{
+						Description: "Key-value map of resource tags.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"self_link": schema.StringAttribute{
+						Description: "Full link to this resource. Can be referenced by other resources.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	// Let the org to list the items of be chosen, defaulting to the provider org
+	attributes["org"] = schema.StringAttribute{
+		Description: fmt.Sprintf("The org to list the %s items of. Defaults to the org configured in the provider.", d.EntityName),
+		Optional:    true,
+		Computed:    true,
+	}
+
+	// Kinds that live within a GVC require its name
+	if d.IsGvcScoped {
+		attributes["gvc"] = schema.StringAttribute{
+			Description: fmt.Sprintf("Name of the GVC to list the %s items of.", d.EntityName),
+			Required:    true,
+			Validators: []validator.String{
+				validators.NameValidator{},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"query": schema.ListNestedBlock{
+				Description:  fmt.Sprintf("A predefined set of criteria or conditions used to query and retrieve the %s items. Every item of the kind is returned when omitted.", d.EntityName),
+				NestedObject: d.QueryDataSourceSchema(),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+// Read fetches the current state of the resource.
+func (d *QueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Declare variables to hold the configuration
+	var plannedQuery types.List
+	var orgName types.String
+	var gvcName types.String
+
+	// Populate the configuration from the request and capture diagnostics
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("query"), &plannedQuery)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("org"), &orgName)...)
+
+	// Read the GVC name for kinds that live within a GVC
+	if d.IsGvcScoped {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("gvc"), &gvcName)...)
+	}
+
+	// Abort if diagnostics errors occurred
+	if resp.Diagnostics.HasError() {
+		// Exit early on error
+		return
+	}
+
+	// Create a new operator instance
+	operator := QueryDataSourceOperator{
+		Ctx:         ctx,
+		Diags:       &resp.Diagnostics,
+		Client:      d.client.WithOrg(orgName.ValueString()),
+		Kind:        d.Kind,
+		Gvc:         gvcName.ValueString(),
+		IsGvcScoped: d.IsGvcScoped,
+		Query:       plannedQuery,
+	}
+
+	// Invoke API to read resource details
+	apiResp, err := operator.InvokeRead()
+
+	// Handle API invocation errors
+	if err != nil {
+		// Report API error
+		resp.Diagnostics.AddError("API error", err.Error())
+
+		// Exit on API error
+		return
+	}
+
+	// Abort if diagnostics errors occurred
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the items from API response
+	items := operator.flattenItems(apiResp.Items)
+
+	// Abort if diagnostics errors occurred
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Persist updated state into Terraform
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(d.ItemsName), items)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("query"), operator.Query)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org"), types.StringValue(operator.Client.Org))...)
+
+	// Persist the GVC name for kinds that live within a GVC
+	if d.IsGvcScoped {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gvc"), gvcName)...)
+	}
+}
+
+/*** Schemas ***/
+
+// QueryDataSourceSchema returns the data source counterpart of the query block returned by QuerySchema.
+func (d *QueryDataSource) QueryDataSourceSchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"fetch": schema.StringAttribute{
+				Description: "Type of fetch. Specify either: `links` or `items`. Default: `items`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.QueryFetchItems, client.QueryFetchLinks),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"spec": schema.ListNestedBlock{
+				Description: "The specification of the query.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"match": schema.StringAttribute{
+							Description: "Type of match. Available values: `all`, `any`, `none`. Default: `all`.",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.QueryMatchAll, client.QueryMatchAny, client.QueryMatchNone),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"terms": schema.ListNestedBlock{
+							Description: "Terms can only contain one of the following attributes: `property`, `rel`, `tag`.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"op": schema.StringAttribute{
+										Description: "Type of query operation. Available values: `=`, `>`, `>=`, `<`, `<=`, `!=`, `~`, `=~`, `exists`, `!exists`, `contains`. Default: `=`.",
+										Optional:    true,
+										Computed:    true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("=", ">", ">=", "<", "<=", "!=", "~", "=~", "exists", "!exists", "contains"),
+										},
+									},
+									"property": schema.StringAttribute{
+										Description: "Property to use for query evaluation.",
+										Optional:    true,
+									},
+									"rel": schema.StringAttribute{
+										Description: "Relation to use for query evaluation.",
+										Optional:    true,
+									},
+									"tag": schema.StringAttribute{
+										Description: "Tag key to use for query evaluation.",
+										Optional:    true,
+									},
+									"value": schema.StringAttribute{
+										Description: "Testing value for query evaluation.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+/*** Data Source Operator ***/
+
+// QueryDataSourceOperator is the operator for managing the state.
+type QueryDataSourceOperator struct {
+	Ctx         context.Context
+	Diags       *diag.Diagnostics
+	Client      *client.Client
+	Kind        string
+	Gvc         string
+	IsGvcScoped bool
+	Query       types.List
+}
+
+// InvokeRead invokes the query API to retrieve every item matching the configured query.
+func (qdo *QueryDataSourceOperator) InvokeRead() (*client.QueryResult[client.Base], error) {
+	// Build the query to send, defaulting to every item of the kind
+	query := qdo.BuildQuery()
+
+	// Abort if the query could not be built, the diagnostics describe why
+	if qdo.Diags.HasError() {
+		return nil, nil
+	}
+
+	// Decode the items as their base metadata only, so no kind-specific values such as secret data are kept
+	var result *client.QueryResult[client.Base]
+	var err error
+
+	// Query within the GVC for kinds that live within one
+	if qdo.IsGvcScoped {
+		result, _, err = client.QueryGvcKind[client.Base](qdo.Ctx, qdo.Client, qdo.Gvc, qdo.Kind, query)
+	} else {
+		result, _, err = client.QueryKind[client.Base](qdo.Ctx, qdo.Client, qdo.Kind, query)
+	}
+
+	// Return the result with any error encountered
+	return result, err
+}
+
+// Builders //
+
+// BuildQuery constructs the query to send from the configured query, filling in the defaults of unset attributes
+// so the configured query can be persisted with its effective values.
+func (qdo *QueryDataSourceOperator) BuildQuery() client.Query {
+	// Initialize default query to fetch every item of the kind
+	query := client.NewQuery(qdo.Kind, client.QueryMatchAll)
+
+	// Return the default query if no custom query was configured
+	if qdo.Query.IsNull() || qdo.Query.IsUnknown() {
+		return query
+	}
+
+	// Build a custom query from the configured query
+	plannedQuery := BuildQuery(qdo.Ctx, qdo.Diags, qdo.Query)
+
+	// Fall back to the default query if the custom query is empty
+	if plannedQuery == nil {
+		return query
+	}
+
+	// Default the fetch mode
+	if plannedQuery.Fetch == nil {
+		plannedQuery.Fetch = query.Fetch
+	}
+
+	// Default the match mode and term operators of the specification
+	if plannedQuery.Spec != nil {
+		if plannedQuery.Spec.Match == nil {
+			plannedQuery.Spec.Match = query.Spec.Match
+		}
+
+		if plannedQuery.Spec.Terms != nil {
+			for i := range *plannedQuery.Spec.Terms {
+				if (*plannedQuery.Spec.Terms)[i].Op == nil {
+					(*plannedQuery.Spec.Terms)[i].Op = StringPointer(client.QueryOpEquals)
+				}
+			}
+		}
+	}
+
+	// Persist the configured query with its effective values
+	qdo.Query = FlattenQuery(qdo.Ctx, qdo.Diags, plannedQuery)
+
+	// Match every item when no specification was configured
+	if plannedQuery.Spec == nil {
+		plannedQuery.Spec = query.Spec
+	}
+
+	// Query the requested kind
+	plannedQuery.Kind = query.Kind
+
+	// Return the custom query
+	return *plannedQuery
+}
+
+// Flatteners //
+
+// flattenItems transforms []client.Base into a Terraform types.List.
+func (qdo *QueryDataSourceOperator) flattenItems(input []client.Base) types.List {
+	// Return an empty list when nothing matched so the result can always be iterated over
+	if len(input) == 0 {
+		return types.ListValueMust(models.QueryItemModel{}.AttributeTypes(), []attr.Value{})
+	}
+
+	// Define the blocks slice
+	blocks := []models.QueryItemModel{}
+
+	// Iterate over the slice and construct the blocks
+	for _, item := range input {
+		// Construct a block
+		block := models.QueryItemModel{
+			CplnId:      types.StringPointerValue(item.ID),
+			Name:        types.StringPointerValue(item.Name),
+			Description: types.StringPointerValue(item.Description),
+			Tags:        FlattenTags(item.Tags),
+			SelfLink:    FlattenSelfLink(item.Links),
+		}
+
+		// Append the constructed block to the blocks slice
+		blocks = append(blocks, block)
+	}
+
+	// Return the successfully created types.List
+	return FlattenList(qdo.Ctx, qdo.Diags, blocks)
+}
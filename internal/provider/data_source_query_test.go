@@ -0,0 +1,212 @@
+package cpln
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneDataSourceQuery_basic performs an acceptance test for the query data sources.
+func TestAccControlPlaneDataSourceQuery_basic(t *testing.T) {
+	// Initialize the test
+	dataSourceTest := NewQueryDataSourceTest()
+
+	// Run the acceptance test case for the data sources, covering read functionality
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t, "DATA_SOURCE_QUERY") },
+		ProtoV6ProviderFactories: GetProviderServer(),
+		Steps:                    dataSourceTest.Steps,
+	})
+}
+
+/*** Unit Tests ***/
+
+// TestQueryDataSourceSchemas verifies that the schema of every query data source is valid.
+func TestQueryDataSourceSchemas(t *testing.T) {
+	// Iterate over every query data source
+	for _, newDataSource := range []func() datasource.DataSource{
+		NewDomainsDataSource,
+		NewGroupsDataSource,
+		NewGvcsDataSource,
+		NewIdentitiesDataSource,
+		NewPoliciesDataSource,
+		NewSecretsDataSource,
+		NewWorkloadsDataSource,
+	} {
+		// Retrieve the schema of the data source
+		resp := datasource.SchemaResponse{}
+		newDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+
+		// Validate the schema implementation
+		if diags := resp.Schema.ValidateImplementation(context.Background()); diags.HasError() {
+			t.Fatalf("invalid schema: %v", diags)
+		}
+	}
+}
+
+// TestQueryDataSourceBuildQuery verifies that the configured query is sent with its defaults filled in.
+func TestQueryDataSourceBuildQuery(t *testing.T) {
+	// Initialize the diagnostics
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	// Build a configured query with a single tag term and no defaults
+	configured := FlattenQuery(ctx, &diags, &client.Query{
+		Spec: &client.QuerySpec{
+			Terms: &[]client.QueryTerm{
+				{Tag: StringPointer("team"), Value: StringPointer("payments")},
+			},
+		},
+	})
+
+	// Initialize the operator with the configured query
+	operator := QueryDataSourceOperator{Ctx: ctx, Diags: &diags, Kind: "workload", Query: configured}
+
+	// Build the query to send
+	query := operator.BuildQuery()
+
+	// Fail on diagnostics errors
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Verify the defaults were filled in
+	if *query.Kind != "workload" || *query.Fetch != client.QueryFetchItems || *query.Spec.Match != client.QueryMatchAll {
+		t.Fatalf("expected the query defaults to be filled in, got kind %s, fetch %s, match %s", *query.Kind, *query.Fetch, *query.Spec.Match)
+	}
+
+	// Verify the term operator was defaulted
+	if op := (*query.Spec.Terms)[0].Op; op == nil || *op != client.QueryOpEquals {
+		t.Fatalf("expected the term operator to default to %s, got %v", client.QueryOpEquals, op)
+	}
+
+	// Verify the persisted query reflects the effective values
+	if operator.Query.IsNull() {
+		t.Fatalf("expected the configured query to be persisted")
+	}
+
+	// Build an operator without a configured query
+	operator = QueryDataSourceOperator{Ctx: ctx, Diags: &diags, Kind: "gvc", Query: types.ListNull(models.QueryModel{}.AttributeTypes())}
+
+	// Verify every item of the kind is queried and the query stays out of the state
+	if query = operator.BuildQuery(); *query.Kind != "gvc" || *query.Spec.Match != client.QueryMatchAll || !operator.Query.IsNull() {
+		t.Fatalf("expected the default query for every gvc, got %+v", query)
+	}
+}
+
+/*** Data Source Test ***/
+
+// QueryDataSourceTest defines the necessary functionality to test the data sources.
+type QueryDataSourceTest struct {
+	Steps []resource.TestStep
+}
+
+// NewQueryDataSourceTest creates a QueryDataSourceTest with initialized test cases.
+func NewQueryDataSourceTest() QueryDataSourceTest {
+	// Create a data source test instance
+	dataSourceTest := QueryDataSourceTest{}
+
+	// Initialize the test steps slice
+	steps := []resource.TestStep{}
+
+	// Fill the steps slice
+	steps = append(steps, dataSourceTest.NewTaggedScenario()...)
+
+	// Set the cases for the data source test
+	dataSourceTest.Steps = steps
+
+	// Return the data source test
+	return dataSourceTest
+}
+
+// Test Scenarios //
+
+// NewTaggedScenario creates a test case that lists the GVCs and workloads carrying a tag.
+func (qdst *QueryDataSourceTest) NewTaggedScenario() []resource.TestStep {
+	// Generate unique names for the resources
+	random := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gvcName := fmt.Sprintf("tf-query-gvc-%s", random)
+	team := fmt.Sprintf("team-%s", random)
+
+	// Return the complete test steps
+	return []resource.TestStep{
+		// Read
+		{
+			Config: qdst.TaggedHcl(gvcName, team),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.cpln_gvcs.tagged", "gvcs.#", "1"),
+				resource.TestCheckResourceAttr("data.cpln_gvcs.tagged", "gvcs.0.name", gvcName),
+				resource.TestCheckResourceAttr("data.cpln_gvcs.tagged", "gvcs.0.tags.team", team),
+				resource.TestCheckResourceAttr("data.cpln_gvcs.tagged", "query.0.fetch", "items"),
+				resource.TestCheckResourceAttr("data.cpln_gvcs.tagged", "query.0.spec.0.match", "all"),
+				resource.TestCheckResourceAttr("data.cpln_gvcs.tagged", "query.0.spec.0.terms.0.op", "="),
+				resource.TestCheckResourceAttr("data.cpln_workloads.all", "workloads.#", "1"),
+				resource.TestCheckResourceAttr("data.cpln_workloads.all", "workloads.0.name", "httpbin"),
+				resource.TestCheckResourceAttrSet("data.cpln_workloads.all", "workloads.0.self_link"),
+			),
+		},
+	}
+}
+
+// Configs //
+
+// TaggedHcl returns a configuration listing the resources it creates.
+func (qdst *QueryDataSourceTest) TaggedHcl(gvcName string, team string) string {
+	return fmt.Sprintf(`
+resource "cpln_gvc" "new" {
+  name        = "%s"
+  description = "query data sources"
+
+  locations = ["aws-eu-central-1"]
+
+  tags = {
+    team = "%s"
+  }
+}
+
+resource "cpln_workload" "new" {
+  gvc  = cpln_gvc.new.name
+  name = "httpbin"
+  type = "serverless"
+
+  container {
+    name  = "container-01"
+    image = "gcr.io/knative-samples/helloworld-go"
+
+    ports {
+      protocol = "http"
+      number   = "8080"
+    }
+  }
+}
+
+data "cpln_gvcs" "tagged" {
+  query {
+    spec {
+      terms {
+        tag   = "team"
+        value = "%s"
+      }
+    }
+  }
+
+  depends_on = [cpln_gvc.new]
+}
+
+data "cpln_workloads" "all" {
+  gvc = cpln_gvc.new.name
+
+  depends_on = [cpln_workload.new]
+}
+`, gvcName, team, team)
+}
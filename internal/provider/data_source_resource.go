@@ -0,0 +1,446 @@
+package cpln
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure data source implements required interfaces.
+var (
+	_ datasource.DataSource              = &ResourceDataSource[DomainResourceModel, client.Domain]{}
+	_ datasource.DataSourceWithConfigure = &ResourceDataSource[DomainResourceModel, client.Domain]{}
+)
+
+/*** Data Source Configuration ***/
+
+// ResourceDataSource is a read-only data source exposing an existing resource. Its schema is derived from the
+// schema of the resource, with the lookup attributes required and every other attribute computed, and its state is
+// built by the operator of the resource.
+type ResourceDataSource[Plan HasEntityID, APIObject any] struct {
+	EntityBase
+	TypeName         string
+	EntityName       string
+	Resource         resource.Resource
+	Prototype        EntityOperatorInterface[Plan, APIObject]
+	LookupAttributes []string
+	Operations       EntityOperations[Plan, APIObject]
+}
+
+// NewAgentDataSource returns a new instance of the agent data source.
+func NewAgentDataSource() datasource.DataSource {
+	return &ResourceDataSource[AgentResourceModel, client.Agent]{
+		TypeName:         "cpln_agent",
+		EntityName:       "Agent",
+		Resource:         NewAgentResource(),
+		Prototype:        &AgentResourceOperator{},
+		LookupAttributes: []string{"name"},
+	}
+}
+
+// NewCustomLocationDataSource returns a new instance of the custom location data source.
+func NewCustomLocationDataSource() datasource.DataSource {
+	return &ResourceDataSource[CustomLocationResourceModel, client.Location]{
+		TypeName:         "cpln_custom_location",
+		EntityName:       "Custom location",
+		Resource:         NewCustomLocationResource(),
+		Prototype:        &CustomLocationResourceOperator{},
+		LookupAttributes: []string{"name"},
+	}
+}
+
+// NewDomainDataSource returns a new instance of the domain data source.
+func NewDomainDataSource() datasource.DataSource {
+	return &ResourceDataSource[DomainResourceModel, client.Domain]{
+		TypeName:         "cpln_domain",
+		EntityName:       "Domain",
+		Resource:         NewDomainResource(),
+		Prototype:        &DomainResourceOperator{},
+		LookupAttributes: []string{"name"},
+	}
+}
+
+// NewGroupDataSource returns a new instance of the group data source.
+func NewGroupDataSource() datasource.DataSource {
+	return &ResourceDataSource[GroupResourceModel, client.Group]{
+		TypeName:         "cpln_group",
+		EntityName:       "Group",
+		Resource:         NewGroupResource(),
+		Prototype:        &GroupResourceOperator{},
+		LookupAttributes: []string{"name"},
+	}
+}
+
+// NewIdentityDataSource returns a new instance of the identity data source.
+func NewIdentityDataSource() datasource.DataSource {
+	return &ResourceDataSource[IdentityResourceModel, client.Identity]{
+		TypeName:         "cpln_identity",
+		EntityName:       "Identity",
+		Resource:         NewIdentityResource(),
+		Prototype:        &IdentityResourceOperator{},
+		LookupAttributes: []string{"name", "gvc"},
+	}
+}
+
+// NewIpSetDataSource returns a new instance of the IP set data source.
+func NewIpSetDataSource() datasource.DataSource {
+	return &ResourceDataSource[IpSetResourceModel, client.IpSet]{
+		TypeName:         "cpln_ipset",
+		EntityName:       "IP set",
+		Resource:         NewIpSetResource(),
+		Prototype:        &IpSetResourceOperator{},
+		LookupAttributes: []string{"name"},
+	}
+}
+
+// NewMk8sDataSource returns a new instance of the mk8s data source.
+func NewMk8sDataSource() datasource.DataSource {
+	return &ResourceDataSource[Mk8sResourceModel, client.Mk8s]{
+		TypeName:         "cpln_mk8s",
+		EntityName:       "Mk8s",
+		Resource:         NewMk8sResource(),
+		Prototype:        &Mk8sResourceOperator{},
+		LookupAttributes: []string{"name"},
+	}
+}
+
+// NewPolicyDataSource returns a new instance of the policy data source.
+func NewPolicyDataSource() datasource.DataSource {
+	return &ResourceDataSource[PolicyResourceModel, client.Policy]{
+		TypeName:         "cpln_policy",
+		EntityName:       "Policy",
+		Resource:         NewPolicyResource(),
+		Prototype:        &PolicyResourceOperator{},
+		LookupAttributes: []string{"name"},
+	}
+}
+
+// NewServiceAccountDataSource returns a new instance of the service account data source.
+func NewServiceAccountDataSource() datasource.DataSource {
+	return &ResourceDataSource[ServiceAccountResourceModel, client.ServiceAccount]{
+		TypeName:         "cpln_service_account",
+		EntityName:       "Service account",
+		Resource:         NewServiceAccountResource(),
+		Prototype:        &ServiceAccountResourceOperator{},
+		LookupAttributes: []string{"name"},
+	}
+}
+
+// NewVolumeSetDataSource returns a new instance of the volume set data source.
+func NewVolumeSetDataSource() datasource.DataSource {
+	return &ResourceDataSource[VolumeSetResourceModel, client.VolumeSet]{
+		TypeName:         "cpln_volume_set",
+		EntityName:       "Volume set",
+		Resource:         NewVolumeSetResource(),
+		Prototype:        &VolumeSetResourceOperator{},
+		LookupAttributes: []string{"name", "gvc"},
+	}
+}
+
+// Metadata provides the data source type name.
+func (d *ResourceDataSource[Plan, APIObject]) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+// Configure configures the data source before use.
+func (d *ResourceDataSource[Plan, APIObject]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
+	d.Operations = NewEntityOperations(d.client, d.Prototype)
+}
+
+// Schema defines the schema for the data source.
+func (d *ResourceDataSource[Plan, APIObject]) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Retrieve the schema of the resource
+	resourceResp := resource.SchemaResponse{}
+	d.Resource.Schema(ctx, resource.SchemaRequest{}, &resourceResp)
+	resp.Diagnostics.Append(resourceResp.Diagnostics...)
+
+	// Derive the data source schema from the resource schema
+	resp.Schema = DataSourceSchemaFromResource(resourceResp.Schema, d.LookupAttributes...)
+}
+
+// Read fetches the current state of the resource.
+func (d *ResourceDataSource[Plan, APIObject]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Declare variables to hold the configuration
+	var config Plan
+	var name types.String
+
+	// Populate the configuration from the request and capture diagnostics
+	resp.Diagnostics.Append(GetResourceModelConfig(ctx, req.Config, &config)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	// Abort if diagnostics errors occurred
+	if resp.Diagnostics.HasError() {
+		// Exit early on error
+		return
+	}
+
+	// Create a new operator instance using the shared resource model
+	operator := d.Operations.NewOperator(ctx, &resp.Diagnostics, config)
+
+	// Invoke API to read resource details
+	apiResp, _, err := operator.InvokeRead(name.ValueString())
+
+	// Report a missing resource, a data source cannot produce an empty result
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("%s not found", d.EntityName), fmt.Sprintf("%s '%s' does not exist.", d.EntityName, name.ValueString()))
+		return
+	}
+
+	// Handle API invocation errors
+	if err != nil {
+		// Report API error
+		AddAPIError(&resp.Diagnostics, "API error", err)
+
+		// Exit on API error
+		return
+	}
+
+	// Build new state from API response, nothing was created so no create-only values are exposed
+	newState := operator.MapResponseToState(apiResp, false)
+
+	// Abort if diagnostics errors occurred
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Persist updated state into Terraform
+	resp.Diagnostics.Append(SetResourceModelState(ctx, &resp.State, &newState)...)
+
+	// Persist the org the resource was read from
+	SetStateOrg(ctx, &resp.Diagnostics, &resp.State, d.Operations.OrgOf(config))
+}
+
+/*** Resource Models ***/
+
+// resourceModelTimeoutsType is the type of the timeouts of a resource model, which data sources do not declare.
+var resourceModelTimeoutsType = timeouts.Type{ObjectType: types.ObjectType{AttrTypes: map[string]attr.Type{}}}
+
+// GetResourceModelConfig populates a resource model from the configuration of a data source that reuses it. Data
+// sources do not declare the timeouts of the resource, which are left null in the model.
+func GetResourceModelConfig(ctx context.Context, config tfsdk.Config, target any) diag.Diagnostics {
+	// Describe the configuration the way the resource model expects it
+	view, ok := resourceModelSchema(config.Schema)
+
+	// Decode the configuration as is when the schema cannot be described
+	if !ok {
+		return config.Get(ctx, target)
+	}
+
+	// Initialize diagnostics
+	var diags diag.Diagnostics
+
+	// Extract the configured attributes
+	var attributes map[string]tftypes.Value
+	if err := config.Raw.As(&attributes); err != nil {
+		diags.AddError("Invalid configuration", fmt.Sprintf("Unable to read the configuration of the data source: %s", err))
+		return diags
+	}
+
+	// Leave the timeouts of the resource null
+	attributes["timeouts"] = tftypes.NewValue(resourceModelTimeoutsType.TerraformType(ctx), nil)
+
+	// Decode the configuration into the resource model
+	return tfsdk.Config{Schema: view, Raw: tftypes.NewValue(view.Type().TerraformType(ctx), attributes)}.Get(ctx, target)
+}
+
+// SetResourceModelState persists a resource model into the state of a data source that reuses it, dropping the
+// timeouts of the resource.
+func SetResourceModelState(ctx context.Context, state *tfsdk.State, val any) diag.Diagnostics {
+	// Describe the state the way the resource model expects it
+	view, ok := resourceModelSchema(state.Schema)
+
+	// Persist the model as is when the schema cannot be described
+	if !ok {
+		return state.Set(ctx, val)
+	}
+
+	// Encode the resource model
+	encoded := tfsdk.State{Schema: view, Raw: tftypes.NewValue(view.Type().TerraformType(ctx), nil)}
+	diags := encoded.Set(ctx, val)
+
+	// Abort if diagnostics errors occurred
+	if diags.HasError() {
+		return diags
+	}
+
+	// Extract the encoded attributes
+	var attributes map[string]tftypes.Value
+	if err := encoded.Raw.As(&attributes); err != nil {
+		diags.AddError("Invalid state", fmt.Sprintf("Unable to encode the state of the data source: %s", err))
+		return diags
+	}
+
+	// Drop the timeouts the data source does not declare
+	delete(attributes, "timeouts")
+
+	// Persist the remaining attributes
+	state.Raw = tftypes.NewValue(state.Schema.Type().TerraformType(ctx), attributes)
+	return diags
+}
+
+// resourceModelSchema returns the schema of a data source extended with the timeouts of the resource model it reuses.
+func resourceModelSchema(input any) (schema.Schema, bool) {
+	// Only data source schemas without timeouts need to be extended
+	dataSourceSchema, ok := input.(schema.Schema)
+	if !ok || dataSourceSchema.Attributes["timeouts"] != nil || dataSourceSchema.Blocks["timeouts"] != nil {
+		return schema.Schema{}, false
+	}
+
+	// Copy the attributes, leaving the schema of the data source untouched
+	attributes := make(map[string]schema.Attribute, len(dataSourceSchema.Attributes)+1)
+	for name, attribute := range dataSourceSchema.Attributes {
+		attributes[name] = attribute
+	}
+
+	// Declare the timeouts of the resource model
+	attributes["timeouts"] = schema.ObjectAttribute{CustomType: resourceModelTimeoutsType, AttributeTypes: map[string]attr.Type{}, Optional: true}
+
+	// Return the extended schema
+	dataSourceSchema.Attributes = attributes
+	return dataSourceSchema, true
+}
+
+/*** Schemas ***/
+
+// DataSourceSchemaFromResource converts a resource schema into a read-only data source schema. The lookup
+// attributes are required, the org is optional, every other attribute is computed, and nested blocks become computed nested attributes
+// so that the resource model can be reused as is. The timeouts block is left out, as data sources do not declare timeouts.
+func DataSourceSchemaFromResource(input resourceschema.Schema, lookupAttributes ...string) schema.Schema {
+	// Collect the lookup attributes
+	lookup := map[string]bool{}
+	for _, name := range lookupAttributes {
+		lookup[name] = true
+	}
+
+	// Convert the top level attributes, requiring the lookup attributes
+	attributes := map[string]schema.Attribute{}
+	for name, attribute := range input.Attributes {
+		attributes[name] = dataSourceAttribute(attribute, lookup[name])
+	}
+
+	// Let the org to look the resource up in be chosen, defaulting to the provider org
+	if org, ok := input.Attributes["org"].(resourceschema.StringAttribute); ok && !lookup["org"] {
+		attributes["org"] = schema.StringAttribute{Description: org.Description, MarkdownDescription: org.MarkdownDescription, Optional: true, Computed: true}
+	}
+
+	// Convert the top level blocks into computed attributes
+	for name, block := range input.Blocks {
+		// Data sources do not declare timeouts
+		if name == "timeouts" {
+			continue
+		}
+
+		attributes[name] = dataSourceBlockAttribute(block)
+	}
+
+	// Return the data source schema
+	return schema.Schema{
+		Description:         input.Description,
+		MarkdownDescription: input.MarkdownDescription,
+		DeprecationMessage:  input.DeprecationMessage,
+		Attributes:          attributes,
+		Blocks:              map[string]schema.Block{},
+	}
+}
+
+// DataSourceOrgSchema returns an optional StringAttribute schema for the org to look the entity up in.
+func DataSourceOrgSchema(entityName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The org the %s belongs to. Defaults to the org configured in the provider.", entityName),
+		Optional:    true,
+		Computed:    true,
+	}
+}
+
+// dataSourceAttribute converts a resource attribute into a data source attribute that is either required or
+// computed. Validators, plan modifiers and defaults only apply to managed resources and are dropped.
+func dataSourceAttribute(input resourceschema.Attribute, required bool) schema.Attribute {
+	// Every attribute that is not looked up is computed
+	computed := !required
+
+	switch a := input.(type) {
+	case resourceschema.StringAttribute:
+		return schema.StringAttribute{CustomType: a.CustomType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.BoolAttribute:
+		return schema.BoolAttribute{CustomType: a.CustomType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.Int32Attribute:
+		return schema.Int32Attribute{CustomType: a.CustomType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.Int64Attribute:
+		return schema.Int64Attribute{CustomType: a.CustomType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.Float32Attribute:
+		return schema.Float32Attribute{CustomType: a.CustomType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.Float64Attribute:
+		return schema.Float64Attribute{CustomType: a.CustomType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.NumberAttribute:
+		return schema.NumberAttribute{CustomType: a.CustomType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.ListAttribute:
+		return schema.ListAttribute{CustomType: a.CustomType, ElementType: a.ElementType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.SetAttribute:
+		return schema.SetAttribute{CustomType: a.CustomType, ElementType: a.ElementType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.MapAttribute:
+		return schema.MapAttribute{CustomType: a.CustomType, ElementType: a.ElementType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.ObjectAttribute:
+		return schema.ObjectAttribute{CustomType: a.CustomType, AttributeTypes: a.AttributeTypes, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.ListNestedAttribute:
+		return schema.ListNestedAttribute{CustomType: a.CustomType, NestedObject: dataSourceNestedAttributeObject(a.NestedObject.Attributes, nil), Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.SetNestedAttribute:
+		return schema.SetNestedAttribute{CustomType: a.CustomType, NestedObject: dataSourceNestedAttributeObject(a.NestedObject.Attributes, nil), Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.MapNestedAttribute:
+		return schema.MapNestedAttribute{CustomType: a.CustomType, NestedObject: dataSourceNestedAttributeObject(a.NestedObject.Attributes, nil), Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{CustomType: a.CustomType, Attributes: dataSourceNestedAttributeObject(a.Attributes, nil).Attributes, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	case resourceschema.DynamicAttribute:
+		return schema.DynamicAttribute{CustomType: a.CustomType, Description: a.Description, MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Required: required, Computed: computed}
+	}
+
+	// Every attribute type of the framework is handled above
+	panic(fmt.Sprintf("unsupported resource attribute type %T", input))
+}
+
+// dataSourceBlockAttribute converts a resource block into a computed data source nested attribute.
+func dataSourceBlockAttribute(input resourceschema.Block) schema.Attribute {
+	switch b := input.(type) {
+	case resourceschema.ListNestedBlock:
+		return schema.ListNestedAttribute{CustomType: b.CustomType, NestedObject: dataSourceNestedAttributeObject(b.NestedObject.Attributes, b.NestedObject.Blocks), Description: b.Description, MarkdownDescription: b.MarkdownDescription, Computed: true}
+	case resourceschema.SetNestedBlock:
+		return schema.SetNestedAttribute{CustomType: b.CustomType, NestedObject: dataSourceNestedAttributeObject(b.NestedObject.Attributes, b.NestedObject.Blocks), Description: b.Description, MarkdownDescription: b.MarkdownDescription, Computed: true}
+	case resourceschema.SingleNestedBlock:
+		return schema.SingleNestedAttribute{CustomType: b.CustomType, Attributes: dataSourceNestedAttributeObject(b.Attributes, b.Blocks).Attributes, Description: b.Description, MarkdownDescription: b.MarkdownDescription, Computed: true}
+	}
+
+	// Every block type of the framework is handled above
+	panic(fmt.Sprintf("unsupported resource block type %T", input))
+}
+
+// dataSourceNestedAttributeObject converts the attributes and blocks of a nested resource object into the
+// computed attributes of a data source nested object.
+func dataSourceNestedAttributeObject(attributes map[string]resourceschema.Attribute, blocks map[string]resourceschema.Block) schema.NestedAttributeObject {
+	// Initialize the nested attributes
+	output := map[string]schema.Attribute{}
+
+	// Convert the nested attributes
+	for name, attribute := range attributes {
+		output[name] = dataSourceAttribute(attribute, false)
+	}
+
+	// Convert the nested blocks
+	for name, block := range blocks {
+		output[name] = dataSourceBlockAttribute(block)
+	}
+
+	// Return the nested object
+	return schema.NestedAttributeObject{Attributes: output}
+}
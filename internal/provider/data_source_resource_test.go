@@ -0,0 +1,295 @@
+package cpln
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneDataSourceResource_basic performs an acceptance test for the data sources backed by resources.
+func TestAccControlPlaneDataSourceResource_basic(t *testing.T) {
+	// Generate unique names for the resources
+	random := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gvcName := fmt.Sprintf("tf-ds-gvc-%s", random)
+	name := fmt.Sprintf("tf-ds-%s", random)
+
+	// Run the acceptance test case for the data sources, covering read functionality
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t, "DATA_SOURCE_RESOURCE") },
+		ProtoV6ProviderFactories: GetProviderServer(),
+		Steps: []resource.TestStep{
+			// Read
+			{
+				Config: ResourceDataSourceHcl(gvcName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cpln_service_account.new", "self_link", "cpln_service_account.new", "self_link"),
+					resource.TestCheckResourceAttrPair("data.cpln_service_account.new", "description", "cpln_service_account.new", "description"),
+					resource.TestCheckResourceAttrPair("data.cpln_group.new", "self_link", "cpln_group.new", "self_link"),
+					resource.TestCheckResourceAttrPair("data.cpln_group.new", "tags.team", "cpln_group.new", "tags.team"),
+					resource.TestCheckResourceAttrPair("data.cpln_identity.new", "self_link", "cpln_identity.new", "self_link"),
+					resource.TestCheckResourceAttr("data.cpln_identity.new", "gvc", gvcName),
+					resource.TestCheckResourceAttrPair("data.cpln_policy.new", "self_link", "cpln_policy.new", "self_link"),
+					resource.TestCheckResourceAttr("data.cpln_policy.new", "target_kind", "serviceaccount"),
+					resource.TestCheckResourceAttr("data.cpln_policy.new", "binding.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.cpln_policy.new", "binding.*", map[string]string{"permissions.#": "1", "principal_links.#": "1"}),
+					resource.TestCheckTypeSetElemAttr("data.cpln_policy.new", "binding.*.permissions.*", "view"),
+					resource.TestCheckTypeSetElemAttr("data.cpln_policy.new", "binding.*.principal_links.*", fmt.Sprintf("group/%s", name)),
+					resource.TestCheckResourceAttrPair("data.cpln_volume_set.new", "self_link", "cpln_volume_set.new", "self_link"),
+					resource.TestCheckResourceAttr("data.cpln_volume_set.new", "gvc", gvcName),
+					resource.TestCheckResourceAttr("data.cpln_volume_set.new", "initial_capacity", "10"),
+					resource.TestCheckResourceAttr("data.cpln_volume_set.new", "performance_class", "general-purpose-ssd"),
+					resource.TestCheckResourceAttr("data.cpln_volume_set.new", "snapshots.0.retention_duration", "2d"),
+					resource.TestCheckResourceAttr("data.cpln_volume_set.new", "snapshots.0.schedule", "0 * * * *"),
+					resource.TestCheckResourceAttr("data.cpln_volume_set.new", "autoscaling.0.max_capacity", "100"),
+					resource.TestCheckResourceAttr("data.cpln_volume_set.new", "autoscaling.0.min_free_percentage", "20"),
+				),
+			},
+		},
+	})
+}
+
+/*** Unit Tests ***/
+
+// TestResourceDataSourceModels verifies that every data source backed by a resource has a valid schema matching
+// the resource model it reuses.
+func TestResourceDataSourceModels(t *testing.T) {
+	checkResourceDataSourceModel[AgentResourceModel](t, NewAgentDataSource())
+	checkResourceDataSourceModel[CustomLocationResourceModel](t, NewCustomLocationDataSource())
+	checkResourceDataSourceModel[DomainResourceModel](t, NewDomainDataSource())
+	checkResourceDataSourceModel[GroupResourceModel](t, NewGroupDataSource())
+	checkResourceDataSourceModel[IdentityResourceModel](t, NewIdentityDataSource())
+	checkResourceDataSourceModel[IpSetResourceModel](t, NewIpSetDataSource())
+	checkResourceDataSourceModel[Mk8sResourceModel](t, NewMk8sDataSource())
+	checkResourceDataSourceModel[PolicyResourceModel](t, NewPolicyDataSource())
+	checkResourceDataSourceModel[ServiceAccountResourceModel](t, NewServiceAccountDataSource())
+	checkResourceDataSourceModel[VolumeSetResourceModel](t, NewVolumeSetDataSource())
+}
+
+// TestResourceModelDataSources verifies that the other data sources reusing the model of a resource have a valid schema
+// matching that model.
+func TestResourceModelDataSources(t *testing.T) {
+	checkResourceDataSourceModel[GvcResourceModel](t, NewGvcDataSource())
+	checkResourceDataSourceModel[LocationResourceModel](t, NewLocationDataSource())
+	checkResourceDataSourceModel[OrgResourceModel](t, NewOrgDataSource())
+}
+
+// TestResourceModelConfigAndState verifies that a resource model is read from a data source configuration with null
+// timeouts, and persisted into the data source state without them.
+func TestResourceModelConfigAndState(t *testing.T) {
+	// Initialize the context
+	ctx := context.Background()
+
+	// Retrieve the schema of the data source
+	resp := datasource.SchemaResponse{}
+	NewGroupDataSource().Schema(ctx, datasource.SchemaRequest{}, &resp)
+
+	// Build a configuration looking the group up by name
+	objectType := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "my-group")
+	config := tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	// Decode the configuration into the resource model
+	var model GroupResourceModel
+	if diags := GetResourceModelConfig(ctx, config, &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Verify the configured attributes are read and the timeouts are null
+	if model.Name.ValueString() != "my-group" || !model.Timeouts.IsNull() {
+		t.Fatalf("unexpected model: name=%s timeouts=%s", model.Name, model.Timeouts)
+	}
+
+	// Persist the model into the state of the data source
+	model.Description = types.StringValue("read")
+	state := tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	if diags := SetResourceModelState(ctx, &state, &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Verify the state matches the schema of the data source
+	if !state.Raw.Type().Equal(objectType) {
+		t.Fatalf("state type = %s, want %s", state.Raw.Type(), objectType)
+	}
+
+	// Verify the attributes are persisted
+	var description types.String
+	state.GetAttribute(ctx, path.Root("description"), &description)
+	if description.ValueString() != "read" {
+		t.Fatalf("description = %s, want read", description)
+	}
+}
+
+// TestResourceDataSourceLookupAttributes verifies that only the lookup attributes are required and that the org can be
+// overridden.
+func TestResourceDataSourceLookupAttributes(t *testing.T) {
+	// Retrieve the schema of a GVC scoped data source
+	resp := datasource.SchemaResponse{}
+	NewIdentityDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+
+	// Iterate over every top level attribute
+	for name, attribute := range resp.Schema.Attributes {
+		// The org defaults to the provider org and may be overridden
+		if name == "org" {
+			if attribute.IsRequired() || !attribute.IsComputed() || !attribute.IsOptional() {
+				t.Errorf("attribute org: expected optional and computed, got required=%t computed=%t optional=%t", attribute.IsRequired(), attribute.IsComputed(), attribute.IsOptional())
+			}
+			continue
+		}
+
+		// Determine whether the attribute is looked up
+		isLookup := name == "name" || name == "gvc"
+
+		// Verify lookup attributes are required and every other attribute is computed
+		if attribute.IsRequired() != isLookup || attribute.IsComputed() == isLookup || attribute.IsOptional() {
+			t.Errorf("attribute %s: expected required=%t, got required=%t computed=%t optional=%t", name, isLookup, attribute.IsRequired(), attribute.IsComputed(), attribute.IsOptional())
+		}
+	}
+
+	// Verify blocks were turned into computed nested attributes
+	if _, ok := resp.Schema.Attributes["aws_access_policy"].(datasourceschema.ListNestedAttribute); !ok {
+		t.Errorf("expected the aws_access_policy block to become a computed list nested attribute")
+	}
+
+	// Verify data sources do not declare timeouts
+	if _, ok := resp.Schema.Blocks["timeouts"]; ok {
+		t.Errorf("expected no timeouts block")
+	}
+}
+
+// checkResourceDataSourceModel validates the schema of the data source and decodes a null configuration into the
+// resource model it reuses.
+func checkResourceDataSourceModel[Plan HasEntityID](t *testing.T, d datasource.DataSource) {
+	// Initialize the context
+	ctx := context.Background()
+
+	// Retrieve the schema of the data source
+	resp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &resp)
+
+	// Retrieve the type name of the data source
+	metadata := datasource.MetadataResponse{}
+	d.Metadata(ctx, datasource.MetadataRequest{}, &metadata)
+
+	// Validate the schema implementation
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("%s: invalid schema: %v", metadata.TypeName, diags)
+	}
+
+	// Build a configuration where every attribute is null
+	objectType := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	// Decode the configuration into the resource model
+	var model Plan
+	config := tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	if diags := GetResourceModelConfig(ctx, config, &model); diags.HasError() {
+		t.Fatalf("%s: the schema does not match the resource model: %v", metadata.TypeName, diags)
+	}
+
+	// Verify the model can be persisted back into the state
+	state := tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	if diags := SetResourceModelState(ctx, &state, &model); diags.HasError() {
+		t.Fatalf("%s: the resource model cannot be persisted: %v", metadata.TypeName, diags)
+	}
+}
+
+// ResourceDataSourceHcl returns a configuration reading back the resources it creates.
+func ResourceDataSourceHcl(gvcName string, name string) string {
+	return fmt.Sprintf(`
+resource "cpln_gvc" "new" {
+  name        = "%s"
+  description = "resource data sources"
+  locations   = ["aws-eu-central-1"]
+}
+
+resource "cpln_identity" "new" {
+  gvc  = cpln_gvc.new.name
+  name = "%s"
+}
+
+resource "cpln_service_account" "new" {
+  name        = "%s"
+  description = "resource data sources"
+}
+
+resource "cpln_group" "new" {
+  name = "%s"
+
+  tags = {
+    team = "payments"
+  }
+}
+
+resource "cpln_policy" "new" {
+  name        = "%s"
+  description = "resource data sources"
+  target_kind = "serviceaccount"
+  target      = "all"
+
+  binding {
+    permissions     = ["view"]
+    principal_links = ["group/${cpln_group.new.name}"]
+  }
+}
+
+resource "cpln_volume_set" "new" {
+  gvc               = cpln_gvc.new.name
+  name              = "%s"
+  initial_capacity  = 10
+  performance_class = "general-purpose-ssd"
+  file_system_type  = "ext4"
+
+  snapshots {
+    create_final_snapshot = false
+    retention_duration    = "2d"
+    schedule              = "0 * * * *"
+  }
+
+  autoscaling {
+    max_capacity        = 100
+    min_free_percentage = 20
+    scaling_factor      = 1.5
+  }
+}
+
+data "cpln_identity" "new" {
+  gvc  = cpln_identity.new.gvc
+  name = cpln_identity.new.name
+}
+
+data "cpln_service_account" "new" {
+  name = cpln_service_account.new.name
+}
+
+data "cpln_group" "new" {
+  name = cpln_group.new.name
+}
+
+data "cpln_policy" "new" {
+  name = cpln_policy.new.name
+}
+
+data "cpln_volume_set" "new" {
+  gvc  = cpln_volume_set.new.gvc
+  name = cpln_volume_set.new.name
+}
+`, gvcName, name, name, name, name, name)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/secret"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,6 +16,29 @@ var (
 	_ datasource.DataSourceWithConfigure = &SecretDataSource{}
 )
 
+/*** Data Source Model ***/
+
+// SecretDataModel holds the revealed secret shared by the data source and the ephemeral resource.
+type SecretDataModel struct {
+	EntityBaseModel
+	Opaque           []models.OpaqueModel         `tfsdk:"opaque"`
+	TLS              []models.TlsModel            `tfsdk:"tls"`
+	GCP              types.String                 `tfsdk:"gcp"`
+	AWS              []models.AwsModel            `tfsdk:"aws"`
+	ECR              []models.EcrModel            `tfsdk:"ecr"`
+	Docker           types.String                 `tfsdk:"docker"`
+	UsernamePassword []models.UserpassModel       `tfsdk:"userpass"`
+	KeyPair          []models.KeyPairModel        `tfsdk:"keypair"`
+	Dictionary       types.Map                    `tfsdk:"dictionary"`
+	DictionaryAsEnvs types.Map                    `tfsdk:"dictionary_as_envs"`
+	AzureSdk         types.String                 `tfsdk:"azure_sdk"`
+	AzureConnector   []models.AzureConnectorModel `tfsdk:"azure_connector"`
+	NatsAccount      []models.NatsAccountModel    `tfsdk:"nats_account"`
+	SecretLink       types.String                 `tfsdk:"secret_link"`
+}
+
+/*** Data Source Configuration ***/
+
 // SecretDataSource is the data source implementation.
 type SecretDataSource struct {
 	EntityBase
@@ -66,6 +90,7 @@ func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Description: "Full link to this resource. Can be referenced by other resources.",
 				Computed:    true,
 			},
+			"org": DataSourceOrgSchema("secret"),
 			"gcp": schema.StringAttribute{
 				MarkdownDescription: "JSON string containing the GCP secret. [Reference Page](https://docs.controlplane.com/reference/secret#gcp)",
 				Computed:            true,
@@ -270,7 +295,7 @@ func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 // Read fetches the current state of the resource.
 func (d *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Declare variable to hold existing state
-	var state SecretResourceModel
+	var state SecretDataModel
 
 	// Populate state from request and capture diagnostics
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
@@ -282,13 +307,13 @@ func (d *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Create a new operator instance
-	operator := d.Operations.NewOperator(ctx, &resp.Diagnostics, state)
+	operator := d.Operations.NewOperator(ctx, &resp.Diagnostics, SecretResourceModel{EntityBaseModel: state.EntityBaseModel})
 
 	// Invoke API to read resource details
-	apiResp, code, err := operator.InvokeRead(state.Name.ValueString())
+	apiResp, _, err := operator.InvokeRead(state.Name.ValueString())
 
 	// Remove resource from state if not found
-	if code == 404 {
+	if client.IsNotFound(err) {
 		// Drop resource from Terraform state
 		resp.State.RemoveResource(ctx)
 		return
@@ -304,13 +329,56 @@ func (d *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Build new state from API response
-	newState := operator.MapResponseToState(apiResp, true)
+	newState := NewSecretDataModel(operator.MapResponseToState(apiResp, true))
 
 	// Abort if diagnostics errors occurred
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Expose the org the secret was read from
+	newState.Org = types.StringValue(EntityOrg(state, d.Operations.Org))
+
 	// Persist updated state into Terraform
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
+
+/*** Helpers ***/
+
+// NewSecretDataModel converts the resource state built from an API response into the revealed secret exposed by the
+// data source and the ephemeral resource.
+func NewSecretDataModel(state SecretResourceModel) SecretDataModel {
+	return SecretDataModel{
+		EntityBaseModel:  state.EntityBaseModel,
+		Opaque:           unwrapSecretBlocks(state.Opaque, func(b models.OpaqueResourceModel) models.OpaqueModel { return b.OpaqueModel }),
+		TLS:              unwrapSecretBlocks(state.TLS, func(b models.TlsResourceModel) models.TlsModel { return b.TlsModel }),
+		GCP:              state.GCP,
+		AWS:              unwrapSecretBlocks(state.AWS, func(b models.AwsResourceModel) models.AwsModel { return b.AwsModel }),
+		ECR:              unwrapSecretBlocks(state.ECR, func(b models.EcrResourceModel) models.EcrModel { return b.EcrModel }),
+		Docker:           state.Docker,
+		UsernamePassword: unwrapSecretBlocks(state.UsernamePassword, func(b models.UserpassResourceModel) models.UserpassModel { return b.UserpassModel }),
+		KeyPair:          unwrapSecretBlocks(state.KeyPair, func(b models.KeyPairResourceModel) models.KeyPairModel { return b.KeyPairModel }),
+		Dictionary:       state.Dictionary,
+		DictionaryAsEnvs: state.DictionaryAsEnvs,
+		AzureSdk:         state.AzureSdk,
+		AzureConnector:   unwrapSecretBlocks(state.AzureConnector, func(b models.AzureConnectorResourceModel) models.AzureConnectorModel { return b.AzureConnectorModel }),
+		NatsAccount:      unwrapSecretBlocks(state.NatsAccount, func(b models.NatsAccountResourceModel) models.NatsAccountModel { return b.NatsAccountModel }),
+		SecretLink:       state.SecretLink,
+	}
+}
+
+// unwrapSecretBlocks strips the resource-only attributes from secret blocks.
+func unwrapSecretBlocks[R any, M any](blocks []R, unwrap func(R) M) []M {
+	// Preserve absent blocks
+	if blocks == nil {
+		return nil
+	}
+
+	// Unwrap every block
+	output := make([]M, 0, len(blocks))
+	for _, block := range blocks {
+		output = append(output, unwrap(block))
+	}
+
+	return output
+}
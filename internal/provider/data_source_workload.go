@@ -23,7 +23,7 @@ var (
 
 // WorkloadDataSourceModel holds the Terraform state for the data source.
 type WorkloadDataSourceModel struct {
-	WorkloadResourceModel
+	WorkloadModel
 	Health types.Object `tfsdk:"health"`
 }
 
@@ -81,6 +81,7 @@ func (d *WorkloadDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Description: "Full link to this resource. Can be referenced by other resources.",
 				Computed:    true,
 			},
+			"org": DataSourceOrgSchema("workload"),
 			"gvc": schema.StringAttribute{
 				Description: "Name of the associated GVC.",
 				Required:    true,
@@ -968,13 +969,13 @@ func (d *WorkloadDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Create a new operator instance using the shared resource model
-	operator := d.Operations.NewOperator(ctx, &resp.Diagnostics, config.WorkloadResourceModel)
+	operator := d.Operations.NewOperator(ctx, &resp.Diagnostics, WorkloadResourceModel{WorkloadModel: config.WorkloadModel})
 
 	// Invoke API to read resource details
-	apiResp, code, err := operator.InvokeRead(config.Name.ValueString())
+	apiResp, _, err := operator.InvokeRead(config.Name.ValueString())
 
 	// Remove resource from state if not found
-	if code == 404 {
+	if client.IsNotFound(err) {
 		// Drop resource from Terraform state
 		resp.State.RemoveResource(ctx)
 		return
@@ -991,8 +992,8 @@ func (d *WorkloadDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	// Build new state from API response
 	newState := WorkloadDataSourceModel{
-		WorkloadResourceModel: operator.MapResponseToState(apiResp, true),
-		Health:                flattenWorkloadHealth(ctx, &resp.Diagnostics, apiResp.Health),
+		WorkloadModel: operator.MapResponseToState(apiResp, true).WorkloadModel,
+		Health:        flattenWorkloadHealth(ctx, &resp.Diagnostics, apiResp.Health),
 	}
 
 	// Abort if diagnostics errors occurred
@@ -1000,6 +1001,9 @@ func (d *WorkloadDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	// Expose the org the workload was read from
+	newState.Org = types.StringValue(d.Operations.OrgOf(WorkloadResourceModel{WorkloadModel: config.WorkloadModel}))
+
 	// Persist updated state into Terraform
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
@@ -0,0 +1,212 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure ephemeral resource implements required interfaces.
+var (
+	_ ephemeral.EphemeralResource                     = &Mk8sKubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &Mk8sKubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &Mk8sKubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose            = &Mk8sKubeconfigEphemeralResource{}
+)
+
+/*** Ephemeral Resource Model ***/
+
+// Mk8sKubeconfigEphemeralResourceModel holds the Terraform result for the ephemeral resource.
+type Mk8sKubeconfigEphemeralResourceModel struct {
+	Org            types.String `tfsdk:"org"`
+	Name           types.String `tfsdk:"name"`
+	Profile        types.String `tfsdk:"profile"`
+	ServiceAccount types.String `tfsdk:"service_account"`
+	Kubeconfig     types.String `tfsdk:"kubeconfig"`
+}
+
+/*** Ephemeral Resource Configuration ***/
+
+// Mk8sKubeconfigEphemeralResource is the ephemeral resource implementation.
+type Mk8sKubeconfigEphemeralResource struct {
+	EntityBase
+}
+
+// NewMk8sKubeconfigEphemeralResource returns a new instance of the ephemeral resource implementation.
+func NewMk8sKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &Mk8sKubeconfigEphemeralResource{}
+}
+
+// Configure configures the ephemeral resource before use.
+func (mke *Mk8sKubeconfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	mke.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
+}
+
+// Metadata provides the ephemeral resource type name.
+func (mke *Mk8sKubeconfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "cpln_mk8s_kubeconfig"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (mke *Mk8sKubeconfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Builds the Kubeconfig of an MK8s cluster for the duration of a Terraform run without persisting it to the state or plan.",
+		Attributes: map[string]schema.Attribute{
+			"org": schema.StringAttribute{
+				Description: "The org the MK8s belongs to. Defaults to the org configured in the provider.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the MK8s to create the Kubeconfig for.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NameValidator{},
+				},
+			},
+			"profile": schema.StringAttribute{
+				Description: "Profile name to extract the token from.",
+				Optional:    true,
+			},
+			"service_account": schema.StringAttribute{
+				Description: "A service account to mint a temporary key for. The key is revoked once Terraform no longer needs the Kubeconfig.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NameValidator{},
+				},
+			},
+			"kubeconfig": schema.StringAttribute{
+				Description: "The Kubeconfig of your MK8s cluster in YAML format.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// ConfigValidators enforces mutual exclusivity between attributes.
+func (mke *Mk8sKubeconfigEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(path.MatchRoot("profile"), path.MatchRoot("service_account")),
+	}
+}
+
+// Open builds the Kubeconfig, minting a temporary service account key when a service account is specified.
+func (mke *Mk8sKubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config Mk8sKubeconfigEphemeralResourceModel
+
+	// Retrieve the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	// Abort on errors to avoid an inconsistent result
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Extract the request values from the configuration
+	mk8sName := config.Name.ValueString()
+	profileName := config.Profile.ValueStringPointer()
+	serviceAccountName := config.ServiceAccount.ValueStringPointer()
+
+	// Target the configured org, falling back to the provider org
+	orgClient := mke.client.WithOrg(config.Org.ValueString())
+
+	// Create a new MK8s Kubeconfig using the API client
+	kubeconfig, key, err := orgClient.CreateMk8sKubeconfig(ctx, mk8sName, profileName, serviceAccountName)
+
+	// Handle the key minted for the service account, if any
+	if key != nil {
+		minted := serviceAccountKeyPrivateState{
+			Org:                orgClient.Org,
+			ServiceAccountName: *serviceAccountName,
+			Name:               key.Name,
+		}
+
+		// Terraform never closes an ephemeral resource that failed to open, so revoke the key right away on failure
+		defer func() {
+			if resp.Diagnostics.HasError() {
+				mke.revokeKey(ctx, &resp.Diagnostics, minted)
+			}
+		}()
+
+		// Remember the minted key so it is revoked once Terraform closes the ephemeral resource
+		mke.setPrivateState(ctx, resp, minted)
+	}
+
+	// Handle any other errors that occurred during the API request
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Error creating mk8s kubeconfig: %s", err))
+		return
+	}
+
+	// Map the Kubeconfig to the result
+	config.Org = types.StringValue(orgClient.Org)
+	config.Kubeconfig = types.StringValue(*kubeconfig)
+
+	// Set the result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// Close revokes the temporary service account key minted on open.
+func (mke *Mk8sKubeconfigEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	// Read the identity of the key minted on open
+	privateState, diags := req.Private.GetKey(ctx, serviceAccountKeyPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+
+	// Nothing to revoke when the Kubeconfig was built from a profile
+	if resp.Diagnostics.HasError() || len(privateState) == 0 {
+		return
+	}
+
+	// Decode the private state
+	var key serviceAccountKeyPrivateState
+	if err := json.Unmarshal(privateState, &key); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Error decoding the private state of the service account key: %s", err))
+		return
+	}
+
+	// Revoke the key
+	mke.revokeKey(ctx, &resp.Diagnostics, key)
+}
+
+/*** Helpers ***/
+
+// setPrivateState stores the identity of the minted service account key for the close operation.
+func (mke *Mk8sKubeconfigEphemeralResource) setPrivateState(ctx context.Context, resp *ephemeral.OpenResponse, key serviceAccountKeyPrivateState) {
+	// Serialize the key identity
+	privateState, err := json.Marshal(key)
+
+	// Handle private state serialization errors
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Error encoding the private state of service account key '%s': %s", key.Name, err))
+		return
+	}
+
+	// Store the private state
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, serviceAccountKeyPrivateStateKey, privateState)...)
+}
+
+// revokeKey removes the minted service account key from its service account.
+func (mke *Mk8sKubeconfigEphemeralResource) revokeKey(ctx context.Context, diags *diag.Diagnostics, key serviceAccountKeyPrivateState) {
+	// Send a request to the API to revoke the key
+	err := mke.client.WithOrg(key.Org).RemoveServiceAccountKey(ctx, key.ServiceAccountName, key.Name)
+
+	// Handle errors from the API request, a key that no longer exists has nothing left to revoke
+	if err != nil && !client.IsNotFound(err) {
+		diags.AddError("API Error", fmt.Sprintf("Error revoking service account key '%s': %s", key.Name, err))
+	}
+}
@@ -0,0 +1,169 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+/*** Unit Tests ***/
+
+// TestMk8sKubeconfigEphemeralResourceSchema verifies the schema is valid and never exposes the Kubeconfig.
+func TestMk8sKubeconfigEphemeralResourceSchema(t *testing.T) {
+	ctx := context.Background()
+
+	// Retrieve the schema
+	resp := ephemeral.SchemaResponse{}
+	NewMk8sKubeconfigEphemeralResource().Schema(ctx, ephemeral.SchemaRequest{}, &resp)
+
+	// Verify the schema is valid
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Verify the Kubeconfig is computed and sensitive
+	kubeconfig := resp.Schema.Attributes["kubeconfig"]
+	if !kubeconfig.IsComputed() || !kubeconfig.IsSensitive() {
+		t.Fatalf("expected the kubeconfig to be computed and sensitive, got computed=%t sensitive=%t", kubeconfig.IsComputed(), kubeconfig.IsSensitive())
+	}
+
+	// Verify the credentials can be configured but are not required
+	for _, name := range []string{"org", "profile", "service_account"} {
+		if attribute := resp.Schema.Attributes[name]; !attribute.IsOptional() || attribute.IsRequired() {
+			t.Fatalf("attribute %s: expected optional, got required=%t optional=%t", name, attribute.IsRequired(), attribute.IsOptional())
+		}
+	}
+}
+
+// TestMk8sKubeconfigEphemeralResourceKeyLifecycle verifies the minted key is recorded in the private state and revoked on
+// close, or right away when the open fails after minting it.
+func TestMk8sKubeconfigEphemeralResourceKeyLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	// Define the table of cases
+	cases := []struct {
+		name          string
+		invalidResult bool
+	}{
+		{name: "open and close"},
+		{name: "failed open", invalidResult: true},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Serve the cluster, mint a key and record the revocations
+			var mu sync.Mutex
+			var revocations []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/org/my-org/mk8s/my-cluster":
+					io.WriteString(w, `{"name":"my-cluster","alias":"abc123","status":{"serverUrl":"https://my-cluster.example.com"}}`)
+				case r.Method == http.MethodGet && r.URL.Path == "/org/my-org/mk8s/my-cluster/-cacerts":
+					io.WriteString(w, `{"cacerts":"certificate"}`)
+				case r.Method == http.MethodPost && r.URL.Path == "/org/my-org/serviceaccount/my-sa/-addKey":
+					io.WriteString(w, `{"key":"my-key.secret"}`)
+				case r.Method == http.MethodPatch && r.URL.Path == "/org/my-org/serviceaccount/my-sa":
+					body, _ := io.ReadAll(r.Body)
+					mu.Lock()
+					revocations = append(revocations, string(body))
+					mu.Unlock()
+					io.WriteString(w, `{}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			// Point the ephemeral resource at the test server
+			mke := &Mk8sKubeconfigEphemeralResource{}
+			mke.client = &client.Client{HostURL: server.URL, Org: "my-org", HTTPClient: server.Client(), Token: "token"}
+
+			// Build the configuration
+			schemaResp := ephemeral.SchemaResponse{}
+			mke.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"org":             tftypes.NewValue(tftypes.String, nil),
+				"name":            tftypes.NewValue(tftypes.String, "my-cluster"),
+				"profile":         tftypes.NewValue(tftypes.String, nil),
+				"service_account": tftypes.NewValue(tftypes.String, "my-sa"),
+				"kubeconfig":      tftypes.NewValue(tftypes.String, nil),
+			})}
+
+			// A result without the attributes of the model cannot be set, which fails the open after the key was minted
+			resultSchema := schemaResp.Schema
+			if tc.invalidResult {
+				resultSchema = schema.Schema{Attributes: map[string]schema.Attribute{}}
+			}
+
+			// Open the ephemeral resource
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{Schema: resultSchema, Raw: tftypes.NewValue(resultSchema.Type().TerraformType(ctx), nil)},
+			}
+			resp.Private = newEphemeralPrivateState(resp.Private)
+			mke.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+
+			// A failed open must not leave the key behind, as Terraform never closes it
+			if tc.invalidResult {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected the open to fail")
+				}
+				if len(revocations) != 1 || !strings.Contains(revocations[0], `"my-key"`) {
+					t.Fatalf("expected the key to be revoked on open, got %v", revocations)
+				}
+				return
+			}
+
+			// Fail on diagnostics errors
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			// Verify the minted key is recorded in the private state and still valid
+			privateState, _ := resp.Private.GetKey(ctx, serviceAccountKeyPrivateStateKey)
+			var key serviceAccountKeyPrivateState
+			if err := json.Unmarshal(privateState, &key); err != nil {
+				t.Fatalf("failed to decode the private state %q: %s", privateState, err)
+			}
+			if want := (serviceAccountKeyPrivateState{Org: "my-org", ServiceAccountName: "my-sa", Name: "my-key"}); key != want {
+				t.Fatalf("private state = %+v, want %+v", key, want)
+			}
+			if len(revocations) != 0 {
+				t.Fatalf("expected the key to stay valid until close, got %v", revocations)
+			}
+
+			// Close the ephemeral resource
+			closeResp := &ephemeral.CloseResponse{}
+			mke.Close(ctx, ephemeral.CloseRequest{Private: resp.Private}, closeResp)
+
+			// Verify the key is revoked
+			if closeResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", closeResp.Diagnostics)
+			}
+			if len(revocations) != 1 || !strings.Contains(revocations[0], `"$drop/keys":["my-key"]`) {
+				t.Fatalf("expected the key to be revoked on close, got %v", revocations)
+			}
+		})
+	}
+}
+
+/*** Helpers ***/
+
+// newEphemeralPrivateState allocates the private state of an ephemeral resource, whose type is internal to the framework
+// and can therefore only be inferred from the field it is assigned to.
+func newEphemeralPrivateState[T any](*T) *T {
+	return new(T)
+}
@@ -0,0 +1,325 @@
+package cpln
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure ephemeral resource implements required interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &SecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &SecretEphemeralResource{}
+)
+
+/*** Ephemeral Resource Configuration ***/
+
+// SecretEphemeralResource is the ephemeral resource implementation.
+type SecretEphemeralResource struct {
+	EntityBase
+	Operations EntityOperations[SecretResourceModel, client.Secret]
+}
+
+// NewSecretEphemeralResource returns a new instance of the ephemeral resource implementation.
+func NewSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &SecretEphemeralResource{}
+}
+
+// Configure configures the ephemeral resource before use.
+func (se *SecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	se.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
+	se.Operations = NewEntityOperations(se.client, &SecretResourceOperator{})
+}
+
+// Metadata provides the ephemeral resource type name.
+func (se *SecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "cpln_secret"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (se *SecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reveals the data of an existing secret for use in provider configurations and other ephemeral contexts. The revealed values are never persisted to the state or plan.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for this secret.",
+				Computed:    true,
+			},
+			"cpln_id": schema.StringAttribute{
+				Description: "The ID, in GUID format, of the secret.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of this secret.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of this secret.",
+				Computed:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Key-value map of resource tags.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"self_link": schema.StringAttribute{
+				Description: "Full link to this resource. Can be referenced by other resources.",
+				Computed:    true,
+			},
+			"org": schema.StringAttribute{
+				Description: "The org the secret belongs to. Defaults to the org configured in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"gcp": schema.StringAttribute{
+				MarkdownDescription: "JSON string containing the GCP secret. [Reference Page](https://docs.controlplane.com/reference/secret#gcp)",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"docker": schema.StringAttribute{
+				MarkdownDescription: "JSON string containing the Docker secret. [Reference Page](https://docs.controlplane.com/reference/secret#docker).",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"dictionary": schema.MapAttribute{
+				MarkdownDescription: "List of unique key-value pairs. [Reference Page](https://docs.controlplane.com/reference/secret#dictionary).",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"dictionary_as_envs": schema.MapAttribute{
+				MarkdownDescription: "If a dictionary secret is defined, this output will be a key-value map in the following format: `key = cpln://secret/SECRET_NAME.key`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"azure_sdk": schema.StringAttribute{
+				MarkdownDescription: "JSON string containing the Docker secret. [Reference Page](https://docs.controlplane.com/reference/secret#azure).",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"secret_link": schema.StringAttribute{
+				Description: "Output used when linking a secret to an environment variable or volume.",
+				Computed:    true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"opaque": schema.ListNestedBlock{
+				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#opaque).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"payload": schema.StringAttribute{
+							Description: "Plain text or base64 encoded string. Use `encoding` attribute to specify encoding.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"encoding": schema.StringAttribute{
+							Description: "Available encodings: `plain`, `base64`. Default: `plain`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"tls": schema.ListNestedBlock{
+				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#tls).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "Private Certificate.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"cert": schema.StringAttribute{
+							Description: "Public Certificate.",
+							Computed:    true,
+						},
+						"chain": schema.StringAttribute{
+							Description: "Chain Certificate.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"aws": schema.ListNestedBlock{
+				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#aws).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"access_key": schema.StringAttribute{
+							Description: "Access Key provided by AWS.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"secret_key": schema.StringAttribute{
+							Description: "Secret Key provided by AWS.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"role_arn": schema.StringAttribute{
+							Description: "Role ARN provided by AWS.",
+							Computed:    true,
+						},
+						"external_id": schema.StringAttribute{
+							Description: "AWS IAM Role External ID.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ecr": schema.ListNestedBlock{
+				MarkdownDescription: "",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"access_key": schema.StringAttribute{
+							Description: "Access Key provided by AWS.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"secret_key": schema.StringAttribute{
+							Description: "Secret Key provided by AWS.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"role_arn": schema.StringAttribute{
+							Description: "Role ARN provided by AWS.",
+							Computed:    true,
+						},
+						"external_id": schema.StringAttribute{
+							Description: "AWS IAM Role External ID. Used when setting up cross-account access to your ECR repositories.",
+							Computed:    true,
+						},
+						"repos": schema.SetAttribute{
+							Description: "List of ECR repositories.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"userpass": schema.ListNestedBlock{
+				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#username).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Description: "Username.",
+							Computed:    true,
+						},
+						"password": schema.StringAttribute{
+							Description: "Password.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"encoding": schema.StringAttribute{
+							Description: "Available encodings: `plain`, `base64`. Default: `plain`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"keypair": schema.ListNestedBlock{
+				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#keypair).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"secret_key": schema.StringAttribute{
+							Description: "Secret/Private Key.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"public_key": schema.StringAttribute{
+							Description: "Public Key.",
+							Computed:    true,
+						},
+						"passphrase": schema.StringAttribute{
+							Description: "Passphrase for private key.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"azure_connector": schema.ListNestedBlock{
+				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#azure-connector).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description: "Deployment URL.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"code": schema.StringAttribute{
+							Description: "Code/Key to authenticate to deployment URL.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"nats_account": schema.ListNestedBlock{
+				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#nats-account).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Description: "Account ID.",
+							Computed:    true,
+						},
+						"private_key": schema.StringAttribute{
+							Description: "Private Key.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Open reveals the secret.
+func (se *SecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config SecretDataModel
+
+	// Retrieve the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	// Abort on errors to avoid an inconsistent result
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create a new operator instance
+	operator := se.Operations.NewOperator(ctx, &resp.Diagnostics, SecretResourceModel{EntityBaseModel: config.EntityBaseModel})
+
+	// Invoke API to reveal the secret
+	apiResp, _, err := operator.InvokeRead(config.Name.ValueString())
+
+	// Report a missing secret explicitly since an ephemeral resource has no state to remove it from
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Secret not found", fmt.Sprintf("Secret '%s' does not exist.", config.Name.ValueString()))
+		return
+	}
+
+	// Handle API invocation errors
+	if err != nil {
+		AddAPIError(&resp.Diagnostics, "API error", err)
+		return
+	}
+
+	// Build the revealed secret from the API response
+	secret := operator.MapResponseToState(apiResp, true)
+
+	// Abort if diagnostics errors occurred
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map the revealed secret to the result
+	result := NewSecretDataModel(secret)
+
+	// Expose the org the secret was revealed from
+	result.Org = types.StringValue(EntityOrg(config, se.Operations.Org))
+
+	// Set the result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+}
@@ -0,0 +1,133 @@
+package cpln
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneEphemeralSecret_basic performs an acceptance test for the ephemeral resource.
+func TestAccControlPlaneEphemeralSecret_basic(t *testing.T) {
+	// Initialize the test
+	ephemeralResourceTest := NewSecretEphemeralResourceTest()
+
+	// Run the acceptance test case for the ephemeral resource, covering the open functionality
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t, "EPHEMERAL_SECRET") },
+		ProtoV6ProviderFactories: GetEphemeralProviderServer(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources are only supported since Terraform 1.10
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: ephemeralResourceTest.Steps,
+	})
+}
+
+/*** Ephemeral Resource Test ***/
+
+// SecretEphemeralResourceTest defines the necessary functionality to test the ephemeral resource.
+type SecretEphemeralResourceTest struct {
+	Steps []resource.TestStep
+}
+
+// NewSecretEphemeralResourceTest creates a SecretEphemeralResourceTest with initialized test cases.
+func NewSecretEphemeralResourceTest() SecretEphemeralResourceTest {
+	// Create an ephemeral resource test instance
+	ephemeralResourceTest := SecretEphemeralResourceTest{}
+
+	// Initialize the test steps slice
+	steps := []resource.TestStep{}
+
+	// Fill the steps slice
+	steps = append(steps, ephemeralResourceTest.NewOpaqueScenario()...)
+
+	// Set the cases for the ephemeral resource test
+	ephemeralResourceTest.Steps = steps
+
+	// Return the ephemeral resource test
+	return ephemeralResourceTest
+}
+
+// Test Scenarios //
+
+// NewOpaqueScenario creates a test case that reveals an opaque secret.
+func (sert *SecretEphemeralResourceTest) NewOpaqueScenario() []resource.TestStep {
+	// Generate a unique name for the resources
+	random := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("ephemeral-opaque-%s", random)
+	ephemeralResourceName := "new"
+
+	// Build test steps
+	_, initialStep := sert.BuildOpaqueTestStep(ephemeralResourceName, name)
+
+	// Return the complete test steps
+	return []resource.TestStep{
+		// Open
+		initialStep,
+	}
+}
+
+// Test Cases //
+
+// BuildOpaqueTestStep returns an opaque test step and its associated test case for the ephemeral resource.
+func (sert *SecretEphemeralResourceTest) BuildOpaqueTestStep(ephemeralResourceName string, name string) (SecretEphemeralResourceTestCase, resource.TestStep) {
+	// Create the test case with metadata and descriptions
+	c := SecretEphemeralResourceTestCase{
+		Payload: "ephemeral-payload",
+		ProviderTestCase: ProviderTestCase{
+			Kind:            "secret",
+			ResourceName:    ephemeralResourceName,
+			Name:            name,
+			ResourceAddress: fmt.Sprintf("ephemeral.cpln_secret.%s", ephemeralResourceName),
+		},
+	}
+
+	// Initialize and return the inital test step
+	return c, resource.TestStep{
+		Config: sert.OpaqueHcl(c),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("echo.test", "data.name", c.Name),
+			resource.TestCheckResourceAttr("echo.test", "data.secret_link", fmt.Sprintf("cpln://secret/%s", c.Name)),
+			resource.TestCheckResourceAttr("echo.test", "data.opaque.0.payload", c.Payload),
+			resource.TestCheckResourceAttr("echo.test", "data.opaque.0.encoding", "plain"),
+		),
+	}
+}
+
+// Configs //
+
+// OpaqueHcl returns an ephemeral resource HCL revealing an opaque secret through the echo provider.
+func (sert *SecretEphemeralResourceTest) OpaqueHcl(c SecretEphemeralResourceTestCase) string {
+	return fmt.Sprintf(`
+resource "cpln_secret" "opaque" {
+  name = "%s"
+
+  opaque {
+    payload = "%s"
+  }
+}
+
+ephemeral "cpln_secret" "%s" {
+  name = cpln_secret.opaque.name
+}
+
+provider "echo" {
+  data = ephemeral.cpln_secret.%s
+}
+
+resource "echo" "test" {}
+`, c.Name, c.Payload, c.ResourceName, c.ResourceName)
+}
+
+/*** Ephemeral Resource Test Case ***/
+
+// SecretEphemeralResourceTestCase defines a specific ephemeral resource test case.
+type SecretEphemeralResourceTestCase struct {
+	ProviderTestCase
+	Payload string
+}
@@ -0,0 +1,210 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure ephemeral resource implements required interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &ServiceAccountKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ServiceAccountKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &ServiceAccountKeyEphemeralResource{}
+)
+
+// serviceAccountKeyPrivateStateKey is the private state key holding the identity of the key to revoke on close.
+const serviceAccountKeyPrivateStateKey = "service_account_key"
+
+/*** Ephemeral Resource Model ***/
+
+// ServiceAccountKeyEphemeralResourceModel holds the Terraform result for the ephemeral resource.
+type ServiceAccountKeyEphemeralResourceModel struct {
+	Org                types.String `tfsdk:"org"`
+	ServiceAccountName types.String `tfsdk:"service_account_name"`
+	Description        types.String `tfsdk:"description"`
+	Name               types.String `tfsdk:"name"`
+	Created            types.String `tfsdk:"created"`
+	Key                types.String `tfsdk:"key"`
+}
+
+// serviceAccountKeyPrivateState identifies the key minted on open so it can be revoked on close.
+type serviceAccountKeyPrivateState struct {
+	Org                string `json:"org,omitempty"`
+	ServiceAccountName string `json:"service_account_name"`
+	Name               string `json:"name"`
+}
+
+/*** Ephemeral Resource Configuration ***/
+
+// ServiceAccountKeyEphemeralResource is the ephemeral resource implementation.
+type ServiceAccountKeyEphemeralResource struct {
+	EntityBase
+}
+
+// NewServiceAccountKeyEphemeralResource returns a new instance of the ephemeral resource implementation.
+func NewServiceAccountKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ServiceAccountKeyEphemeralResource{}
+}
+
+// Configure configures the ephemeral resource before use.
+func (sake *ServiceAccountKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	sake.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
+}
+
+// Metadata provides the ephemeral resource type name.
+func (sake *ServiceAccountKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "cpln_service_account_key"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (sake *ServiceAccountKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Mints a service account key for the duration of a Terraform run and revokes it once the run no longer needs it. The key is never persisted to the state or plan.",
+		Attributes: map[string]schema.Attribute{
+			"org": schema.StringAttribute{
+				Description: "The org the Service Account belongs to. Defaults to the org configured in the provider.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"service_account_name": schema.StringAttribute{
+				Description: "The name of an existing Service Account the key will belong to.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NameValidator{},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Service Account Key. Max: 250.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The generated name of the key.",
+				Computed:    true,
+			},
+			"created": schema.StringAttribute{
+				Description: "The timestamp, in UTC, when the key was created.",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "The generated key.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open mints a new service account key.
+func (sake *ServiceAccountKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config ServiceAccountKeyEphemeralResourceModel
+
+	// Retrieve the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	// Abort on errors to avoid an inconsistent result
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Extract the request values from the configuration
+	serviceAccountName := config.ServiceAccountName.ValueString()
+	description := config.Description.ValueString()
+
+	// Target the configured org, falling back to the provider org
+	orgClient := sake.client.WithOrg(config.Org.ValueString())
+
+	// Send the create request to the API client
+	responsePayload, err := orgClient.AddServiceAccountKey(ctx, serviceAccountName, description)
+
+	// Handle any errors that occurred during the API request
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Error creating service account key: %s", err))
+		return
+	}
+
+	// Identify the minted key
+	minted := serviceAccountKeyPrivateState{
+		Org:                orgClient.Org,
+		ServiceAccountName: serviceAccountName,
+		Name:               responsePayload.Name,
+	}
+
+	// Terraform never closes an ephemeral resource that failed to open, so revoke the key right away on failure
+	defer func() {
+		if resp.Diagnostics.HasError() {
+			sake.revokeKey(ctx, &resp.Diagnostics, minted)
+		}
+	}()
+
+	// Remember which key to revoke once Terraform closes the ephemeral resource
+	privateState, err := json.Marshal(minted)
+
+	// Handle private state serialization errors
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Error encoding the private state of service account key '%s': %s", responsePayload.Name, err))
+		return
+	}
+
+	// Store the private state for the close operation
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, serviceAccountKeyPrivateStateKey, privateState)...)
+
+	// Map the API response to the result
+	config.Org = types.StringValue(orgClient.Org)
+	config.Name = types.StringValue(responsePayload.Name)
+	config.Created = types.StringPointerValue(responsePayload.Created)
+	config.Key = types.StringValue(responsePayload.Key)
+
+	// Set the result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// Close revokes the service account key minted on open.
+func (sake *ServiceAccountKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	// Read the identity of the key minted on open
+	privateState, diags := req.Private.GetKey(ctx, serviceAccountKeyPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+
+	// Nothing to revoke if the key was never minted
+	if resp.Diagnostics.HasError() || len(privateState) == 0 {
+		return
+	}
+
+	// Decode the private state
+	var key serviceAccountKeyPrivateState
+	if err := json.Unmarshal(privateState, &key); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Error decoding the private state of the service account key: %s", err))
+		return
+	}
+
+	// Revoke the key
+	sake.revokeKey(ctx, &resp.Diagnostics, key)
+}
+
+/*** Helpers ***/
+
+// revokeKey removes the minted service account key from its service account.
+func (sake *ServiceAccountKeyEphemeralResource) revokeKey(ctx context.Context, diags *diag.Diagnostics, key serviceAccountKeyPrivateState) {
+	// Send a request to the API to revoke the key
+	err := sake.client.WithOrg(key.Org).RemoveServiceAccountKey(ctx, key.ServiceAccountName, key.Name)
+
+	// Handle errors from the API request, a key that no longer exists has nothing left to revoke
+	if err != nil && !client.IsNotFound(err) {
+		diags.AddError("API Error", fmt.Sprintf("Error revoking service account key '%s': %s", key.Name, err))
+	}
+}
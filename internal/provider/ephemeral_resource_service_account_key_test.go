@@ -0,0 +1,257 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneEphemeralServiceAccountKey_basic performs an acceptance test for the ephemeral resource.
+func TestAccControlPlaneEphemeralServiceAccountKey_basic(t *testing.T) {
+	// Initialize the test
+	ephemeralResourceTest := NewServiceAccountKeyEphemeralResourceTest()
+
+	// Run the acceptance test case for the ephemeral resource, covering open and close functionalities
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t, "EPHEMERAL_SERVICE_ACCOUNT_KEY") },
+		ProtoV6ProviderFactories: GetEphemeralProviderServer(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources are only supported since Terraform 1.10
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: ephemeralResourceTest.Steps,
+	})
+}
+
+/*** Unit Tests ***/
+
+// TestServiceAccountKeyEphemeralResourceKeyLifecycle verifies the minted key is recorded in the private state and revoked
+// on close, or right away when the open fails after minting it.
+func TestServiceAccountKeyEphemeralResourceKeyLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	// Define the table of cases
+	cases := []struct {
+		name          string
+		invalidResult bool
+	}{
+		{name: "open and close"},
+		{name: "failed open", invalidResult: true},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Mint a key and record the revocations
+			var mu sync.Mutex
+			var revocations []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/org/my-org/serviceaccount/my-sa/-addKey":
+					io.WriteString(w, `{"name":"my-key","created":"2026-01-01T00:00:00Z","key":"my-key.secret"}`)
+				case r.Method == http.MethodPatch && r.URL.Path == "/org/my-org/serviceaccount/my-sa":
+					body, _ := io.ReadAll(r.Body)
+					mu.Lock()
+					revocations = append(revocations, string(body))
+					mu.Unlock()
+					io.WriteString(w, `{}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			// Point the ephemeral resource at the test server
+			sake := &ServiceAccountKeyEphemeralResource{}
+			sake.client = &client.Client{HostURL: server.URL, Org: "my-org", HTTPClient: server.Client(), Token: "token"}
+
+			// Build the configuration
+			schemaResp := ephemeral.SchemaResponse{}
+			sake.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"org":                  tftypes.NewValue(tftypes.String, nil),
+				"service_account_name": tftypes.NewValue(tftypes.String, "my-sa"),
+				"description":          tftypes.NewValue(tftypes.String, "ephemeral key"),
+				"name":                 tftypes.NewValue(tftypes.String, nil),
+				"created":              tftypes.NewValue(tftypes.String, nil),
+				"key":                  tftypes.NewValue(tftypes.String, nil),
+			})}
+
+			// A result without the attributes of the model cannot be set, which fails the open after the key was minted
+			resultSchema := schemaResp.Schema
+			if tc.invalidResult {
+				resultSchema = schema.Schema{Attributes: map[string]schema.Attribute{}}
+			}
+
+			// Open the ephemeral resource
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{Schema: resultSchema, Raw: tftypes.NewValue(resultSchema.Type().TerraformType(ctx), nil)},
+			}
+			resp.Private = newEphemeralPrivateState(resp.Private)
+			sake.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+
+			// A failed open must not leave the key behind, as Terraform never closes it
+			if tc.invalidResult {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected the open to fail")
+				}
+				if len(revocations) != 1 || !strings.Contains(revocations[0], `"$drop/keys":["my-key"]`) {
+					t.Fatalf("expected the key to be revoked on open, got %v", revocations)
+				}
+				return
+			}
+
+			// Fail on diagnostics errors
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			// Verify the minted key is recorded in the private state and still valid
+			privateState, _ := resp.Private.GetKey(ctx, serviceAccountKeyPrivateStateKey)
+			var key serviceAccountKeyPrivateState
+			if err := json.Unmarshal(privateState, &key); err != nil {
+				t.Fatalf("failed to decode the private state %q: %s", privateState, err)
+			}
+			if want := (serviceAccountKeyPrivateState{Org: "my-org", ServiceAccountName: "my-sa", Name: "my-key"}); key != want {
+				t.Fatalf("private state = %+v, want %+v", key, want)
+			}
+			if len(revocations) != 0 {
+				t.Fatalf("expected the key to stay valid until close, got %v", revocations)
+			}
+
+			// Close the ephemeral resource
+			closeResp := &ephemeral.CloseResponse{}
+			sake.Close(ctx, ephemeral.CloseRequest{Private: resp.Private}, closeResp)
+
+			// Verify the key is revoked
+			if closeResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", closeResp.Diagnostics)
+			}
+			if len(revocations) != 1 || !strings.Contains(revocations[0], `"$drop/keys":["my-key"]`) {
+				t.Fatalf("expected the key to be revoked on close, got %v", revocations)
+			}
+		})
+	}
+}
+
+/*** Ephemeral Resource Test ***/
+
+// ServiceAccountKeyEphemeralResourceTest defines the necessary functionality to test the ephemeral resource.
+type ServiceAccountKeyEphemeralResourceTest struct {
+	Steps []resource.TestStep
+}
+
+// NewServiceAccountKeyEphemeralResourceTest creates a ServiceAccountKeyEphemeralResourceTest with initialized test cases.
+func NewServiceAccountKeyEphemeralResourceTest() ServiceAccountKeyEphemeralResourceTest {
+	// Create an ephemeral resource test instance
+	ephemeralResourceTest := ServiceAccountKeyEphemeralResourceTest{}
+
+	// Initialize the test steps slice
+	steps := []resource.TestStep{}
+
+	// Fill the steps slice
+	steps = append(steps, ephemeralResourceTest.NewDefaultScenario()...)
+
+	// Set the cases for the ephemeral resource test
+	ephemeralResourceTest.Steps = steps
+
+	// Return the ephemeral resource test
+	return ephemeralResourceTest
+}
+
+// Test Scenarios //
+
+// NewDefaultScenario creates a test case that mints a key for an existing service account.
+func (sakert *ServiceAccountKeyEphemeralResourceTest) NewDefaultScenario() []resource.TestStep {
+	// Generate a unique name for the resources
+	random := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceAccountName := fmt.Sprintf("sa-ephemeral-key-%s", random)
+	ephemeralResourceName := "new"
+
+	// Build test steps
+	_, initialStep := sakert.BuildDefaultTestStep(ephemeralResourceName, serviceAccountName)
+
+	// Return the complete test steps
+	return []resource.TestStep{
+		// Open & Close
+		initialStep,
+	}
+}
+
+// Test Cases //
+
+// BuildDefaultTestStep returns a default initial test step and its associated test case for the ephemeral resource.
+func (sakert *ServiceAccountKeyEphemeralResourceTest) BuildDefaultTestStep(ephemeralResourceName string, serviceAccountName string) (ServiceAccountKeyEphemeralResourceTestCase, resource.TestStep) {
+	// Create the test case with metadata and descriptions
+	c := ServiceAccountKeyEphemeralResourceTestCase{
+		ServiceAccountName: serviceAccountName,
+		ProviderTestCase: ProviderTestCase{
+			Kind:            "serviceaccountkey",
+			ResourceName:    ephemeralResourceName,
+			Description:     "ephemeral key",
+			ResourceAddress: fmt.Sprintf("ephemeral.cpln_service_account_key.%s", ephemeralResourceName),
+		},
+	}
+
+	// Initialize and return the inital test step
+	return c, resource.TestStep{
+		Config: sakert.DefaultHcl(c),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("echo.test", "data.service_account_name", c.ServiceAccountName),
+			resource.TestCheckResourceAttr("echo.test", "data.description", c.Description),
+			resource.TestCheckResourceAttrSet("echo.test", "data.name"),
+			resource.TestCheckResourceAttrSet("echo.test", "data.created"),
+			resource.TestCheckResourceAttrSet("echo.test", "data.key"),
+		),
+	}
+}
+
+// Configs //
+
+// DefaultHcl returns an ephemeral resource HCL whose result is surfaced through the echo provider.
+func (sakert *ServiceAccountKeyEphemeralResourceTest) DefaultHcl(c ServiceAccountKeyEphemeralResourceTestCase) string {
+	return fmt.Sprintf(`
+resource "cpln_service_account" "new" {
+  name        = "%s"
+  description = "service account for ephemeral keys"
+}
+
+ephemeral "cpln_service_account_key" "%s" {
+  service_account_name = cpln_service_account.new.name
+  description          = "%s"
+}
+
+provider "echo" {
+  data = ephemeral.cpln_service_account_key.%s
+}
+
+resource "echo" "test" {}
+`, c.ServiceAccountName, c.ResourceName, c.Description, c.ResourceName)
+}
+
+/*** Ephemeral Resource Test Case ***/
+
+// ServiceAccountKeyEphemeralResourceTestCase defines a specific ephemeral resource test case.
+type ServiceAccountKeyEphemeralResourceTestCase struct {
+	ProviderTestCase
+	ServiceAccountName string
+}
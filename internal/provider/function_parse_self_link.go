@@ -0,0 +1,100 @@
+package cpln
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure function implements required interfaces.
+var _ function.Function = &ParseSelfLinkFunction{}
+
+// parseSelfLinkAttributeTypes describes the object returned by the function.
+var parseSelfLinkAttributeTypes = map[string]attr.Type{
+	"org":  types.StringType,
+	"gvc":  types.StringType,
+	"kind": types.StringType,
+	"name": types.StringType,
+}
+
+/*** Function Configuration ***/
+
+// ParseSelfLinkFunction splits a self link into its components.
+type ParseSelfLinkFunction struct{}
+
+// NewParseSelfLinkFunction returns a new instance of the function implementation.
+func NewParseSelfLinkFunction() function.Function {
+	return &ParseSelfLinkFunction{}
+}
+
+// Metadata provides the function name.
+func (f *ParseSelfLinkFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_self_link"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ParseSelfLinkFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Splits a self link into its org, GVC, kind and name.",
+		Description: "Splits a full (`/org/ORG_NAME/...`) or org-relative (`//...`) self link into an object with the `org`, `gvc`, `kind` and `name` attributes. The `org` attribute is null for org-relative links and the `gvc` attribute is null for resources that do not belong to a GVC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "link",
+				Description: "The self link to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseSelfLinkAttributeTypes,
+		},
+	}
+}
+
+// Run parses the self link.
+func (f *ParseSelfLinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var link string
+
+	// Read the arguments
+	resp.Error = req.Arguments.Get(ctx, &link)
+
+	// Abort if the arguments could not be read
+	if resp.Error != nil {
+		return
+	}
+
+	// Parse the link
+	parts, err := ParseSelfLink(link)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// Build the result, omitted components are null
+	result, diags := types.ObjectValue(parseSelfLinkAttributeTypes, map[string]attr.Value{
+		"org":  stringValueOrNull(parts.Org),
+		"gvc":  stringValueOrNull(parts.Gvc),
+		"kind": types.StringValue(parts.Kind),
+		"name": types.StringValue(parts.Name),
+	})
+
+	// Abort if the result could not be built
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	// Set the result
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+/*** Helpers ***/
+
+// stringValueOrNull returns a null string for an empty value.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,104 @@
+package cpln
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneFunctionParseSelfLink_basic verifies the function is callable from a configuration.
+func TestAccControlPlaneFunctionParseSelfLink_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: GetProviderServer(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  link = provider::cpln::parse_self_link("/org/acme/gvc/production/workload/httpbin")
+}
+
+output "org" {
+  value = local.link.org
+}
+
+output "gvc" {
+  value = local.link.gvc
+}
+
+output "kind" {
+  value = local.link.kind
+}
+
+output "name" {
+  value = local.link.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("org", "acme"),
+					resource.TestCheckOutput("gvc", "production"),
+					resource.TestCheckOutput("kind", "workload"),
+					resource.TestCheckOutput("name", "httpbin"),
+				),
+			},
+		},
+	})
+}
+
+/*** Unit Tests ***/
+
+// TestParseSelfLinkFunction verifies the components parsed from full and org-relative links and the rejected links.
+func TestParseSelfLinkFunction(t *testing.T) {
+	// Define the test cases, where an empty component is expected to be null
+	cases := []struct {
+		link     string
+		expected *SelfLinkParts
+	}{
+		{"/org/acme/gvc/production/workload/httpbin", &SelfLinkParts{Org: "acme", Gvc: "production", Kind: "workload", Name: "httpbin"}},
+		{"/org/acme/secret/database", &SelfLinkParts{Org: "acme", Kind: "secret", Name: "database"}},
+		{"/org/acme/gvc/production", &SelfLinkParts{Org: "acme", Kind: "gvc", Name: "production"}},
+		{"/org/acme", &SelfLinkParts{Org: "acme", Kind: "org", Name: "acme"}},
+		{"//gvc/production/identity/payments", &SelfLinkParts{Gvc: "production", Kind: "identity", Name: "payments"}},
+		{"//ipset/edge", &SelfLinkParts{Kind: "ipset", Name: "edge"}},
+		{"org/acme/secret/database", nil},
+		{"/org/acme/secret/database/", nil},
+		{"/org//secret/database", nil},
+		{"//workload/httpbin", nil},
+		{"//gvc/production/workload", nil},
+		{"//", nil},
+	}
+
+	// Run every test case
+	for _, c := range cases {
+		result, funcErr := RunTestFunction(NewParseSelfLinkFunction(), types.StringValue(c.link))
+
+		// Verify the rejected link
+		if c.expected == nil {
+			if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+				t.Errorf("parse_self_link(%q): expected an error on the link argument, got %v", c.link, funcErr)
+			}
+
+			continue
+		}
+
+		// Build the expected object
+		expected := types.ObjectValueMust(parseSelfLinkAttributeTypes, map[string]attr.Value{
+			"org":  stringValueOrNull(c.expected.Org),
+			"gvc":  stringValueOrNull(c.expected.Gvc),
+			"kind": types.StringValue(c.expected.Kind),
+			"name": types.StringValue(c.expected.Name),
+		})
+
+		// Verify the components
+		if funcErr != nil || !result.Equal(expected) {
+			t.Errorf("parse_self_link(%q): expected %s, got %s (%v)", c.link, expected, result, funcErr)
+		}
+	}
+}
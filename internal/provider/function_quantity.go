@@ -0,0 +1,341 @@
+package cpln
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure functions implement required interfaces.
+var (
+	_ function.Function = &QuantityParseFunction{}
+	_ function.Function = &QuantityCompareFunction{}
+	_ function.Function = &QuantityAddFunction{}
+	_ function.Function = &QuantityToMillicoresFunction{}
+	_ function.Function = &QuantityToMebibytesFunction{}
+)
+
+// quantityParseAttributeTypes describes the object returned by the quantity_parse function.
+var quantityParseAttributeTypes = map[string]attr.Type{
+	"value":     types.NumberType,
+	"unit":      types.StringType,
+	"canonical": types.StringType,
+}
+
+// mebibyte is the number of bytes in a mebibyte.
+var mebibyte = big.NewRat(1<<20, 1)
+
+/*** Parse ***/
+
+// QuantityParseFunction validates a quantity and returns its components and canonical form.
+type QuantityParseFunction struct{}
+
+// NewQuantityParseFunction returns a new instance of the function implementation.
+func NewQuantityParseFunction() function.Function {
+	return &QuantityParseFunction{}
+}
+
+// Metadata provides the function name.
+func (f *QuantityParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quantity_parse"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *QuantityParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parses a CPU or memory quantity.",
+		Description: "Parses a CPU or memory quantity, such as `500m` or `1.5Gi`, and returns an object with its numeric `value`, its `unit` and its `canonical` form, which expresses the quantity in the largest unit of the same kind that keeps the number whole.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "quantity",
+				Description: "The quantity to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: quantityParseAttributeTypes,
+		},
+	}
+}
+
+// Run parses the quantity.
+func (f *QuantityParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	// Read the arguments
+	resp.Error = req.Arguments.Get(ctx, &value)
+
+	// Abort if the arguments could not be read
+	if resp.Error != nil {
+		return
+	}
+
+	// Parse the quantity
+	quantity, err := validators.ParseQuantity(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// Build the result
+	result, diags := types.ObjectValue(quantityParseAttributeTypes, map[string]attr.Value{
+		"value":     types.NumberValue(new(big.Float).SetRat(quantity.Value)),
+		"unit":      types.StringValue(quantity.Unit),
+		"canonical": types.StringValue(quantity.Canonical()),
+	})
+
+	// Abort if the result could not be built
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	// Set the result
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+/*** Compare ***/
+
+// QuantityCompareFunction compares two quantities of the same kind.
+type QuantityCompareFunction struct{}
+
+// NewQuantityCompareFunction returns a new instance of the function implementation.
+func NewQuantityCompareFunction() function.Function {
+	return &QuantityCompareFunction{}
+}
+
+// Metadata provides the function name.
+func (f *QuantityCompareFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quantity_compare"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *QuantityCompareFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compares two CPU or memory quantities.",
+		Description: "Compares two quantities of the same kind, regardless of their units, and returns `-1` when the first is smaller, `0` when they are equal and `1` when the first is larger.",
+		Parameters:  quantityPairParameters(),
+		Return:      function.Int64Return{},
+	}
+}
+
+// Run compares the quantities.
+func (f *QuantityCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Parse both quantities
+	a, b, _, funcErr := parseQuantityPair(ctx, req)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	// Set the result
+	resp.Error = resp.Result.Set(ctx, int64(a.Base.Cmp(b.Base)))
+}
+
+/*** Add ***/
+
+// QuantityAddFunction adds two quantities of the same kind.
+type QuantityAddFunction struct{}
+
+// NewQuantityAddFunction returns a new instance of the function implementation.
+func NewQuantityAddFunction() function.Function {
+	return &QuantityAddFunction{}
+}
+
+// Metadata provides the function name.
+func (f *QuantityAddFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quantity_add"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *QuantityAddFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Adds two CPU or memory quantities.",
+		Description: "Adds two quantities of the same kind, regardless of their units, and returns the sum in its canonical form, such as `1536Mi` for `1Gi` and `512Mi`.",
+		Parameters:  quantityPairParameters(),
+		Return:      function.StringReturn{},
+	}
+}
+
+// Run adds the quantities.
+func (f *QuantityAddFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Parse both quantities
+	a, b, family, funcErr := parseQuantityPair(ctx, req)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	// Set the result
+	resp.Error = resp.Result.Set(ctx, validators.FormatQuantity(new(big.Rat).Add(a.Base, b.Base), family))
+}
+
+/*** To Millicores ***/
+
+// QuantityToMillicoresFunction converts a CPU quantity to millicores.
+type QuantityToMillicoresFunction struct{}
+
+// NewQuantityToMillicoresFunction returns a new instance of the function implementation.
+func NewQuantityToMillicoresFunction() function.Function {
+	return &QuantityToMillicoresFunction{}
+}
+
+// Metadata provides the function name.
+func (f *QuantityToMillicoresFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quantity_to_millicores"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *QuantityToMillicoresFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts a CPU quantity to millicores.",
+		Description: "Converts a CPU quantity in cores, such as `1.5`, or millicores, such as `1500m`, to a number of millicores.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "quantity",
+				Description: "The CPU quantity to convert.",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+// Run converts the quantity.
+func (f *QuantityToMillicoresFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	// Read the arguments
+	resp.Error = req.Arguments.Get(ctx, &value)
+
+	// Abort if the arguments could not be read
+	if resp.Error != nil {
+		return
+	}
+
+	// Parse the quantity
+	quantity, err := validators.ParseCpuQuantity(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// Set the result
+	millicores := new(big.Rat).Mul(quantity.Base, big.NewRat(1000, 1))
+	resp.Error = resp.Result.Set(ctx, types.NumberValue(new(big.Float).SetRat(millicores)))
+}
+
+/*** To Mebibytes ***/
+
+// QuantityToMebibytesFunction converts a memory quantity to mebibytes.
+type QuantityToMebibytesFunction struct{}
+
+// NewQuantityToMebibytesFunction returns a new instance of the function implementation.
+func NewQuantityToMebibytesFunction() function.Function {
+	return &QuantityToMebibytesFunction{}
+}
+
+// Metadata provides the function name.
+func (f *QuantityToMebibytesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quantity_to_mebibytes"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *QuantityToMebibytesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts a memory quantity to mebibytes.",
+		Description: "Converts a memory quantity, such as `1Gi` or `512M`, to a number of mebibytes. The result has a fraction when the quantity is not a whole number of mebibytes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "quantity",
+				Description: "The memory quantity to convert.",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+// Run converts the quantity.
+func (f *QuantityToMebibytesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	// Read the arguments
+	resp.Error = req.Arguments.Get(ctx, &value)
+
+	// Abort if the arguments could not be read
+	if resp.Error != nil {
+		return
+	}
+
+	// Parse the quantity
+	quantity, err := validators.ParseMemoryQuantity(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// Set the result
+	mebibytes := new(big.Rat).Quo(quantity.Base, mebibyte)
+	resp.Error = resp.Result.Set(ctx, types.NumberValue(new(big.Float).SetRat(mebibytes)))
+}
+
+/*** Helpers ***/
+
+// quantityPairParameters returns the parameters of the functions operating on two quantities.
+func quantityPairParameters() []function.Parameter {
+	return []function.Parameter{
+		function.StringParameter{
+			Name:        "a",
+			Description: "The first quantity.",
+		},
+		function.StringParameter{
+			Name:        "b",
+			Description: "The second quantity.",
+		},
+	}
+}
+
+// parseQuantityPair parses the two quantity arguments and returns the family their result is expressed in. CPU
+// quantities cannot be mixed with memory quantities, a quantity without a unit matches either kind.
+func parseQuantityPair(ctx context.Context, req function.RunRequest) (*validators.Quantity, *validators.Quantity, validators.QuantityFamily, *function.FuncError) {
+	var valueA, valueB string
+
+	// Read the arguments
+	if funcErr := req.Arguments.Get(ctx, &valueA, &valueB); funcErr != nil {
+		return nil, nil, "", funcErr
+	}
+
+	// Parse the first quantity
+	a, err := validators.ParseQuantity(valueA)
+	if err != nil {
+		return nil, nil, "", function.NewArgumentFuncError(0, err.Error())
+	}
+
+	// Parse the second quantity
+	b, err := validators.ParseQuantity(valueB)
+	if err != nil {
+		return nil, nil, "", function.NewArgumentFuncError(1, err.Error())
+	}
+
+	// Quantities without a unit adopt the family of the other quantity
+	family := a.Family
+	switch {
+	case a.Unit == "":
+		family = b.Family
+	case b.Unit == "":
+		family = a.Family
+	case a.Family == validators.QuantityFamilyCpu && b.Family != validators.QuantityFamilyCpu:
+		return nil, nil, "", function.NewArgumentFuncError(1, fmt.Sprintf("Cannot combine the CPU quantity %q with the memory quantity %q.", valueA, valueB))
+	case a.Family != validators.QuantityFamilyCpu && b.Family == validators.QuantityFamilyCpu:
+		return nil, nil, "", function.NewArgumentFuncError(1, fmt.Sprintf("Cannot combine the memory quantity %q with the CPU quantity %q.", valueA, valueB))
+	case a.Family != b.Family:
+		// Mixing binary and decimal suffixes is expressed in the binary family, falling back to bytes
+		family = validators.QuantityFamilyBinary
+	}
+
+	// Return the parsed quantities
+	return a, b, family, nil
+}
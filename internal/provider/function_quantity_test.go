@@ -0,0 +1,251 @@
+package cpln
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneFunctionQuantity_basic verifies the quantity functions are callable from a configuration.
+func TestAccControlPlaneFunctionQuantity_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: GetProviderServer(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "canonical" {
+  value = provider::cpln::quantity_parse("1024Mi").canonical
+}
+
+output "larger" {
+  value = provider::cpln::quantity_compare("1.5", "1000m")
+}
+
+output "sum" {
+  value = provider::cpln::quantity_add("1Gi", "512Mi")
+}
+
+output "millicores" {
+  value = provider::cpln::quantity_to_millicores("1.5")
+}
+
+output "mebibytes" {
+  value = provider::cpln::quantity_to_mebibytes("1Gi")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("canonical", "1Gi"),
+					resource.TestCheckOutput("larger", "1"),
+					resource.TestCheckOutput("sum", "1536Mi"),
+					resource.TestCheckOutput("millicores", "1500"),
+					resource.TestCheckOutput("mebibytes", "1024"),
+				),
+			},
+		},
+	})
+}
+
+/*** Unit Tests ***/
+
+// TestQuantityParseFunction verifies the components and canonical form of valid quantities and the rejected ones.
+func TestQuantityParseFunction(t *testing.T) {
+	// Define the test cases, where an empty canonical form means the quantity is rejected
+	cases := []struct {
+		quantity  string
+		value     string
+		unit      string
+		canonical string
+	}{
+		{"500m", "500", "m", "500m"},
+		{"1500m", "1500", "m", "1500m"},
+		{"2000m", "2000", "m", "2"},
+		{"1.5", "1.5", "", "1500m"},
+		{".25", "0.25", "", "250m"},
+		{"1024Mi", "1024", "Mi", "1Gi"},
+		{"0.5Gi", "0.5", "Gi", "512Mi"},
+		{"1000M", "1000", "M", "1G"},
+		{"1.5k", "1.5", "k", "1500"},
+		{"0Mi", "0", "Mi", "0"},
+		{"1e3", "1000", "", "1000"},
+		{"1E9", "1000000000", "", "1000000000"},
+		{"5e2m", "500", "m", "500m"},
+		{"2.5e-1", "0.25", "", "250m"},
+		{"1E", "1", "E", "1E"},
+		{"1Ei", "1", "Ei", "1Ei"},
+		{"1.5cores", "", "", ""},
+		{"-1", "", "", ""},
+		{"1e", "", "", ""},
+		{"1e100", "", "", ""},
+		{"1.2.3", "", "", ""},
+		{"Mi", "", "", ""},
+		{"", "", "", ""},
+	}
+
+	// Run every test case
+	for _, c := range cases {
+		result, funcErr := RunTestFunction(NewQuantityParseFunction(), types.StringValue(c.quantity))
+
+		// Verify the rejected quantity
+		if c.canonical == "" {
+			if funcErr == nil {
+				t.Errorf("quantity_parse(%q): expected an error, got %s", c.quantity, result)
+			}
+
+			continue
+		}
+
+		// Build the expected object
+		value, _ := new(big.Float).SetString(c.value)
+		expected := types.ObjectValueMust(quantityParseAttributeTypes, map[string]attr.Value{
+			"value":     types.NumberValue(value),
+			"unit":      types.StringValue(c.unit),
+			"canonical": types.StringValue(c.canonical),
+		})
+
+		// Verify the components
+		if funcErr != nil || !result.Equal(expected) {
+			t.Errorf("quantity_parse(%q): expected %s, got %s (%v)", c.quantity, expected, result, funcErr)
+		}
+	}
+}
+
+// TestQuantityCompareFunction verifies quantities are compared regardless of their units.
+func TestQuantityCompareFunction(t *testing.T) {
+	// Define the test cases
+	cases := []struct {
+		a        string
+		b        string
+		expected int64
+		rejected bool
+	}{
+		{"1.5", "1000m", 1, false},
+		{"1", "1000m", 0, false},
+		{"500m", "1", -1, false},
+		{"1Gi", "1024Mi", 0, false},
+		{"1G", "1Gi", -1, false},
+		{"500m", "128Mi", 0, true},
+		{"128Mi", "500m", 0, true},
+	}
+
+	// Run every test case
+	for _, c := range cases {
+		result, funcErr := RunTestFunction(NewQuantityCompareFunction(), types.StringValue(c.a), types.StringValue(c.b))
+
+		// Verify the rejected pair
+		if c.rejected {
+			if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 1 {
+				t.Errorf("quantity_compare(%q, %q): expected an error on the second argument, got %v", c.a, c.b, funcErr)
+			}
+
+			continue
+		}
+
+		// Verify the comparison
+		if funcErr != nil || !result.Equal(types.Int64Value(c.expected)) {
+			t.Errorf("quantity_compare(%q, %q): expected %d, got %s (%v)", c.a, c.b, c.expected, result, funcErr)
+		}
+	}
+}
+
+// TestQuantityAddFunction verifies sums are returned in their canonical form.
+func TestQuantityAddFunction(t *testing.T) {
+	// Define the test cases
+	cases := []struct {
+		a        string
+		b        string
+		expected string
+	}{
+		{"250m", "250m", "500m"},
+		{"500m", "0.5", "1"},
+		{"1Gi", "512Mi", "1536Mi"},
+		{"512Mi", "512Mi", "1Gi"},
+		{"1G", "1Mi", "1001048576"},
+		{"1Ki", "1024", "2Ki"},
+	}
+
+	// Run every test case
+	for _, c := range cases {
+		result, funcErr := RunTestFunction(NewQuantityAddFunction(), types.StringValue(c.a), types.StringValue(c.b))
+
+		// Verify the sum
+		if funcErr != nil || !result.Equal(types.StringValue(c.expected)) {
+			t.Errorf("quantity_add(%q, %q): expected %s, got %s (%v)", c.a, c.b, c.expected, result, funcErr)
+		}
+	}
+}
+
+// TestQuantityConversionFunctions verifies the conversions to millicores and mebibytes and the rejected kinds.
+func TestQuantityConversionFunctions(t *testing.T) {
+	// Verify the conversions to millicores
+	for quantity, expected := range map[string]float64{"1.5": 1500, "250m": 250, "2": 2000} {
+		result, funcErr := RunTestFunction(NewQuantityToMillicoresFunction(), types.StringValue(quantity))
+		if funcErr != nil || !result.Equal(types.NumberValue(big.NewFloat(expected))) {
+			t.Errorf("quantity_to_millicores(%q): expected %v, got %s (%v)", quantity, expected, result, funcErr)
+		}
+	}
+
+	// Verify the conversions to mebibytes
+	for quantity, expected := range map[string]float64{"1Gi": 1024, "512Mi": 512, "512Ki": 0.5, "1048576": 1} {
+		result, funcErr := RunTestFunction(NewQuantityToMebibytesFunction(), types.StringValue(quantity))
+		if funcErr != nil || !result.Equal(types.NumberValue(big.NewFloat(expected))) {
+			t.Errorf("quantity_to_mebibytes(%q): expected %v, got %s (%v)", quantity, expected, result, funcErr)
+		}
+	}
+
+	// Verify memory quantities are not converted to millicores
+	if _, funcErr := RunTestFunction(NewQuantityToMillicoresFunction(), types.StringValue("128Mi")); funcErr == nil {
+		t.Errorf("quantity_to_millicores(\"128Mi\"): expected an error")
+	}
+
+	// Verify millicores are not converted to mebibytes
+	if _, funcErr := RunTestFunction(NewQuantityToMebibytesFunction(), types.StringValue("500m")); funcErr == nil {
+		t.Errorf("quantity_to_mebibytes(\"500m\"): expected an error")
+	}
+}
+
+// TestQuantityValidators verifies the plan-time validators of the CPU and memory attributes.
+func TestQuantityValidators(t *testing.T) {
+	// Define the test cases
+	cases := []struct {
+		validator validator.String
+		value     string
+		valid     bool
+	}{
+		{validators.CpuQuantityValidator{}, "1500m", true},
+		{validators.CpuQuantityValidator{}, "1.5", true},
+		{validators.CpuQuantityValidator{}, "1.5cores", false},
+		{validators.CpuQuantityValidator{}, "128Mi", false},
+		{validators.CpuQuantityValidator{}, "5e2m", true},
+		{validators.MemoryQuantityValidator{}, "128Mi", true},
+		{validators.MemoryQuantityValidator{}, "1G", true},
+		{validators.MemoryQuantityValidator{}, "500m", false},
+		{validators.MemoryQuantityValidator{}, "128MB", false},
+		{validators.MemoryQuantityValidator{}, "1e9", true},
+		{validators.MemoryQuantityValidator{}, "0.5Gi", true},
+		{validators.MemoryQuantityValidator{}, "0.5", false},
+		{validators.MemoryQuantityValidator{}, "1.5e-4k", false},
+	}
+
+	// Run every test case
+	for _, c := range cases {
+		resp := validator.StringResponse{}
+		c.validator.ValidateString(context.Background(), validator.StringRequest{ConfigValue: types.StringValue(c.value)}, &resp)
+
+		// Verify the outcome
+		if resp.Diagnostics.HasError() == c.valid {
+			t.Errorf("%T(%q): expected valid=%t, got %v", c.validator, c.value, c.valid, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package cpln
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure function implements required interfaces.
+var _ function.Function = &SelfLinkFunction{}
+
+/*** Function Configuration ***/
+
+// SelfLinkFunction builds the org-relative self link of a resource.
+type SelfLinkFunction struct{}
+
+// NewSelfLinkFunction returns a new instance of the function implementation.
+func NewSelfLinkFunction() function.Function {
+	return &SelfLinkFunction{}
+}
+
+// Metadata provides the function name.
+func (f *SelfLinkFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "self_link"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *SelfLinkFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the self link of a resource.",
+		Description: "Builds the org-relative self link of a resource, such as `//gvc/GVC_NAME/workload/WORKLOAD_NAME`, which Control Plane resolves against the org configured in the provider. Workloads, identities and volume sets require a GVC, every other kind must omit it.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "kind",
+				Description: "The kind of the resource, such as `workload`, `secret` or `gvc`.",
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the resource.",
+			},
+			function.StringParameter{
+				Name:           "gvc",
+				Description:    "The name of the GVC the resource belongs to, or null for resources that do not belong to a GVC.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the self link from the arguments.
+func (f *SelfLinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kind, name string
+	var gvc types.String
+
+	// Read the arguments
+	resp.Error = req.Arguments.Get(ctx, &kind, &name, &gvc)
+
+	// Abort if the arguments could not be read
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate the kind and name, which become segments of the link
+	for index, segment := range []string{kind, name} {
+		if segment == "" || strings.Contains(segment, "/") {
+			resp.Error = function.NewArgumentFuncError(int64(index), fmt.Sprintf("Expected a non-empty value without slashes, got %q.", segment))
+			return
+		}
+	}
+
+	// Validate the GVC against the scope of the kind
+	switch {
+	case IsGvcScopedResource(kind) && gvc.IsNull():
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("A %s belongs to a GVC, the gvc argument must be set.", kind))
+	case !IsGvcScopedResource(kind) && !gvc.IsNull():
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("A %s does not belong to a GVC, the gvc argument must be null.", kind))
+	case !gvc.IsNull() && (gvc.ValueString() == "" || strings.Contains(gvc.ValueString(), "/")):
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Expected a non-empty value without slashes, got %q.", gvc.ValueString()))
+	}
+
+	// Abort on validation errors
+	if resp.Error != nil {
+		return
+	}
+
+	// Set the result
+	resp.Error = resp.Result.Set(ctx, GetRelativeSelfLink(kind, name, gvc.ValueString()))
+}
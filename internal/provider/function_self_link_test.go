@@ -0,0 +1,104 @@
+package cpln
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneFunctionSelfLink_basic verifies the function is callable from a configuration.
+func TestAccControlPlaneFunctionSelfLink_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: GetProviderServer(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "workload" {
+  value = provider::cpln::self_link("workload", "httpbin", "production")
+}
+
+output "secret" {
+  value = provider::cpln::self_link("secret", "database", null)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("workload", "//gvc/production/workload/httpbin"),
+					resource.TestCheckOutput("secret", "//secret/database"),
+				),
+			},
+		},
+	})
+}
+
+/*** Unit Tests ***/
+
+// TestSelfLinkFunction verifies the self links built for every scope and the rejected arguments.
+func TestSelfLinkFunction(t *testing.T) {
+	// Define the test cases
+	cases := []struct {
+		kind     string
+		name     string
+		gvc      types.String
+		expected string
+		errorArg int64
+	}{
+		{"workload", "httpbin", types.StringValue("production"), "//gvc/production/workload/httpbin", -1},
+		{"volumeset", "data", types.StringValue("production"), "//gvc/production/volumeset/data", -1},
+		{"gvc", "production", types.StringNull(), "//gvc/production", -1},
+		{"secret", "database", types.StringNull(), "//secret/database", -1},
+		{"identity", "payments", types.StringNull(), "", 2},
+		{"secret", "database", types.StringValue("production"), "", 2},
+		{"workload", "httpbin", types.StringValue("prod/uction"), "", 2},
+		{"", "httpbin", types.StringNull(), "", 0},
+		{"secret", "a/b", types.StringNull(), "", 1},
+	}
+
+	// Run every test case
+	for _, c := range cases {
+		result, funcErr := RunTestFunction(NewSelfLinkFunction(), types.StringValue(c.kind), types.StringValue(c.name), c.gvc)
+
+		// Verify the rejected argument
+		if c.errorArg >= 0 {
+			if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != c.errorArg {
+				t.Errorf("self_link(%q, %q, %s): expected an error on argument %d, got %v", c.kind, c.name, c.gvc, c.errorArg, funcErr)
+			}
+
+			continue
+		}
+
+		// Verify the link
+		if funcErr != nil || !result.Equal(types.StringValue(c.expected)) {
+			t.Errorf("self_link(%q, %q, %s): expected %s, got %s (%v)", c.kind, c.name, c.gvc, c.expected, result, funcErr)
+		}
+	}
+}
+
+// RunTestFunction runs the function with the arguments and returns its result and error.
+func RunTestFunction(f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	// Retrieve the definition to build the result of the declared type
+	definition := function.DefinitionResponse{}
+	f.Definition(context.Background(), function.DefinitionRequest{}, &definition)
+
+	// Initialize the result with an unknown value of the declared type
+	result, funcErr := definition.Definition.Return.NewResultData(context.Background())
+	if funcErr != nil {
+		return nil, funcErr
+	}
+
+	// Run the function
+	resp := function.RunResponse{Result: result}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &resp)
+
+	// Return the result and error
+	return resp.Result.Value(), resp.Error
+}
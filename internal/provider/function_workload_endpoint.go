@@ -0,0 +1,68 @@
+package cpln
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure function implements required interfaces.
+var _ function.Function = &WorkloadEndpointFunction{}
+
+/*** Function Configuration ***/
+
+// WorkloadEndpointFunction builds the internal endpoint of a workload.
+type WorkloadEndpointFunction struct{}
+
+// NewWorkloadEndpointFunction returns a new instance of the function implementation.
+func NewWorkloadEndpointFunction() function.Function {
+	return &WorkloadEndpointFunction{}
+}
+
+// Metadata provides the function name.
+func (f *WorkloadEndpointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "workload_endpoint"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *WorkloadEndpointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the internal endpoint of a workload.",
+		Description: "Builds the hostname other workloads use to reach a workload from within Control Plane, in the format `WORKLOAD_NAME.GVC_NAME.cpln.local`. The canonical public endpoint depends on the GVC alias and is exposed by the `status` of the `cpln_workload` resource instead.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the workload.",
+				Validators: []function.StringParameterValidator{
+					validators.NameParameterValidator{},
+				},
+			},
+			function.StringParameter{
+				Name:        "gvc",
+				Description: "The name of the GVC the workload belongs to.",
+				Validators: []function.StringParameterValidator{
+					validators.NameParameterValidator{},
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the endpoint from the arguments.
+func (f *WorkloadEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, gvc string
+
+	// Read the arguments
+	resp.Error = req.Arguments.Get(ctx, &name, &gvc)
+
+	// Abort if the arguments could not be read
+	if resp.Error != nil {
+		return
+	}
+
+	// Set the result
+	resp.Error = resp.Result.Set(ctx, fmt.Sprintf("%s.%s.cpln.local", name, gvc))
+}
@@ -0,0 +1,44 @@
+package cpln
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneFunctionWorkloadEndpoint_basic verifies the function is callable from a configuration.
+func TestAccControlPlaneFunctionWorkloadEndpoint_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: GetProviderServer(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "endpoint" {
+  value = provider::cpln::workload_endpoint("httpbin", "production")
+}
+`,
+				Check: resource.TestCheckOutput("endpoint", "httpbin.production.cpln.local"),
+			},
+		},
+	})
+}
+
+/*** Unit Tests ***/
+
+// TestWorkloadEndpointFunction verifies the endpoint built from the arguments.
+func TestWorkloadEndpointFunction(t *testing.T) {
+	// Run the function
+	result, funcErr := RunTestFunction(NewWorkloadEndpointFunction(), types.StringValue("httpbin"), types.StringValue("production"))
+
+	// Verify the endpoint
+	if funcErr != nil || !result.Equal(types.StringValue("httpbin.production.cpln.local")) {
+		t.Fatalf("expected httpbin.production.cpln.local, got %s (%v)", result, funcErr)
+	}
+}
@@ -5,16 +5,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -59,6 +60,118 @@ func GetSelfLinkWithGvc(orgName string, kind string, gvc string, name string) st
 	return fmt.Sprintf("/org/%s/gvc/%s/%s/%s", orgName, gvc, kind, name)
 }
 
+// GetRelativeSelfLink constructs the org-relative self link of the specified resource, omitting the GVC when empty.
+func GetRelativeSelfLink(kind string, name string, gvc string) string {
+	// Resources within a GVC are nested under it
+	if gvc != "" {
+		return fmt.Sprintf("//gvc/%s/%s/%s", gvc, kind, name)
+	}
+
+	// Return the org scoped link
+	return fmt.Sprintf("//%s/%s", kind, name)
+}
+
+// SelfLinkParts holds the components of a self link, with empty values for the components it omits.
+type SelfLinkParts struct {
+	Org  string
+	Gvc  string
+	Kind string
+	Name string
+}
+
+// ParseSelfLink splits a full ("/org/...") or org-relative ("//...") self link into its components.
+func ParseSelfLink(link string) (*SelfLinkParts, error) {
+	parts := SelfLinkParts{}
+	var segments []string
+
+	// Strip the org prefix, which relative links omit
+	switch {
+	case strings.HasPrefix(link, "/org/"):
+		segments = strings.Split(strings.TrimPrefix(link, "/org/"), "/")
+		parts.Org, segments = segments[0], segments[1:]
+	case strings.HasPrefix(link, "//"):
+		segments = strings.Split(strings.TrimPrefix(link, "//"), "/")
+	default:
+		return nil, fmt.Errorf("expected a self link starting with '/org/' or '//', got %q", link)
+	}
+
+	// Reject empty segments such as the ones produced by trailing or doubled slashes
+	if (parts.Org == "" && strings.HasPrefix(link, "/org/")) || slices.Contains(segments, "") {
+		return nil, fmt.Errorf("self link %q contains an empty segment", link)
+	}
+
+	switch {
+	case len(segments) == 0 && parts.Org != "":
+		// The link of the org itself
+		parts.Kind, parts.Name = "org", parts.Org
+	case len(segments) == 2:
+		// An org scoped resource, including a GVC
+		parts.Kind, parts.Name = segments[0], segments[1]
+	case len(segments) == 4 && segments[0] == "gvc":
+		// A resource nested within a GVC
+		parts.Gvc, parts.Kind, parts.Name = segments[1], segments[2], segments[3]
+	default:
+		return nil, fmt.Errorf("self link %q does not reference a resource", link)
+	}
+
+	// Resources that live within a GVC cannot be referenced without it
+	if parts.Gvc == "" && IsGvcScopedResource(parts.Kind) {
+		return nil, fmt.Errorf("self link %q references a %s without its GVC", link, parts.Kind)
+	}
+
+	// Return the parsed components
+	return &parts, nil
+}
+
+// ParseSelfLinkOfKind parses a self link and ensures it references the specified kind. The org of a full link is
+// returned as is, so that the caller can manage the resource within it, while relative links leave it empty.
+func ParseSelfLinkOfKind(link string, kind string) (*SelfLinkParts, error) {
+	// Parse the link
+	parts, err := ParseSelfLink(link)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject links to other kinds
+	if parts.Kind != kind {
+		return nil, fmt.Errorf("self link %q references a %s, expected a %s", link, parts.Kind, kind)
+	}
+
+	// Return the parsed components
+	return parts, nil
+}
+
+// ParseGvcScopedImportId parses the import ID of a resource within a GVC, accepting "GVC_NAME:NAME", a full self
+// link ("/org/ORG_NAME/gvc/GVC_NAME/KIND/NAME") or an org-relative self link ("//gvc/GVC_NAME/KIND/NAME"). The org is
+// only returned for full self links, the other forms belong to the org configured in the provider.
+func ParseGvcScopedImportId(id string, kind string) (string, string, string, error) {
+	// Parse the self link forms
+	if strings.HasPrefix(id, "/") {
+		parts, err := ParseSelfLinkOfKind(id, kind)
+		if err != nil {
+			return "", "", "", err
+		}
+
+		return parts.Org, parts.Gvc, parts.Name, nil
+	}
+
+	// Split the short form into the GVC and the name
+	parts := strings.SplitN(id, ":", 2)
+
+	// Validate that the short form has exactly two non-empty segments without slashes
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.Contains(id, "/") {
+		return "", "", "", fmt.Errorf("%q is neither a self link nor a 'GVC_NAME:NAME' pair", id)
+	}
+
+	// Reject names containing another separator, which usually means the segments were mixed up
+	if strings.Contains(parts[1], ":") {
+		return "", "", "", fmt.Errorf("%q contains more than one ':' separator", id)
+	}
+
+	// Return the GVC and the name
+	return "", parts[0], parts[1], nil
+}
+
 // GetDomainLock returns a per-domain mutex for serializing route operations.
 func GetDomainLock(domainName string) *sync.Mutex {
 	mu, _ := domainOperationLocks.LoadOrStore(domainName, &sync.Mutex{})
@@ -168,12 +281,23 @@ func ToStringSlice(ifaces []interface{}) []string {
 }
 
 // PreserveJSONFormatting returns the plan value if both raw API string and plan string parse to semantically identical JSON, otherwise returns the API string value.
+// A non-string API value is serialized to JSON when there is no plan value to fall back to.
 func PreserveJSONFormatting(raw interface{}, plan types.String) types.String {
 	// Convert raw interface to string value
 	rawAPI, ok := raw.(string)
 
 	// Check if raw value is not a string
 	if !ok {
+		// Return original plan value when there is one
+		if !plan.IsNull() && !plan.IsUnknown() {
+			return plan
+		}
+
+		// Without a prior value, such as right after an import, serialize the API value instead
+		if encoded, err := json.Marshal(raw); err == nil && raw != nil {
+			return types.StringValue(string(encoded))
+		}
+
 		// Return original plan value
 		return plan
 	}
@@ -348,35 +472,92 @@ func StringifyStringValue(v types.String) string {
 	return v.ValueString()
 }
 
-// ExecuteCplnCommand executes a cpln CLI command and returns the standard output.
-func ExecuteCplnCommand(args []string) (string, error) {
-	cmd := exec.Command("cpln", args...)
+// AddAPIError reports an API error as a diagnostic, keeping the request ID so the failure can be traced on the API side.
+func AddAPIError(diags *diag.Diagnostics, summary string, err error) {
+	AddAttributeAPIError(diags, summary, err, nil)
+}
+
+// AddAttributeAPIError reports an API error like AddAPIError, attaching the validation failures reported by the API for
+// specific fields to the attributes the resolver maps them to, so Terraform can point at the offending configuration.
+// The error is reported as a whole when any failure cannot be attached.
+func AddAttributeAPIError(diags *diag.Diagnostics, summary string, err error, resolve func(apiPath string) (path.Path, bool)) {
+	// Errors raised without a response carry nothing more to report
+	apiErr, ok := client.AsAPIError(err)
+	if !ok {
+		diags.AddError(summary, err.Error())
+		return
+	}
 
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	// Describe the response so every diagnostic can be traced on the API side
+	trace := fmt.Sprintf("Status: %d", apiErr.StatusCode)
+	if apiErr.RequestID != "" {
+		trace += fmt.Sprintf(", request ID: %s", apiErr.RequestID)
+	}
 
-	err := cmd.Run()
-	if err != nil {
-		errorMessage := ""
-		stdoutString := stdout.String()
-		stderrString := stderr.String()
+	// Attach field validation failures to their attributes when the API reported any
+	fieldErrors := apiErr.FieldErrors()
+	attached := 0
 
-		if len(strings.TrimSpace(stdoutString)) != 0 {
-			errorMessage = fmt.Sprintf("Stdout: %s", stdoutString)
+	for _, fieldError := range fieldErrors {
+		// Skip failures of fields without an attribute, the error as a whole describes them
+		if resolve == nil {
+			break
+		}
+		attributePath, ok := resolve(fieldError.Path)
+		if !ok {
+			continue
 		}
 
-		if len(strings.TrimSpace(stderrString)) != 0 {
-			if len(errorMessage) != 0 {
-				errorMessage = fmt.Sprintf("%s ", errorMessage)
-			}
-			errorMessage = fmt.Sprintf("%sStderr: %s", errorMessage, stderrString)
+		diags.AddAttributeError(attributePath, summary, fmt.Sprintf("%s (API field: %s)\n\n%s", fieldError.Message, fieldError.Path, trace))
+		attached++
+	}
+
+	// The field failures already describe the error
+	if len(fieldErrors) > 0 && attached == len(fieldErrors) {
+		return
+	}
+
+	// Report the error as a whole, with the request ID the message does not include
+	detail := err.Error()
+	if apiErr.RequestID != "" {
+		detail += fmt.Sprintf("\n\nRequest ID: %s", apiErr.RequestID)
+	}
+
+	diags.AddError(summary, detail)
+}
+
+// APIPathToAttributePath maps a dotted API field path (i.e. spec.localOptions.capacityAI) to the top-level Terraform
+// attribute that configures it (i.e. local_options). The fields of a kind whose attribute is not named after them are
+// looked up in fieldAttributes first, keyed by the field path (i.e. "spec.containers" to "container").
+func APIPathToAttributePath(apiPath string, fieldAttributes map[string]string) path.Path {
+	// Split the path into its segments
+	segments := strings.Split(apiPath, ".")
+
+	// Prefer the attribute of the longest field path the kind maps explicitly
+	for i := len(segments); i > 0; i-- {
+		if name, ok := fieldAttributes[strings.Join(segments[:i], ".")]; ok {
+			return path.Root(name)
 		}
+	}
 
-		return "", fmt.Errorf("cpln command failed: %s. %s", err, errorMessage)
+	// Drop the spec wrapper since most spec fields are exposed at the root of the schema
+	if len(segments) > 1 && segments[0] == "spec" {
+		segments = segments[1:]
+	}
+
+	// Convert the camel cased API name into the snake cased attribute name
+	var name strings.Builder
+	for i, r := range segments[0] {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				name.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		name.WriteRune(r)
 	}
 
-	return stdout.String(), nil
+	return path.Root(name.String())
 }
 
 // TestdataAbsPath returns the absolute path to a file under testdata.
@@ -0,0 +1,364 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure list resource implements required interfaces.
+var (
+	_ list.ListResource              = &QueryListResource[WorkloadResourceModel, client.Workload]{}
+	_ list.ListResourceWithConfigure = &QueryListResource[WorkloadResourceModel, client.Workload]{}
+)
+
+/*** List Resource Configuration ***/
+
+// QueryListResource is a list resource discovering the items of a kind so Terraform can generate import blocks for them.
+type QueryListResource[Plan HasEntityID, APIObject any] struct {
+	EntityBase
+	TypeName   string
+	EntityName string
+	Identity   EntityIdentity
+	Prototype  EntityOperatorInterface[Plan, APIObject]
+	Operations EntityOperations[Plan, APIObject]
+}
+
+// NewWorkloadListResource returns a list resource discovering the workloads of the org.
+func NewWorkloadListResource() list.ListResource {
+	return &QueryListResource[WorkloadResourceModel, client.Workload]{TypeName: "cpln_workload", EntityName: "workload", Identity: workloadEntityIdentity, Prototype: &WorkloadResourceOperator{}}
+}
+
+// NewGvcListResource returns a list resource discovering the GVCs of the org.
+func NewGvcListResource() list.ListResource {
+	return &QueryListResource[GvcResourceModel, client.Gvc]{TypeName: "cpln_gvc", EntityName: "GVC", Identity: gvcEntityIdentity, Prototype: &GvcResourceOperator{}}
+}
+
+// NewSecretListResource returns a list resource discovering the secrets of the org, without revealing their values.
+func NewSecretListResource() list.ListResource {
+	return &QueryListResource[SecretResourceModel, client.Secret]{TypeName: "cpln_secret", EntityName: "secret", Identity: secretEntityIdentity, Prototype: NewSecretResourceOperator()}
+}
+
+// NewIdentityListResource returns a list resource discovering the identities of the org.
+func NewIdentityListResource() list.ListResource {
+	return &QueryListResource[IdentityResourceModel, client.Identity]{TypeName: "cpln_identity", EntityName: "identity", Identity: identityEntityIdentity, Prototype: &IdentityResourceOperator{}}
+}
+
+// NewPolicyListResource returns a list resource discovering the policies of the org.
+func NewPolicyListResource() list.ListResource {
+	return &QueryListResource[PolicyResourceModel, client.Policy]{TypeName: "cpln_policy", EntityName: "policy", Identity: policyEntityIdentity, Prototype: &PolicyResourceOperator{}}
+}
+
+// NewDomainListResource returns a list resource discovering the domains of the org.
+func NewDomainListResource() list.ListResource {
+	return &QueryListResource[DomainResourceModel, client.Domain]{TypeName: "cpln_domain", EntityName: "domain", Identity: domainEntityIdentity, Prototype: &DomainResourceOperator{}}
+}
+
+// NewMk8sListResource returns a list resource discovering the MK8s clusters of the org.
+func NewMk8sListResource() list.ListResource {
+	return &QueryListResource[Mk8sResourceModel, client.Mk8s]{TypeName: "cpln_mk8s", EntityName: "MK8s cluster", Identity: mk8sEntityIdentity, Prototype: &Mk8sResourceOperator{}}
+}
+
+// Metadata provides the list resource type name, which matches the type name of the resource it discovers.
+func (l *QueryListResource[Plan, APIObject]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = l.TypeName
+}
+
+// Configure configures the list resource before use.
+func (l *QueryListResource[Plan, APIObject]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	l.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
+	l.Operations = NewEntityOperations(l.client, l.Prototype)
+}
+
+// ListResourceConfigSchema defines the schema of the list block configuration.
+func (l *QueryListResource[Plan, APIObject]) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	// Define the filters shared by every kind
+	attributes := map[string]listschema.Attribute{
+		"tags": listschema.MapAttribute{
+			Description: fmt.Sprintf("Only list the %s items carrying every one of these tags with the given value.", l.EntityName),
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	}
+
+	// The org to search can differ from the provider org
+	attributes["org"] = listschema.StringAttribute{
+		Description: fmt.Sprintf("The org to list the %s items of. Defaults to the org configured in the provider.", l.EntityName),
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	// Kinds that live within a GVC can be narrowed down to one
+	if l.Identity.IsGvcScoped {
+		attributes["gvc"] = listschema.StringAttribute{
+			Description: fmt.Sprintf("Only list the %s items of this GVC. Every GVC of the org is searched when omitted.", l.EntityName),
+			Optional:    true,
+			Validators: []validator.String{
+				validators.NameValidator{},
+			},
+		}
+	}
+
+	resp.Schema = listschema.Schema{
+		Description: fmt.Sprintf("Discovers the %s items of the org so they can be imported.", l.EntityName),
+		Attributes:  attributes,
+	}
+}
+
+// List streams every item of the kind that matches the configured filters.
+func (l *QueryListResource[Plan, APIObject]) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var tags types.Map
+	var org types.String
+	var gvc types.String
+
+	// Read the tags filter
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &tags)...)
+
+	// Read the org to search
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("org"), &org)...)
+
+	// Read the GVC filter when the kind lives within a GVC
+	if l.Identity.IsGvcScoped {
+		diags.Append(req.Config.GetAttribute(ctx, path.Root("gvc"), &gvc)...)
+	}
+
+	// Build the query matching the tags filter
+	query := l.BuildQuery(ctx, &diags, tags)
+
+	// Abort if the configuration could not be read
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Target the configured org, falling back to the provider org
+	orgClient := l.client.WithOrg(org.ValueString())
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		// Resolve the GVCs to search
+		gvcNames, err := l.ResolveGvcNames(ctx, orgClient, gvc)
+
+		// Handle API errors
+		if err != nil {
+			AddAPIError(&diags, "API error", err)
+			push(list.ListResult{Diagnostics: diags})
+			return
+		}
+
+		// Track the number of results streamed so far
+		var count int64
+
+		// Iterate over every GVC to search
+		for _, gvcName := range gvcNames {
+			// Run the query
+			items, err := l.InvokeQuery(ctx, orgClient, gvcName, query)
+
+			// Handle API errors
+			if err != nil {
+				AddAPIError(&diags, "API error", err)
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			// Stream every matching item
+			for _, item := range items {
+				// Stop when Terraform no longer needs results
+				if !push(l.NewListResult(ctx, req, orgClient.Org, gvcName, item)) {
+					return
+				}
+
+				// Stop once the requested number of results is reached
+				count++
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+			}
+		}
+	}
+}
+
+/*** Helpers ***/
+
+// BuildQuery returns a query matching every item of the kind that carries all of the given tags.
+func (l *QueryListResource[Plan, APIObject]) BuildQuery(ctx context.Context, diags *diag.Diagnostics, tags types.Map) client.Query {
+	terms := []client.QueryTerm{}
+
+	// Every item of the kind is listed when no tags are specified
+	if tags.IsNull() || tags.IsUnknown() {
+		return client.NewQuery(l.Identity.Kind, client.QueryMatchAll, terms...)
+	}
+
+	// Extract the tags
+	values := map[string]string{}
+	diags.Append(tags.ElementsAs(ctx, &values, false)...)
+
+	// Sort the tag names so the query is deterministic
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Add a term for every tag
+	for _, name := range names {
+		value := values[name]
+		terms = append(terms, client.NewTagQueryTerm(name, client.QueryOpEquals, &value))
+	}
+
+	// Return the query
+	return client.NewQuery(l.Identity.Kind, client.QueryMatchAll, terms...)
+}
+
+// ResolveGvcNames returns the GVCs to search, every GVC of the org when the kind lives within one and none was configured.
+func (l *QueryListResource[Plan, APIObject]) ResolveGvcNames(ctx context.Context, orgClient *client.Client, gvc types.String) ([]string, error) {
+	// Org scoped kinds are queried once
+	if !l.Identity.IsGvcScoped {
+		return []string{""}, nil
+	}
+
+	// Search the configured GVC only
+	if !gvc.IsNull() && !gvc.IsUnknown() {
+		return []string{gvc.ValueString()}, nil
+	}
+
+	// Query every GVC of the org
+	result, _, err := client.QueryKind[client.Base](ctx, orgClient, "gvc", client.NewQuery("gvc", client.QueryMatchAll))
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the GVC names
+	gvcNames := []string{}
+	for _, item := range result.Items {
+		gvcNames = append(gvcNames, *item.Name)
+	}
+
+	// Return the GVC names
+	return gvcNames, nil
+}
+
+// InvokeQuery runs the query against the org, or against the GVC when the kind lives within one.
+func (l *QueryListResource[Plan, APIObject]) InvokeQuery(ctx context.Context, orgClient *client.Client, gvcName string, query client.Query) ([]json.RawMessage, error) {
+	var result *client.QueryResult[json.RawMessage]
+	var err error
+
+	// Pick the endpoint matching the scope of the kind
+	if l.Identity.IsGvcScoped {
+		result, _, err = client.QueryGvcKind[json.RawMessage](ctx, orgClient, gvcName, l.Identity.Kind, query)
+	} else {
+		result, _, err = client.QueryKind[json.RawMessage](ctx, orgClient, l.Identity.Kind, query)
+	}
+
+	// Handle API errors
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the raw items so they can be decoded into both the metadata and the full object
+	return result.Items, nil
+}
+
+// NewListResult builds the result for an item, including its full state when Terraform requests it.
+func (l *QueryListResource[Plan, APIObject]) NewListResult(ctx context.Context, req list.ListRequest, org string, gvcName string, item json.RawMessage) list.ListResult {
+	result := req.NewListResult(ctx)
+
+	// Decode the metadata of the item
+	var base client.Base
+	if err := json.Unmarshal(item, &base); err != nil {
+		result.Diagnostics.AddError("Internal Error", fmt.Sprintf("Error decoding a %s returned by the query: %s", l.EntityName, err))
+		return result
+	}
+
+	// Reject items without a name, they cannot be imported
+	if base.Name == nil {
+		result.Diagnostics.AddError("Internal Error", fmt.Sprintf("The query returned a %s without a name.", l.EntityName))
+		return result
+	}
+
+	// Name the result after the item, prefixed by its GVC when it lives within one
+	result.DisplayName = *base.Name
+	if l.Identity.IsGvcScoped {
+		result.DisplayName = fmt.Sprintf("%s/%s", gvcName, *base.Name)
+	}
+
+	// Populate the identity Terraform uses to generate the import block
+	result.Diagnostics.Append(l.Identity.Set(ctx, result.Identity, org, gvcName, *base.Name)...)
+
+	// Skip the full state unless Terraform requests it
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	// Decode the full object
+	var apiObject APIObject
+	if err := json.Unmarshal(item, &apiObject); err != nil {
+		result.Diagnostics.AddError("Internal Error", fmt.Sprintf("Error decoding %s '%s': %s", l.EntityName, *base.Name, err))
+		return result
+	}
+
+	// Map the object to the state the same way an import does
+	operator := l.Operations.NewOperator(ctx, &result.Diagnostics, l.NewImportPlan(ctx, &result.Diagnostics, req, org, gvcName))
+	state := operator.MapResponseToState(&apiObject, false)
+
+	// Abort if diagnostics errors occurred
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	// Set the resource state of the result
+	result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+
+	// Persist the org the item belongs to, when the resource exposes one
+	if _, diags := req.ResourceSchema.TypeAtPath(ctx, path.Root("org")); !diags.HasError() {
+		result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("org"), types.StringValue(org))...)
+	}
+
+	// Return the result
+	return result
+}
+
+// NewImportPlan returns a resource model where only the org and GVC are known, mirroring the state right after an import.
+func (l *QueryListResource[Plan, APIObject]) NewImportPlan(ctx context.Context, diags *diag.Diagnostics, req list.ListRequest, org string, gvcName string) Plan {
+	var plan Plan
+
+	// Build a state where every attribute is null
+	objectType := req.ResourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	state := tfsdk.State{Schema: req.ResourceSchema, Raw: tftypes.NewValue(objectType, values)}
+
+	// Set the org when the resource exposes one
+	if _, typeDiags := state.Schema.TypeAtPath(ctx, path.Root("org")); !typeDiags.HasError() {
+		diags.Append(state.SetAttribute(ctx, path.Root("org"), types.StringValue(org))...)
+	}
+
+	// Set the GVC when the kind lives within one
+	if l.Identity.IsGvcScoped {
+		diags.Append(state.SetAttribute(ctx, path.Root("gvc"), types.StringValue(gvcName))...)
+	}
+
+	// Decode the state into the resource model
+	diags.Append(state.Get(ctx, &plan)...)
+
+	// Return the resource model
+	return plan
+}
@@ -0,0 +1,237 @@
+package cpln
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+/*** Acceptance Test ***/
+
+// TestAccControlPlaneListResourceQuery_basic verifies that the resources discovered by the list resources can be
+// imported by their identity.
+func TestAccControlPlaneListResourceQuery_basic(t *testing.T) {
+	// Generate unique names for the resources
+	random := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gvcName := fmt.Sprintf("tf-list-gvc-%s", random)
+
+	// Run the acceptance test case, covering import by identity
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t, "LIST_RESOURCE_QUERY") },
+		ProtoV6ProviderFactories: GetProviderServer(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: ListResourceQueryHcl(gvcName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpln_gvc.new", "name", gvcName),
+					resource.TestCheckResourceAttr("cpln_workload.new", "gvc", gvcName),
+				),
+			},
+			// Import the GVC by its identity
+			{
+				ResourceName:    "cpln_gvc.new",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Import the workload by its identity
+			{
+				ResourceName:    "cpln_workload.new",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+/*** Unit Tests ***/
+
+// TestQueryListResourceSchemas verifies that every list resource has a valid schema and discovers a resource
+// supporting identities.
+func TestQueryListResourceSchemas(t *testing.T) {
+	// Initialize the context
+	ctx := context.Background()
+
+	// Index the resources of the provider by their type name
+	resources := map[string]frameworkresource.Resource{}
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+		metadata := frameworkresource.MetadataResponse{}
+		r.Metadata(ctx, frameworkresource.MetadataRequest{ProviderTypeName: "cpln"}, &metadata)
+		resources[metadata.TypeName] = r
+	}
+
+	// Iterate over every list resource
+	for _, newListResource := range New("test")().(*CplnProvider).ListResources(ctx) {
+		l := newListResource()
+
+		// Retrieve the type name of the list resource
+		metadata := frameworkresource.MetadataResponse{}
+		l.Metadata(ctx, frameworkresource.MetadataRequest{ProviderTypeName: "cpln"}, &metadata)
+
+		// Validate the list configuration schema
+		resp := list.ListResourceSchemaResponse{}
+		l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &resp)
+		if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("%s: invalid list schema: %v", metadata.TypeName, diags)
+		}
+
+		// Verify the discovered resource exists and supports identities
+		r, ok := resources[metadata.TypeName].(frameworkresource.ResourceWithIdentity)
+		if !ok {
+			t.Fatalf("%s: expected a resource supporting identities with the same type name", metadata.TypeName)
+		}
+
+		// Validate the identity schema of the resource
+		identityResp := frameworkresource.IdentitySchemaResponse{}
+		r.IdentitySchema(ctx, frameworkresource.IdentitySchemaRequest{}, &identityResp)
+		if diags := identityResp.IdentitySchema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("%s: invalid identity schema: %v", metadata.TypeName, diags)
+		}
+	}
+}
+
+// TestQueryListResourceBuildQuery verifies that the tags filter turns into a deterministic tag query.
+func TestQueryListResourceBuildQuery(t *testing.T) {
+	// Initialize the context and diagnostics
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	// Initialize the list resource
+	l := NewWorkloadListResource().(*QueryListResource[WorkloadResourceModel, client.Workload])
+
+	// Build the query without any filter
+	query := l.BuildQuery(ctx, &diags, types.MapNull(types.StringType))
+	if *query.Kind != "workload" || len(*query.Spec.Terms) != 0 {
+		t.Fatalf("expected a query for every workload, got %+v", query)
+	}
+
+	// Build the query filtered by tags
+	tags := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team": types.StringValue("payments"),
+		"env":  types.StringValue("prod"),
+	})
+	query = l.BuildQuery(ctx, &diags, tags)
+
+	// Fail on diagnostics errors
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Verify the terms are sorted by tag name
+	terms := *query.Spec.Terms
+	if len(terms) != 2 || *terms[0].Tag != "env" || *terms[1].Tag != "team" || *terms[1].Value != "payments" || *terms[1].Op != client.QueryOpEquals {
+		t.Fatalf("unexpected terms: %+v", terms)
+	}
+
+	// Verify every tag must match
+	if *query.Spec.Match != client.QueryMatchAll {
+		t.Fatalf("expected match %s, got %s", client.QueryMatchAll, *query.Spec.Match)
+	}
+}
+
+// TestQueryListResourceNewListResult verifies that a result carries the identity and state of the discovered item.
+func TestQueryListResourceNewListResult(t *testing.T) {
+	// Initialize the context
+	ctx := context.Background()
+
+	// Initialize the list resource
+	l := NewIdentityListResource().(*QueryListResource[IdentityResourceModel, client.Identity])
+	l.Configure(ctx, frameworkresource.ConfigureRequest{}, &frameworkresource.ConfigureResponse{})
+
+	// Retrieve the schemas of the discovered resource
+	schemaResp := frameworkresource.SchemaResponse{}
+	NewIdentityResource().Schema(ctx, frameworkresource.SchemaRequest{}, &schemaResp)
+	identityResp := frameworkresource.IdentitySchemaResponse{}
+	NewIdentityResource().(*IdentityResource).IdentitySchema(ctx, frameworkresource.IdentitySchemaRequest{}, &identityResp)
+
+	// Build the request
+	req := list.ListRequest{
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+
+	// Build the result of an identity returned by the query
+	item, _ := json.Marshal(map[string]any{
+		"id":          "b3c3f7a2-0000-0000-0000-000000000000",
+		"name":        "payments",
+		"kind":        "identity",
+		"description": "payments",
+		"tags":        map[string]string{"team": "payments"},
+		"links":       []map[string]string{{"rel": "self", "href": "/org/acme/gvc/prod/identity/payments"}},
+	})
+	result := l.NewListResult(ctx, req, "acme", "prod", item)
+
+	// Fail on diagnostics errors
+	if result.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+	}
+
+	// Verify the display name
+	if result.DisplayName != "prod/payments" {
+		t.Fatalf("expected display name prod/payments, got %s", result.DisplayName)
+	}
+
+	// Verify the identity
+	for attribute, expected := range map[string]string{"org": "acme", "kind": "identity", "gvc": "prod", "name": "payments"} {
+		var value types.String
+		result.Identity.GetAttribute(ctx, path.Root(attribute), &value)
+		if value.ValueString() != expected {
+			t.Errorf("identity %s: expected %s, got %s", attribute, expected, value.ValueString())
+		}
+	}
+
+	// Verify the resource state
+	var state IdentityResourceModel
+	result.Resource.Get(ctx, &state)
+	if state.Name.ValueString() != "payments" || state.Gvc.ValueString() != "prod" || state.Org.ValueString() != "acme" || state.Tags.Elements()["team"] == nil {
+		t.Fatalf("unexpected resource state: %+v", state)
+	}
+}
+
+// ListResourceQueryHcl returns a configuration creating a GVC and a workload to import.
+func ListResourceQueryHcl(gvcName string) string {
+	return fmt.Sprintf(`
+resource "cpln_gvc" "new" {
+  name        = "%s"
+  description = "list resources"
+  locations   = ["aws-eu-central-1"]
+
+  tags = {
+    team = "payments"
+  }
+}
+
+resource "cpln_workload" "new" {
+  gvc  = cpln_gvc.new.name
+  name = "httpbin"
+  type = "serverless"
+
+  container {
+    name  = "container-01"
+    image = "gcr.io/knative-samples/helloworld-go"
+
+    ports {
+      protocol = "http"
+      number   = "8080"
+    }
+  }
+}
+`, gvcName)
+}
@@ -65,6 +65,28 @@ func (q QuerySpecTermModel) AttributeTypes() attr.Type {
 	}
 }
 
+// Query Item //
+
+type QueryItemModel struct {
+	CplnId      types.String `tfsdk:"cpln_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.Map    `tfsdk:"tags"`
+	SelfLink    types.String `tfsdk:"self_link"`
+}
+
+func (q QueryItemModel) AttributeTypes() attr.Type {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"cpln_id":     types.StringType,
+			"name":        types.StringType,
+			"description": types.StringType,
+			"tags":        types.MapType{ElemType: types.StringType},
+			"self_link":   types.StringType,
+		},
+	}
+}
+
 // Lightstep Tracing //
 
 type LightstepTracingModel struct {
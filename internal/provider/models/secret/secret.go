@@ -11,6 +11,12 @@ type OpaqueModel struct {
 	Encoding types.String `tfsdk:"encoding"`
 }
 
+type OpaqueResourceModel struct {
+	OpaqueModel
+	PayloadWo        types.String `tfsdk:"payload_wo"`
+	PayloadWoVersion types.Int32  `tfsdk:"payload_wo_version"`
+}
+
 // TLS //
 
 type TlsModel struct {
@@ -19,6 +25,12 @@ type TlsModel struct {
 	Chain types.String `tfsdk:"chain"`
 }
 
+type TlsResourceModel struct {
+	TlsModel
+	KeyWo        types.String `tfsdk:"key_wo"`
+	KeyWoVersion types.Int32  `tfsdk:"key_wo_version"`
+}
+
 // AWS //
 
 type AwsModel struct {
@@ -28,6 +40,12 @@ type AwsModel struct {
 	ExternalId types.String `tfsdk:"external_id"`
 }
 
+type AwsResourceModel struct {
+	AwsModel
+	SecretKeyWo        types.String `tfsdk:"secret_key_wo"`
+	SecretKeyWoVersion types.Int32  `tfsdk:"secret_key_wo_version"`
+}
+
 // ECR //
 
 type EcrModel struct {
@@ -38,6 +56,12 @@ type EcrModel struct {
 	Repos      types.Set    `tfsdk:"repos"`
 }
 
+type EcrResourceModel struct {
+	EcrModel
+	SecretKeyWo        types.String `tfsdk:"secret_key_wo"`
+	SecretKeyWoVersion types.Int32  `tfsdk:"secret_key_wo_version"`
+}
+
 // Userpass //
 
 type UserpassModel struct {
@@ -46,6 +70,12 @@ type UserpassModel struct {
 	Encoding types.String `tfsdk:"encoding"`
 }
 
+type UserpassResourceModel struct {
+	UserpassModel
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int32  `tfsdk:"password_wo_version"`
+}
+
 // Key Pair //
 
 type KeyPairModel struct {
@@ -54,6 +84,12 @@ type KeyPairModel struct {
 	Passphrase types.String `tfsdk:"passphrase"`
 }
 
+type KeyPairResourceModel struct {
+	KeyPairModel
+	SecretKeyWo        types.String `tfsdk:"secret_key_wo"`
+	SecretKeyWoVersion types.Int32  `tfsdk:"secret_key_wo_version"`
+}
+
 // Azure Connector //
 
 type AzureConnectorModel struct {
@@ -61,9 +97,21 @@ type AzureConnectorModel struct {
 	Code types.String `tfsdk:"code"`
 }
 
+type AzureConnectorResourceModel struct {
+	AzureConnectorModel
+	CodeWo        types.String `tfsdk:"code_wo"`
+	CodeWoVersion types.Int32  `tfsdk:"code_wo_version"`
+}
+
 // NATS Account //
 
 type NatsAccountModel struct {
 	AccountId  types.String `tfsdk:"account_id"`
 	PrivateKey types.String `tfsdk:"private_key"`
 }
+
+type NatsAccountResourceModel struct {
+	NatsAccountModel
+	PrivateKeyWo        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWoVersion types.Int32  `tfsdk:"private_key_wo_version"`
+}
@@ -785,6 +785,26 @@ func (r RequestRetryPolicyModel) AttributeTypes() attr.Type {
 	}
 }
 
+// Wait For Ready //
+
+type WaitForReadyModel struct {
+	Timeout           types.Int32 `tfsdk:"timeout"`
+	PollInterval      types.Int32 `tfsdk:"poll_interval"`
+	MinReadyLocations types.Int32 `tfsdk:"min_ready_locations"`
+	MinReadyReplicas  types.Int32 `tfsdk:"min_ready_replicas"`
+}
+
+func (w WaitForReadyModel) AttributeTypes() attr.Type {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"timeout":             types.Int32Type,
+			"poll_interval":       types.Int32Type,
+			"min_ready_locations": types.Int32Type,
+			"min_ready_replicas":  types.Int32Type,
+		},
+	}
+}
+
 // VM //
 
 type VmModel struct {
@@ -2,20 +2,33 @@ package cpln
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &CplnProvider{}
+	_ provider.Provider                       = &CplnProvider{}
+	_ provider.ProviderWithEphemeralResources = &CplnProvider{}
+	_ provider.ProviderWithListResources      = &CplnProvider{}
+	_ provider.ProviderWithFunctions          = &CplnProvider{}
 )
 
 // CplnProvider is the provider implementation.
@@ -29,11 +42,19 @@ type CplnProvider struct {
 
 // CplnProviderModel maps provider schema data to a Go type.
 type CplnProviderModel struct {
-	Org          types.String `tfsdk:"org"`
-	Endpoint     types.String `tfsdk:"endpoint"`
-	Profile      types.String `tfsdk:"profile"`
-	Token        types.String `tfsdk:"token"`
-	RefreshToken types.String `tfsdk:"refresh_token"`
+	Org             types.String `tfsdk:"org"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Profile         types.String `tfsdk:"profile"`
+	Token           types.String `tfsdk:"token"`
+	RefreshToken    types.String `tfsdk:"refresh_token"`
+	MaxRetries      types.Int32  `tfsdk:"max_retries"`
+	RetryMaxBackoff types.String `tfsdk:"retry_max_backoff"`
+
+	OidcToken            types.String `tfsdk:"oidc_token"`
+	OidcTokenFile        types.String `tfsdk:"oidc_token_file"`
+	OidcTokenExchangeUrl types.String `tfsdk:"oidc_token_exchange_url"`
+
+	FailOnExternalChanges types.Bool `tfsdk:"fail_on_external_changes"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -77,6 +98,37 @@ func (p *CplnProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Sensitive:   true,
 				Description: "A generated token that can be used to authenticate to the data service API. Can be specified with the CPLN_REFRESH_TOKEN environment variable. Used when the provider is required to create an org or update the auth_config property. Refer to the section above on how to obtain the refresh token.",
 			},
+			"oidc_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "An OIDC token issued by an external identity provider, such as a CI pipeline, that is exchanged for a short-lived Control Plane access token. Can be specified with the CPLN_OIDC_TOKEN environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("oidc_token_file")),
+				},
+			},
+			"oidc_token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a file holding an OIDC token issued by an external identity provider. The file is read again every time the access token is refreshed, so that rotated tokens are picked up. Can be specified with the CPLN_OIDC_TOKEN_FILE environment variable.",
+			},
+			"oidc_token_exchange_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The endpoint the OIDC token is exchanged at. Defaults to the token exchange endpoint advertised by the data service. Can be specified with the CPLN_OIDC_TOKEN_EXCHANGE_URL environment variable.",
+			},
+			"max_retries": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The maximum number of times a failed API request is retried. When not set, requests are retried until the operation timeout is reached. Can be specified with the CPLN_MAX_RETRIES environment variable.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum delay between two retries of a failed API request, given as a duration string such as `10s` or `1m`. Default is: `30s`. Can be specified with the CPLN_RETRY_MAX_BACKOFF environment variable.",
+			},
+			"fail_on_external_changes": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When enabled, changes made to a managed object outside of Terraform, such as in the console or with the CLI, fail the plan instead of producing a warning. Default is: `false`. Can be specified with the CPLN_FAIL_ON_EXTERNAL_CHANGES environment variable.",
+			},
 		},
 	}
 }
@@ -116,6 +168,73 @@ func (p *CplnProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		config.RefreshToken = types.StringValue(os.Getenv("CPLN_REFRESH_TOKEN"))
 	}
 
+	if config.OidcToken.IsNull() || config.OidcToken.IsUnknown() {
+		config.OidcToken = types.StringValue(os.Getenv("CPLN_OIDC_TOKEN"))
+	}
+
+	if config.OidcTokenFile.IsNull() || config.OidcTokenFile.IsUnknown() {
+		config.OidcTokenFile = types.StringValue(os.Getenv("CPLN_OIDC_TOKEN_FILE"))
+	}
+
+	if config.OidcTokenExchangeUrl.IsNull() || config.OidcTokenExchangeUrl.IsUnknown() {
+		config.OidcTokenExchangeUrl = types.StringValue(os.Getenv("CPLN_OIDC_TOKEN_EXCHANGE_URL"))
+	}
+
+	if config.MaxRetries.IsNull() || config.MaxRetries.IsUnknown() {
+		if maxRetries := os.Getenv("CPLN_MAX_RETRIES"); maxRetries != "" {
+			value, err := strconv.ParseInt(maxRetries, 10, 32)
+
+			if err != nil || value < 0 {
+				resp.Diagnostics.AddError("Invalid CPLN_MAX_RETRIES", fmt.Sprintf("Expected a non-negative integer, got %q.", maxRetries))
+				return
+			}
+
+			config.MaxRetries = types.Int32Value(int32(value))
+		}
+	}
+
+	if config.RetryMaxBackoff.IsNull() || config.RetryMaxBackoff.IsUnknown() {
+		if retryMaxBackoff := os.Getenv("CPLN_RETRY_MAX_BACKOFF"); retryMaxBackoff != "" {
+			config.RetryMaxBackoff = types.StringValue(retryMaxBackoff)
+		}
+	}
+
+	if config.FailOnExternalChanges.IsNull() || config.FailOnExternalChanges.IsUnknown() {
+		if failOnExternalChanges := os.Getenv("CPLN_FAIL_ON_EXTERNAL_CHANGES"); failOnExternalChanges != "" {
+			value, err := strconv.ParseBool(failOnExternalChanges)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid CPLN_FAIL_ON_EXTERNAL_CHANGES", fmt.Sprintf("Expected a boolean, got %q.", failOnExternalChanges))
+				return
+			}
+
+			config.FailOnExternalChanges = types.BoolValue(value)
+		}
+	}
+
+	// Build the retry policy out of the configuration values
+	retryPolicy := client.DefaultRetryPolicy()
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		retryPolicy.MaxRetries = int(config.MaxRetries.ValueInt32())
+	}
+
+	if !config.RetryMaxBackoff.IsNull() && !config.RetryMaxBackoff.IsUnknown() {
+		maxBackoff, err := time.ParseDuration(config.RetryMaxBackoff.ValueString())
+
+		if err != nil || maxBackoff <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_backoff"),
+				"Invalid Retry Max Backoff",
+				fmt.Sprintf("Expected a positive duration string such as \"30s\", got %q.", config.RetryMaxBackoff.ValueString()),
+			)
+
+			return
+		}
+
+		retryPolicy.MaxBackoff = maxBackoff
+	}
+
 	// Create a new cpln client using the configuration values
 	c, err := client.NewClient(
 		config.Org.ValueStringPointer(),
@@ -123,6 +242,11 @@ func (p *CplnProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		config.Profile.ValueStringPointer(),
 		config.Token.ValueStringPointer(),
 		config.RefreshToken.ValueStringPointer(),
+		&client.OidcConfig{
+			Token:       config.OidcToken.ValueString(),
+			TokenFile:   config.OidcTokenFile.ValueString(),
+			ExchangeURL: config.OidcTokenExchangeUrl.ValueString(),
+		},
 		p.version,
 	)
 
@@ -139,27 +263,52 @@ func (p *CplnProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	// Apply the configured retry policy
+	c.RetryPolicy = retryPolicy
+
+	// Apply the configured handling of changes made outside of Terraform
+	c.FailOnExternalChanges = config.FailOnExternalChanges.ValueBool()
+
 	// Set provider client
 	p.client = c
 
-	// Make the cpln client available during DataSource and Resource type Configure methods
+	// Make the cpln client available during DataSource, Resource, EphemeralResource and ListResource type Configure methods
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ListResourceData = c
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *CplnProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAgentDataSource,
 		NewCloudAccountDataSource,
+		NewCustomLocationDataSource,
+		NewDomainDataSource,
+		NewDomainsDataSource,
+		NewGroupDataSource,
+		NewGroupsDataSource,
 		NewGvcDataSource,
+		NewGvcsDataSource,
 		NewHelmTemplateDataSource,
+		NewIdentitiesDataSource,
+		NewIdentityDataSource,
 		NewImageDataSource,
 		NewImagesDataSource,
+		NewIpSetDataSource,
 		NewLocationDataSource,
 		NewLocationsDataSource,
+		NewMk8sDataSource,
 		NewOrgDataSource,
+		NewPoliciesDataSource,
+		NewPolicyDataSource,
 		NewSecretDataSource,
+		NewSecretsDataSource,
+		NewServiceAccountDataSource,
+		NewVolumeSetDataSource,
 		NewWorkloadDataSource,
+		NewWorkloadsDataSource,
 	}
 }
 
@@ -192,3 +341,39 @@ func (p *CplnProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewWorkloadResource,
 	}
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *CplnProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewMk8sKubeconfigEphemeralResource,
+		NewSecretEphemeralResource,
+		NewServiceAccountKeyEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *CplnProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewDomainListResource,
+		NewGvcListResource,
+		NewIdentityListResource,
+		NewMk8sListResource,
+		NewPolicyListResource,
+		NewSecretListResource,
+		NewWorkloadListResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *CplnProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseSelfLinkFunction,
+		NewQuantityAddFunction,
+		NewQuantityCompareFunction,
+		NewQuantityParseFunction,
+		NewQuantityToMebibytesFunction,
+		NewQuantityToMillicoresFunction,
+		NewSelfLinkFunction,
+		NewWorkloadEndpointFunction,
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -788,6 +789,30 @@ func GetProviderServer() map[string]func() (tfprotov6.ProviderServer, error) {
 	}
 }
 
+// GetEphemeralProviderServer returns the provider factories along with the echo provider, which surfaces ephemeral
+// resource results so that they can be checked during acceptance testing.
+func GetEphemeralProviderServer() map[string]func() (tfprotov6.ProviderServer, error) {
+	// Start from the regular provider factories
+	factories := GetProviderServer()
+
+	// Add the echo provider
+	factories["echo"] = echoprovider.NewProviderServer()
+
+	// Return the provider factories
+	return factories
+}
+
+// BuildImportPlanTestStep returns a test step that imports the resource through an import block and fails unless the
+// plan that follows the import is empty, an empty import ID falls back to the ID held in state.
+func BuildImportPlanTestStep(resourceAddress string, importId string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:    resourceAddress,
+		ImportState:     true,
+		ImportStateKind: resource.ImportBlockWithID,
+		ImportStateId:   importId,
+	}
+}
+
 // MustLoadTestData loads the contents of a file from the testdata directory as a string and fails the test if it cannot be read.
 func MustLoadTestData(filename string) string {
 	// Construct the full file path relative to the testdata directory
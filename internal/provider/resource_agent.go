@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,15 +19,20 @@ import (
 var (
 	_ resource.Resource                = &AgentResource{}
 	_ resource.ResourceWithImportState = &AgentResource{}
+	_ resource.ResourceWithIdentity    = &AgentResource{}
 )
 
+// agentEntityIdentity describes the identity of the agent entity.
+var agentEntityIdentity = EntityIdentity{Kind: "agent"}
+
 /*** Resource Model ***/
 
 // AgentResourceModel holds the Terraform state for the resource.
 type AgentResourceModel struct {
 	EntityBaseModel
-	UserData        types.String `tfsdk:"user_data"`
-	ProtocolVersion types.String `tfsdk:"protocol_version"`
+	UserData        types.String   `tfsdk:"user_data"`
+	ProtocolVersion types.String   `tfsdk:"protocol_version"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -46,10 +52,17 @@ func NewAgentResource() resource.Resource {
 func (ar *AgentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	ar.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	ar.Operations = NewEntityOperations(ar.client, &AgentResourceOperator{})
+	ar.Operations.Identity = &agentEntityIdentity
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (ar *AgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		agentEntityIdentity.ImportState(ctx, req, resp, ar.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -58,6 +71,11 @@ func (ar *AgentResource) Metadata(ctx context.Context, req resource.MetadataRequ
 	resp.TypeName = "cpln_agent"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (ar *AgentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = agentEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (ar *AgentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -78,6 +96,9 @@ func (ar *AgentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": ar.TimeoutsSchema(ctx),
+		},
 	}
 }
 
@@ -151,26 +172,29 @@ func (aro *AgentResourceOperator) MapResponseToState(agent *client.Agent, isCrea
 	// Populate common fields from base resource data
 	state.From(agent.Base)
 
+	// Preserve the configured timeouts
+	state.Timeouts = aro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (aro *AgentResourceOperator) InvokeCreate(req client.Agent) (*client.Agent, int, error) {
-	return aro.Client.CreateAgent(req)
+	return aro.Client.CreateAgent(aro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (aro *AgentResourceOperator) InvokeRead(name string) (*client.Agent, int, error) {
-	return aro.Client.GetAgent(name)
+	return aro.Client.GetAgent(aro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (aro *AgentResourceOperator) InvokeUpdate(req client.Agent) (*client.Agent, int, error) {
-	return aro.Client.UpdateAgent(req)
+	return aro.Client.UpdateAgent(aro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (aro *AgentResourceOperator) InvokeDelete(name string) error {
-	return aro.Client.DeleteAgent(name)
+	return aro.Client.DeleteAgent(aro.Ctx, name)
 }
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -51,6 +52,8 @@ func TestAccControlPlaneAgent_basic(t *testing.T) {
 				ResourceName: resourceName,
 				ImportState:  true,
 			},
+			// Import with an import block and expect an empty plan
+			BuildImportPlanTestStep(resourceName, ""),
 			// Update and Read testing
 			{
 				Config: testAccControlPlaneAgentUpdateWithOptionals(name, description),
@@ -125,7 +128,7 @@ func testAccCheckControlPlaneAgentCheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of agent with name: %s", agentName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		agent, code, err := TestProvider.client.GetAgent(agentName)
+		agent, code, err := TestProvider.client.GetAgent(context.Background(), agentName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -166,7 +169,7 @@ func testAccCheckControlPlaneAgentExists(resourceName string, agentName string,
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteAgent, _, err := TestProvider.client.GetAgent(agentName)
+		remoteAgent, _, err := TestProvider.client.GetAgent(context.Background(), agentName)
 		if err != nil {
 			return fmt.Errorf("error retrieving agent from external system: %w", err)
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,14 +15,19 @@ import (
 var (
 	_ resource.Resource                = &AuditContextResource{}
 	_ resource.ResourceWithImportState = &AuditContextResource{}
+	_ resource.ResourceWithIdentity    = &AuditContextResource{}
 )
 
+// auditContextEntityIdentity describes the identity of the auditctx entity.
+var auditContextEntityIdentity = EntityIdentity{Kind: "auditctx"}
+
 /*** Resource Model ***/
 
 // AuditContextResourceModel holds the Terraform state for the resource.
 type AuditContextResourceModel struct {
 	EntityBaseModel
-	Origin types.String `tfsdk:"origin"`
+	Origin   types.String   `tfsdk:"origin"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -41,10 +47,17 @@ func NewAuditContextResource() resource.Resource {
 func (acr *AuditContextResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	acr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	acr.Operations = NewEntityOperations(acr.client, &AuditContextResourceOperator{})
+	acr.Operations.Identity = &auditContextEntityIdentity
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (acr *AuditContextResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		auditContextEntityIdentity.ImportState(ctx, req, resp, acr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -53,6 +66,11 @@ func (acr *AuditContextResource) Metadata(ctx context.Context, req resource.Meta
 	resp.TypeName = "cpln_audit_context"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (acr *AuditContextResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = auditContextEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (acr *AuditContextResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -62,6 +80,9 @@ func (acr *AuditContextResource) Schema(ctx context.Context, req resource.Schema
 				Computed:    true,
 			},
 		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": acr.TimeoutsSchema(ctx),
+		},
 	}
 }
 
@@ -116,23 +137,26 @@ func (acro *AuditContextResourceOperator) MapResponseToState(auditctx *client.Au
 	// Set origin
 	state.Origin = types.StringPointerValue(auditctx.Origin)
 
+	// Preserve the configured timeouts
+	state.Timeouts = acro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (acro *AuditContextResourceOperator) InvokeCreate(req client.AuditContext) (*client.AuditContext, int, error) {
-	return acro.Client.CreateAuditContext(req)
+	return acro.Client.CreateAuditContext(acro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (acro *AuditContextResourceOperator) InvokeRead(name string) (*client.AuditContext, int, error) {
-	return acro.Client.GetAuditContext(name)
+	return acro.Client.GetAuditContext(acro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (acro *AuditContextResourceOperator) InvokeUpdate(req client.AuditContext) (*client.AuditContext, int, error) {
-	return acro.Client.UpdateAuditContext(req)
+	return acro.Client.UpdateAuditContext(acro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -47,6 +48,8 @@ func TestAccControlPlaneAuditContext_basic(t *testing.T) {
 				ResourceName: resourceName,
 				ImportState:  true,
 			},
+			// Import with an import block and expect an empty plan
+			BuildImportPlanTestStep(resourceName, ""),
 			// Update and Read testing
 			{
 				Config: testAccControlPlaneAuditContextUpdateWithOptionals(name, description),
@@ -110,7 +113,7 @@ func testAccCheckControlPlaneAuditContextExists(resourceName string, auditCtxNam
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteAuditCtx, _, err := TestProvider.client.GetAuditContext(auditCtxName)
+		remoteAuditCtx, _, err := TestProvider.client.GetAuditContext(context.Background(), auditCtxName)
 		if err != nil {
 			return fmt.Errorf("error retrieving audit context from external system: %w", err)
 		}
@@ -7,6 +7,7 @@ import (
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/catalog_template"
 	whitespacestring "github.com/controlplane-com/terraform-provider-cpln/internal/provider/types/whitespacestring"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,8 +31,10 @@ type CatalogTemplateResourceModel struct {
 	Template  types.String                                     `tfsdk:"template"`
 	Version   types.String                                     `tfsdk:"version"`
 	Gvc       types.String                                     `tfsdk:"gvc"`
+	Org       types.String                                     `tfsdk:"org"`
 	Values    whitespacestring.WhitespaceNormalizedStringValue `tfsdk:"values"`
 	Resources types.List                                       `tfsdk:"resources"`
+	Timeouts  timeouts.Value                                   `tfsdk:"timeouts"`
 }
 
 // GetID returns the ID field from the catalog template resource model.
@@ -40,6 +43,12 @@ func (m CatalogTemplateResourceModel) GetID() types.String {
 	return m.ID
 }
 
+// GetOrg returns the org the catalog template is deployed to.
+func (m CatalogTemplateResourceModel) GetOrg() types.String {
+	// Return the stored org value
+	return m.Org
+}
+
 /*** Resource Configuration ***/
 
 // CatalogTemplateResource is the resource implementation.
@@ -108,6 +117,7 @@ func (r *CatalogTemplateResource) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org": r.OrgSchema("catalog template"),
 			"values": schema.StringAttribute{
 				Description: "The values file content (YAML format) for customizing the template deployment.",
 				Required:    true,
@@ -134,6 +144,9 @@ func (r *CatalogTemplateResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": r.TimeoutsSchema(ctx),
+		},
 	}
 }
 
@@ -193,6 +206,9 @@ func (ctro *CatalogTemplateResourceOperator) MapResponseToState(release *client.
 	}
 	state.Resources = ctro.flattenResources(release.Resources)
 
+	// Preserve the configured timeouts
+	state.Timeouts = ctro.Plan.Timeouts
+
 	// Return the populated state model
 	return state
 }
@@ -200,7 +216,7 @@ func (ctro *CatalogTemplateResourceOperator) MapResponseToState(release *client.
 // InvokeCreate invokes the Create API to create a new resource.
 func (ctro *CatalogTemplateResourceOperator) InvokeCreate(req client.MarketplaceRelease) (*client.MarketplaceRelease, int, error) {
 	// Get template details to check if it creates its own GVC
-	template, err := ctro.Client.GetMarketplaceTemplate(req.Template)
+	template, err := ctro.Client.GetMarketplaceTemplate(ctro.Ctx, req.Template)
 	if err != nil {
 		// Return error if template fetch fails
 		return nil, 0, fmt.Errorf("could not get template %s: %w", req.Template, err)
@@ -244,7 +260,7 @@ func (ctro *CatalogTemplateResourceOperator) InvokeCreate(req client.Marketplace
 	}
 
 	// Call the install endpoint to create the release
-	_, err = ctro.Client.InstallMarketplaceRelease(installReq)
+	_, err = ctro.Client.InstallMarketplaceRelease(ctro.Ctx, installReq)
 	if err != nil {
 		return nil, 0, fmt.Errorf("could not install release %s: %s", req.Name, err.Error())
 	}
@@ -265,15 +281,10 @@ func (ctro *CatalogTemplateResourceOperator) InvokeRead(name string) (*client.Ma
 	// Query the backend to get the release information
 	releaseInfo, code, err := ctro.queryRelease(name)
 	if err != nil {
-		// Return error if query fails
+		// Return error if query fails, including the not found error of a release without any revision
 		return nil, code, err
 	}
 
-	// If release doesn't exist, return 404
-	if code == 404 {
-		return nil, code, fmt.Errorf("release %s not found", name)
-	}
-
 	// Return the release info
 	return releaseInfo, code, nil
 }
@@ -293,7 +304,7 @@ func (ctro *CatalogTemplateResourceOperator) InvokeUpdate(req client.Marketplace
 	}
 
 	// Perform the release upgrade
-	_, err := ctro.Client.InstallMarketplaceRelease(upgradeReq)
+	_, err := ctro.Client.InstallMarketplaceRelease(ctro.Ctx, upgradeReq)
 	if err != nil {
 		return nil, 0, fmt.Errorf("could not upgrade release %s: %s", req.Name, err.Error())
 	}
@@ -318,7 +329,7 @@ func (ctro *CatalogTemplateResourceOperator) InvokeDelete(name string) error {
 	}
 
 	// Uninstall the marketplace release
-	_, err := ctro.Client.UninstallMarketplaceRelease(uninstallReq)
+	_, err := ctro.Client.UninstallMarketplaceRelease(ctro.Ctx, uninstallReq)
 	if err != nil {
 		return fmt.Errorf("could not uninstall release %s: %s", name, err.Error())
 	}
@@ -397,7 +408,7 @@ func (ctro *CatalogTemplateResourceOperator) queryRelease(releaseName string) (*
 	}
 
 	// Query Control Plane API for helm release secrets
-	releaseInfo, code, err := ctro.Client.GetMarketplaceRelease(releaseName, query)
+	releaseInfo, code, err := ctro.Client.GetMarketplaceRelease(ctro.Ctx, releaseName, query)
 	if err != nil {
 		// Return error if query fails
 		return nil, code, fmt.Errorf("could not query release %s: %w", releaseName, err)
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -112,7 +113,7 @@ func (ctrt *CatalogTemplateResourceTest) CheckDestroy(s *terraform.State) error
 		}
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		catalogTemplate, code, err := TestProvider.client.GetMarketplaceRelease(catalogTemplateName, query)
+		catalogTemplate, code, err := TestProvider.client.GetMarketplaceRelease(context.Background(), catalogTemplateName, query)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -164,6 +165,8 @@ func (ctrt *CatalogTemplateResourceTest) NewWithGvcScenario() []resource.TestSte
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -195,6 +198,8 @@ func (ctrt *CatalogTemplateResourceTest) NewWithoutGvcScenario() []resource.Test
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -447,7 +452,7 @@ func (ctrtc *CatalogTemplateResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteCatalogTemplate, _, err := TestProvider.client.GetMarketplaceRelease(ctrtc.Name, query)
+		remoteCatalogTemplate, _, err := TestProvider.client.GetMarketplaceRelease(context.Background(), ctrtc.Name, query)
 		if err != nil {
 			return fmt.Errorf("error retrieving catalog template from external system: %w", err)
 		}
@@ -6,6 +6,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/cloud_account"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -23,20 +24,25 @@ import (
 var (
 	_ resource.Resource                = &CloudAccountResource{}
 	_ resource.ResourceWithImportState = &CloudAccountResource{}
+	_ resource.ResourceWithIdentity    = &CloudAccountResource{}
 )
 
+// cloudAccountEntityIdentity describes the identity of the cloudaccount entity.
+var cloudAccountEntityIdentity = EntityIdentity{Kind: "cloudaccount"}
+
 /*** Resource Model ***/
 
 // CloudAccountResourceModel holds the Terraform state for the resource.
 type CloudAccountResourceModel struct {
 	EntityBaseModel
-	Aws                   types.List   `tfsdk:"aws"`
-	Azure                 types.List   `tfsdk:"azure"`
-	Gcp                   types.List   `tfsdk:"gcp"`
-	Ngs                   types.List   `tfsdk:"ngs"`
-	GcpServiceAccountName types.String `tfsdk:"gcp_service_account_name"`
-	GcpRoles              types.Set    `tfsdk:"gcp_roles"`
-	Status                types.List   `tfsdk:"status"`
+	Aws                   types.List     `tfsdk:"aws"`
+	Azure                 types.List     `tfsdk:"azure"`
+	Gcp                   types.List     `tfsdk:"gcp"`
+	Ngs                   types.List     `tfsdk:"ngs"`
+	GcpServiceAccountName types.String   `tfsdk:"gcp_service_account_name"`
+	GcpRoles              types.Set      `tfsdk:"gcp_roles"`
+	Status                types.List     `tfsdk:"status"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -56,10 +62,17 @@ func NewCloudAccountResource() resource.Resource {
 func (car *CloudAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	car.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	car.Operations = NewEntityOperations(car.client, &CloudAccountResourceOperator{})
+	car.Operations.Identity = &cloudAccountEntityIdentity
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (car *CloudAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		cloudAccountEntityIdentity.ImportState(ctx, req, resp, car.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -68,6 +81,11 @@ func (car *CloudAccountResource) Metadata(ctx context.Context, req resource.Meta
 	resp.TypeName = "cpln_cloud_account"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (car *CloudAccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = cloudAccountEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (car *CloudAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -202,6 +220,7 @@ func (car *CloudAccountResource) Schema(ctx context.Context, req resource.Schema
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"timeouts": car.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -328,28 +347,31 @@ func (caro *CloudAccountResourceOperator) MapResponseToState(cloudAccount *clien
 	state.GcpRoles = FlattenSetString(&GcpRoles)
 	state.Status = caro.flattenStatus(cloudAccount)
 
+	// Preserve the configured timeouts
+	state.Timeouts = caro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (caro *CloudAccountResourceOperator) InvokeCreate(req client.CloudAccount) (*client.CloudAccount, int, error) {
-	return caro.Client.CreateCloudAccount(req)
+	return caro.Client.CreateCloudAccount(caro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (caro *CloudAccountResourceOperator) InvokeRead(name string) (*client.CloudAccount, int, error) {
-	return caro.Client.GetCloudAccount(name)
+	return caro.Client.GetCloudAccount(caro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (caro *CloudAccountResourceOperator) InvokeUpdate(req client.CloudAccount) (*client.CloudAccount, int, error) {
-	return caro.Client.UpdateCloudAccount(req)
+	return caro.Client.UpdateCloudAccount(caro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (caro *CloudAccountResourceOperator) InvokeDelete(name string) error {
-	return caro.Client.DeleteCloudAccount(name)
+	return caro.Client.DeleteCloudAccount(caro.Ctx, name)
 }
 
 // getCloudAccountProviderName determines the provider name based on the non-null and known state of cloud provider attributes.
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -120,6 +121,11 @@ func TestAccControlPlaneCloudAccount_basic(t *testing.T) {
 				ResourceName: ngs.resourceName,
 				ImportState:  true,
 			},
+			// Import with an import block and expect an empty plan
+			BuildImportPlanTestStep(aws.resourceName, ""),
+			BuildImportPlanTestStep(azure.resourceName, ""),
+			BuildImportPlanTestStep(gcp.resourceName, ""),
+			BuildImportPlanTestStep(ngs.resourceName, ""),
 			// Update and Read testing
 			{
 				Config: testAccControlPlaneCloudAccountUpdateWithOptionals(description, aws, azure, gcp, ngs),
@@ -353,7 +359,7 @@ func testAccCheckControlPlaneCloudAccountCheckDestroy(s *terraform.State) error
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of cloud account with name: %s", cloudAccountName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		cloudAccount, code, err := TestProvider.client.GetCloudAccount(cloudAccountName)
+		cloudAccount, code, err := TestProvider.client.GetCloudAccount(context.Background(), cloudAccountName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -394,7 +400,7 @@ func testAccCheckControlPlaneCloudAccountExists(resourceName string, cloudAccoun
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteCloudAccount, _, err := TestProvider.client.GetCloudAccount(cloudAccountName)
+		remoteCloudAccount, _, err := TestProvider.client.GetCloudAccount(context.Background(), cloudAccountName)
 		if err != nil {
 			return fmt.Errorf("error retrieving cloud account from external system: %w", err)
 		}
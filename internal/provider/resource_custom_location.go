@@ -5,6 +5,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/location"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,19 +21,24 @@ import (
 var (
 	_ resource.Resource                = &CustomLocationResource{}
 	_ resource.ResourceWithImportState = &CustomLocationResource{}
+	_ resource.ResourceWithIdentity    = &CustomLocationResource{}
 )
 
+// customLocationEntityIdentity describes the identity of the location entity.
+var customLocationEntityIdentity = EntityIdentity{Kind: "location"}
+
 /*** Resource Model ***/
 
 // CustomLocationResourceModel holds the Terraform state for the resource.
 type CustomLocationResourceModel struct {
 	EntityBaseModel
-	Origin        types.String `tfsdk:"origin"`
-	CloudProvider types.String `tfsdk:"cloud_provider"`
-	Region        types.String `tfsdk:"region"`
-	Enabled       types.Bool   `tfsdk:"enabled"`
-	Geo           types.List   `tfsdk:"geo"`
-	IpRanges      types.Set    `tfsdk:"ip_ranges"`
+	Origin        types.String   `tfsdk:"origin"`
+	CloudProvider types.String   `tfsdk:"cloud_provider"`
+	Region        types.String   `tfsdk:"region"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	Geo           types.List     `tfsdk:"geo"`
+	IpRanges      types.Set      `tfsdk:"ip_ranges"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -52,10 +58,17 @@ func NewCustomLocationResource() resource.Resource {
 func (clr *CustomLocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	clr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	clr.Operations = NewEntityOperations(clr.client, &CustomLocationResourceOperator{})
+	clr.Operations.Identity = &customLocationEntityIdentity
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (clr *CustomLocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		customLocationEntityIdentity.ImportState(ctx, req, resp, clr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -64,6 +77,11 @@ func (clr *CustomLocationResource) Metadata(ctx context.Context, req resource.Me
 	resp.TypeName = "cpln_custom_location"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (clr *CustomLocationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = customLocationEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (clr *CustomLocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -133,6 +151,9 @@ func (clr *CustomLocationResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": clr.TimeoutsSchema(ctx),
+		},
 	}
 }
 
@@ -204,28 +225,31 @@ func (clro *CustomLocationResourceOperator) MapResponseToState(location *client.
 		state.IpRanges = types.SetNull(types.StringType)
 	}
 
+	// Preserve the configured timeouts
+	state.Timeouts = clro.Plan.Timeouts
+
 	// Return the built state
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (clro *CustomLocationResourceOperator) InvokeCreate(req client.Location) (*client.Location, int, error) {
-	return clro.Client.CreateCustomLocation(req)
+	return clro.Client.CreateCustomLocation(clro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (clro *CustomLocationResourceOperator) InvokeRead(name string) (*client.Location, int, error) {
-	return clro.Client.GetLocation(name)
+	return clro.Client.GetLocation(clro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (clro *CustomLocationResourceOperator) InvokeUpdate(req client.Location) (*client.Location, int, error) {
-	return clro.Client.UpdateLocation(req)
+	return clro.Client.UpdateLocation(clro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (clro *CustomLocationResourceOperator) InvokeDelete(name string) error {
-	return clro.Client.DeleteCustomLocation(name)
+	return clro.Client.DeleteCustomLocation(clro.Ctx, name)
 }
 
 // Flatteners //
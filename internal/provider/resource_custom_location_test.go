@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -84,6 +85,9 @@ func TestAccControlPlaneCustomLocation_basic(t *testing.T) {
 				ResourceName: byokDisabled.resourceName,
 				ImportState:  true,
 			},
+			// Import with an import block and expect an empty plan
+			BuildImportPlanTestStep(byokEnabled.resourceName, ""),
+			BuildImportPlanTestStep(byokDisabled.resourceName, ""),
 			// Update and Read testing
 			{
 				Config: testAccControlPlaneCustomLocationUpdate(description, byokEnabled, byokDisabled),
@@ -220,7 +224,7 @@ func testAccCheckControlPlaneCustomLocationCheckDestroy(s *terraform.State) erro
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of custom location with name: %s", customLocationName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		customLocation, code, err := TestProvider.client.GetLocation(customLocationName)
+		customLocation, code, err := TestProvider.client.GetLocation(context.Background(), customLocationName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -261,7 +265,7 @@ func testAccCheckControlPlaneCustomLocationExists(resourceName string, customLoc
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteCustomLocation, _, err := TestProvider.client.GetLocation(customLocationName)
+		remoteCustomLocation, _, err := TestProvider.client.GetLocation(context.Background(), customLocationName)
 		if err != nil {
 			return fmt.Errorf("error retrieving custom location from external system: %w", err)
 		}
@@ -7,6 +7,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/domain"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -32,15 +33,20 @@ var (
 	_ resource.ResourceWithImportState    = &DomainResource{}
 	_ resource.ResourceWithModifyPlan     = &DomainResource{}
 	_ resource.ResourceWithValidateConfig = &DomainResource{}
+	_ resource.ResourceWithIdentity       = &DomainResource{}
 )
 
+// domainEntityIdentity describes the identity of the domain entity.
+var domainEntityIdentity = EntityIdentity{Kind: "domain"}
+
 /*** Resource Model ***/
 
 // DomainResourceModel holds the Terraform state for the resource.
 type DomainResourceModel struct {
 	EntityBaseModel
-	Spec   types.List `tfsdk:"spec"`
-	Status types.List `tfsdk:"status"`
+	Spec     types.List     `tfsdk:"spec"`
+	Status   types.List     `tfsdk:"status"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -60,10 +66,20 @@ func NewDomainResource() resource.Resource {
 func (dr *DomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	dr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	dr.Operations = NewEntityOperations(dr.client, &DomainResourceOperator{})
+	dr.Operations.Identity = &domainEntityIdentity
+
+	// Routes are also managed by the cpln_domain_route resource
+	dr.Operations.DriftIgnoredPaths = []string{"spec.ports[*].routes"}
+
+	// The spec is configured through a block of the same name
+	dr.Operations.APIFieldAttributes = map[string]string{"spec": "spec"}
 }
 
 // ModifyPlan handles plan modifications.
 func (r *DomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Fail the plan if the entity was changed outside of Terraform
+	r.CheckExternalChanges(ctx, req, resp)
+
 	// If no existing state or plan provided, skip further processing
 	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		return
@@ -151,6 +167,12 @@ func (dr *DomainResource) ValidateConfig(ctx context.Context, req resource.Valid
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (dr *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		domainEntityIdentity.ImportState(ctx, req, resp, dr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -159,6 +181,11 @@ func (dr *DomainResource) Metadata(ctx context.Context, req resource.MetadataReq
 	resp.TypeName = "cpln_domain"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (dr *DomainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = domainEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (dr *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -538,6 +565,7 @@ func (dr *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"timeouts": dr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -554,6 +582,10 @@ func (dr *DomainResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update modifies the resource.
 func (dr *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Bound the update operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Update, DefaultUpdateTimeout)
+	defer cancel()
+
 	// Read plan and prior state
 	var plan DomainResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -593,6 +625,14 @@ func (dr *DomainResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	// Persist the org the domain was updated in
+	SetStateOrg(ctx, &resp.Diagnostics, &resp.State, dr.Operations.OrgOf(plan))
+
+	// Remember the written object to detect changes made outside of Terraform
+	if resp.Private != nil {
+		dr.Operations.RecordDriftBaseline(ctx, &resp.Diagnostics, resp.Private, apiResp)
+	}
 }
 
 // Delete removes the resource.
@@ -671,28 +711,31 @@ func (dro *DomainResourceOperator) MapResponseToState(domain *client.Domain, isC
 	state.Spec = dro.flattenSpec(domain.Spec)
 	state.Status = dro.flattenStatus(domain.Status)
 
+	// Preserve the configured timeouts
+	state.Timeouts = dro.Plan.Timeouts
+
 	// Return the built state
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (dro *DomainResourceOperator) InvokeCreate(req client.Domain) (*client.Domain, int, error) {
-	return dro.Client.CreateDomain(req)
+	return dro.Client.CreateDomain(dro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (dro *DomainResourceOperator) InvokeRead(name string) (*client.Domain, int, error) {
-	return dro.Client.GetDomain(name)
+	return dro.Client.GetDomain(dro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (dro *DomainResourceOperator) InvokeUpdate(req client.Domain) (*client.Domain, int, error) {
-	return dro.Client.UpdateDomain(req)
+	return dro.Client.UpdateDomain(dro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (dro *DomainResourceOperator) InvokeDelete(name string) error {
-	return dro.Client.DeleteDomain(name)
+	return dro.Client.DeleteDomain(dro.Ctx, name)
 }
 
 // Builders //
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -33,20 +34,22 @@ var (
 
 // DomainRouteResourceModel holds the Terraform state for the resource.
 type DomainRouteResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	DomainLink    types.String `tfsdk:"domain_link"`
-	DomainPort    types.Int32  `tfsdk:"domain_port"`
-	Prefix        types.String `tfsdk:"prefix"`
-	ReplacePrefix types.String `tfsdk:"replace_prefix"`
-	Regex         types.String `tfsdk:"regex"`
-	WorkloadLink  types.String `tfsdk:"workload_link"`
-	Port          types.Int32  `tfsdk:"port"`
-	HostPrefix    types.String `tfsdk:"host_prefix"`
-	HostRegex     types.String `tfsdk:"host_regex"`
-	Headers       types.List   `tfsdk:"headers"`
-	Replica       types.Int32  `tfsdk:"replica"`
-	Mirror        types.List   `tfsdk:"mirror"`
-	Canary        types.List   `tfsdk:"canary"`
+	ID            types.String   `tfsdk:"id"`
+	DomainLink    types.String   `tfsdk:"domain_link"`
+	DomainPort    types.Int32    `tfsdk:"domain_port"`
+	Prefix        types.String   `tfsdk:"prefix"`
+	ReplacePrefix types.String   `tfsdk:"replace_prefix"`
+	Regex         types.String   `tfsdk:"regex"`
+	WorkloadLink  types.String   `tfsdk:"workload_link"`
+	Port          types.Int32    `tfsdk:"port"`
+	HostPrefix    types.String   `tfsdk:"host_prefix"`
+	HostRegex     types.String   `tfsdk:"host_regex"`
+	Headers       types.List     `tfsdk:"headers"`
+	Replica       types.Int32    `tfsdk:"replica"`
+	Mirror        types.List     `tfsdk:"mirror"`
+	Canary        types.List     `tfsdk:"canary"`
+	Org           types.String   `tfsdk:"org"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -68,7 +71,11 @@ func (drr *DomainRouteResource) Configure(ctx context.Context, req resource.Conf
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (drr *DomainRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Split the import ID
+	// Describe the accepted format for the error messages below
+	const expectedFormat = "Expected import identifier with format: 'DOMAIN:DOMAIN_PORT:[PREFIX|REGEX]', " +
+		"where DOMAIN is a domain name (e.g., 'example.com') or a domain self link (e.g., '/org/ORG_NAME/domain/example.com')"
+
+	// Split the import ID, the prefix or regex may itself contain the separator
 	parts := strings.SplitN(req.ID, ":", 3)
 
 	// Validate that ID has exactly three non-empty segments
@@ -76,10 +83,7 @@ func (drr *DomainRouteResource) ImportState(ctx context.Context, req resource.Im
 		// Report error when import identifier format is unexpected
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf(
-				"Expected import identifier with format: "+
-					"'domain_link:domain_port:[PREFIX|REGEX]'. Got: %q", req.ID,
-			),
+			fmt.Sprintf("%s. Got: %q", expectedFormat, req.ID),
 		)
 
 		// Abort import operation on error
@@ -92,15 +96,12 @@ func (drr *DomainRouteResource) ImportState(ctx context.Context, req resource.Im
 	// Convert domainPortStr to integer
 	portInt, err := strconv.Atoi(domainPortStr)
 
-	// Handle error when port conversion fails
-	if err != nil {
+	// Handle error when port conversion fails or the port is out of range
+	if err != nil || portInt < 1 || portInt > 65535 {
 		// Report error for invalid port value in identifier
 		resp.Diagnostics.AddError(
-			"Invalid Import Identifier",
-			fmt.Sprintf(
-				"domain_port must be an integer; got %q (error: %s)",
-				domainPortStr, err.Error(),
-			),
+			"Unexpected Import Identifier",
+			fmt.Sprintf("DOMAIN_PORT must be a port number between 1 and 65535, got %q. %s.", domainPortStr, expectedFormat),
 		)
 
 		// Abort import operation on error
@@ -110,12 +111,33 @@ func (drr *DomainRouteResource) ImportState(ctx context.Context, req resource.Im
 	// Cast portInt to int for state attribute
 	domainPort := int(portInt)
 
-	// Normalize domainLink to a full self-link if the user provided a domain name instead of a link
-	if !strings.HasPrefix(domainLink, "/org/") && !strings.HasPrefix(domainLink, "//") {
-		// Construct the full domain link from the provided domain name
-		if drr.client != nil {
-			domainLink = GetSelfLink(drr.client.Org, "domain", domainLink)
+	// Resolve the org the domain must belong to
+	var org string
+	if drr.client != nil {
+		org = drr.client.Org
+	}
+
+	// Validate a domain self link, or normalize a domain name to a full self link
+	if strings.HasPrefix(domainLink, "/") {
+		// Ensure the link references a domain
+		parts, err := ParseSelfLinkOfKind(domainLink, "domain")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Invalid domain self link: %s. %s.", err.Error(), expectedFormat),
+			)
+
+			// Abort import operation on error
+			return
+		}
+
+		// Manage the route within the org the link references, if any
+		if parts.Org != "" {
+			org = parts.Org
 		}
+	} else if drr.client != nil {
+		// Construct the full domain link from the provided domain name
+		domainLink = GetSelfLink(org, "domain", domainLink)
 	}
 
 	// Flag to indicate if route is regex-based
@@ -124,17 +146,17 @@ func (drr *DomainRouteResource) ImportState(ctx context.Context, req resource.Im
 	// Check if API client is available before fetching domain details
 	if drr.client != nil {
 		// Retrieve domain details and status from API
-		dom, status, err := drr.client.GetDomain(GetNameFromSelfLink(domainLink))
+		dom, _, err := drr.client.WithOrg(org).GetDomain(ctx, GetNameFromSelfLink(domainLink))
 
-		// Report error if API call to fetch domain fails
-		if err != nil {
-			resp.Diagnostics.AddError("Error fetching domain", err.Error())
+		// Report error if domain is not found
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("domain_link"), "Domain not found", fmt.Sprintf("Domain '%s' not found", domainLink))
 			return
 		}
 
-		// Report error if domain is not found
-		if status == 404 {
-			resp.Diagnostics.AddError("Domain not found", fmt.Sprintf("Domain '%s' not found", domainLink))
+		// Report error if API call to fetch domain fails
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching domain", err.Error())
 			return
 		}
 
@@ -180,6 +202,13 @@ func (drr *DomainRouteResource) ImportState(ctx context.Context, req resource.Im
 		resp.State.SetAttribute(ctx, path.Root("domain_port"), types.Int32Value(int32(domainPort)))...,
 	)
 
+	// Set the org attribute in the Terraform state when it is known
+	if org != "" {
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("org"), types.StringValue(org))...,
+		)
+	}
+
 	// Set the regex or prefix attribute based on isRegex flag
 	if isRegex {
 		// Assign regex attribute when route is regex-based
@@ -218,6 +247,15 @@ func (drr *DomainRouteResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org": schema.StringAttribute{
+				Description: "The org the domain belongs to. Defaults to the org of the domain self link, then to the org configured in the provider when the resource is created. Existing resources keep the org recorded in the state when the provider org changes. Changing it replaces the resource.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"domain_port": schema.Int32Attribute{
 				Description: "The port the route corresponds to. Default: 443",
 				Optional:    true,
@@ -342,6 +380,7 @@ func (drr *DomainRouteResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 				},
 			},
+			"timeouts": drr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -356,6 +395,10 @@ func (drr *DomainRouteResource) ConfigValidators(ctx context.Context) []resource
 
 // Create creates the resource.
 func (drr *DomainRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Bound the create operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Create, DefaultCreateTimeout)
+	defer cancel()
+
 	var plannedState DomainRouteResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -380,8 +423,11 @@ func (drr *DomainRouteResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Target the org the domain belongs to
+	orgClient := drr.orgClient(plannedState)
+
 	// Send the create request to the API client
-	responsePayload, _, err := drr.client.AddDomainRoute(domainName, domainPort, route)
+	responsePayload, _, err := orgClient.AddDomainRoute(ctx, domainName, domainPort, route)
 
 	// Handle any other errors that occurred during the API request
 	if err != nil {
@@ -390,7 +436,7 @@ func (drr *DomainRouteResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// Map the API response to the Terraform state
-	finalState := drr.buildState(ctx, &resp.Diagnostics, plannedState, plannedState.DomainLink.ValueString(), domainPort, responsePayload)
+	finalState := drr.buildState(ctx, &resp.Diagnostics, plannedState, orgClient.Org, plannedState.DomainLink.ValueString(), domainPort, responsePayload)
 
 	// Return if an error has occurred during the state creation
 	if resp.Diagnostics.HasError() {
@@ -403,6 +449,10 @@ func (drr *DomainRouteResource) Create(ctx context.Context, req resource.CreateR
 
 // Read fetches the current state of the resource.
 func (drr *DomainRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Bound the read operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Read, DefaultReadTimeout)
+	defer cancel()
+
 	var plannedState DomainRouteResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -417,12 +467,15 @@ func (drr *DomainRouteResource) Read(ctx context.Context, req resource.ReadReque
 	domainLink := plannedState.DomainLink.ValueString()
 	domainPort := int(plannedState.DomainPort.ValueInt32())
 
+	// Target the org the domain belongs to
+	orgClient := drr.orgClient(plannedState)
+
 	// Fetch the domain route
-	responsePayload, code, err := drr.client.GetDomainRoute(GetNameFromSelfLink(domainLink), domainPort, plannedState.Prefix.ValueStringPointer(), plannedState.Regex.ValueStringPointer())
+	responsePayload, _, err := orgClient.GetDomainRoute(ctx, GetNameFromSelfLink(domainLink), domainPort, plannedState.Prefix.ValueStringPointer(), plannedState.Regex.ValueStringPointer())
 
 	// Handle the case where the route is not found (HTTP 404),
 	// indicating it has been deleted outside of Terraform. Remove it from state
-	if code == 404 {
+	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -434,7 +487,7 @@ func (drr *DomainRouteResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Map the API response to the Terraform state
-	finalState := drr.buildState(ctx, &resp.Diagnostics, plannedState, domainLink, domainPort, responsePayload)
+	finalState := drr.buildState(ctx, &resp.Diagnostics, plannedState, orgClient.Org, domainLink, domainPort, responsePayload)
 
 	// Return if an error has occurred during the state creation
 	if resp.Diagnostics.HasError() {
@@ -447,6 +500,10 @@ func (drr *DomainRouteResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update modifies the resource.
 func (drr *DomainRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Bound the update operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Update, DefaultUpdateTimeout)
+	defer cancel()
+
 	var plannedState DomainRouteResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -471,8 +528,11 @@ func (drr *DomainRouteResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// Target the org the domain belongs to
+	orgClient := drr.orgClient(plannedState)
+
 	// Send the update request to the API with the modified data
-	responsePayload, _, err := drr.client.UpdateDomainRoute(domainName, domainPort, &route)
+	responsePayload, _, err := orgClient.UpdateDomainRoute(ctx, domainName, domainPort, &route)
 
 	// Handle errors from the API update request
 	if err != nil {
@@ -481,7 +541,7 @@ func (drr *DomainRouteResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Map the API response to the Terraform finalState
-	finalState := drr.buildState(ctx, &resp.Diagnostics, plannedState, plannedState.DomainLink.ValueString(), domainPort, responsePayload)
+	finalState := drr.buildState(ctx, &resp.Diagnostics, plannedState, orgClient.Org, plannedState.DomainLink.ValueString(), domainPort, responsePayload)
 
 	// Return if an error has occurred during the state creation
 	if resp.Diagnostics.HasError() {
@@ -494,6 +554,10 @@ func (drr *DomainRouteResource) Update(ctx context.Context, req resource.UpdateR
 
 // Delete removes the resource.
 func (drr *DomainRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Bound the delete operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Delete, DefaultDeleteTimeout)
+	defer cancel()
+
 	var state DomainRouteResourceModel
 
 	// Retrieve the state from the Terraform configuration
@@ -511,7 +575,7 @@ func (drr *DomainRouteResource) Delete(ctx context.Context, req resource.DeleteR
 	defer mu.Unlock()
 
 	// Send a delete request to the API using the name from the state
-	err := drr.client.RemoveDomainRoute(domainName, int(state.DomainPort.ValueInt32()), state.Prefix.ValueStringPointer(), state.Regex.ValueStringPointer())
+	err := drr.orgClient(state).RemoveDomainRoute(ctx, domainName, int(state.DomainPort.ValueInt32()), state.Prefix.ValueStringPointer(), state.Regex.ValueStringPointer())
 
 	// Handle errors from the API delete request
 	if err != nil {
@@ -526,6 +590,22 @@ func (drr *DomainRouteResource) Delete(ctx context.Context, req resource.DeleteR
 
 /*** Helpers ***/
 
+// orgClient returns a client targeting the org of the route: the configured one, then the one of the domain self link.
+func (drr *DomainRouteResource) orgClient(plan DomainRouteResourceModel) *client.Client {
+	// Prefer the org that was set explicitly
+	org := plan.Org.ValueString()
+
+	// Fall back to the org the domain self link references
+	if org == "" {
+		if parts, err := ParseSelfLink(plan.DomainLink.ValueString()); err == nil {
+			org = parts.Org
+		}
+	}
+
+	// Return a client for the resolved org, which defaults to the provider org
+	return drr.client.WithOrg(org)
+}
+
 // buildRequest creates a request payload from a state model.
 func (drr *DomainRouteResource) buildRequest(ctx context.Context, diags *diag.Diagnostics, plan DomainRouteResourceModel) (string, int, client.DomainRoute) {
 	// Initialize a new request payload
@@ -549,7 +629,7 @@ func (drr *DomainRouteResource) buildRequest(ctx context.Context, diags *diag.Di
 }
 
 // buildState creates a state model from response payload.
-func (drr *DomainRouteResource) buildState(ctx context.Context, diags *diag.Diagnostics, plan DomainRouteResourceModel, domainLink string, domainPort int, route *client.DomainRoute) DomainRouteResourceModel {
+func (drr *DomainRouteResource) buildState(ctx context.Context, diags *diag.Diagnostics, plan DomainRouteResourceModel, org string, domainLink string, domainPort int, route *client.DomainRoute) DomainRouteResourceModel {
 	// Initialize empty state model
 	state := DomainRouteResourceModel{}
 
@@ -599,14 +679,18 @@ func (drr *DomainRouteResource) buildState(ctx context.Context, diags *diag.Diag
 	state.Prefix = types.StringPointerValue(route.Prefix)
 	state.ReplacePrefix = types.StringPointerValue(route.ReplacePrefix)
 	state.Regex = types.StringPointerValue(route.Regex)
-	state.WorkloadLink = FlattenLinkString(plan.WorkloadLink, route.WorkloadLink, drr.client.Org)
+	state.WorkloadLink = FlattenLinkString(plan.WorkloadLink, route.WorkloadLink, org)
 	state.Port = FlattenInt(route.Port)
 	state.HostPrefix = types.StringPointerValue(route.HostPrefix)
 	state.HostRegex = types.StringPointerValue(route.HostRegex)
 	state.Headers = FlattenRouteHeaders(ctx, diags, route.Headers)
 	state.Replica = FlattenInt(route.Replica)
-	state.Mirror = FlattenRouteMirror(ctx, diags, plan.Mirror, route.Mirror, drr.client.Org)
-	state.Canary = FlattenRouteCanary(ctx, diags, plan.Canary, route.Canaries, drr.client.Org)
+	state.Mirror = FlattenRouteMirror(ctx, diags, plan.Mirror, route.Mirror, org)
+	state.Canary = FlattenRouteCanary(ctx, diags, plan.Canary, route.Canaries, org)
+	state.Org = types.StringValue(org)
+
+	// Preserve the configured timeouts
+	state.Timeouts = plan.Timeouts
 
 	// Return completed state model
 	return state
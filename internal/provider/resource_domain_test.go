@@ -1,11 +1,15 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"testing"
 
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
@@ -95,7 +99,7 @@ func (drt *DomainResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of domain with name: %s", domainName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		domain, code, err := TestProvider.client.GetDomain(domainName)
+		domain, code, err := TestProvider.client.GetDomain(context.Background(), domainName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -151,6 +155,8 @@ func (drt *DomainResourceTest) NewDefaultScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -176,6 +182,8 @@ func (drt *DomainResourceTest) NewDefaultScenario() []resource.TestStep {
 			ImportState:   true,
 			ImportStateId: fmt.Sprintf("%s:443:/user/.*/profile", subDomainSelfLink),
 		},
+		// Import a domain route with an import block and expect an empty plan
+		BuildImportPlanTestStep("cpln_domain_route.first-route", fmt.Sprintf("%s:443:/first", subDomainSelfLink)),
 		// Domain Route Import (using domain name instead of full link)
 		// Verifies that bare domain names are normalized to full self-links,
 		// preventing a RequiresReplace diff on the next plan.
@@ -2979,7 +2987,7 @@ func (drtc *DomainResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteDomain, _, err := TestProvider.client.GetDomain(drtc.Name)
+		remoteDomain, _, err := TestProvider.client.GetDomain(context.Background(), drtc.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving domain from external system: %w", err)
 		}
@@ -2999,3 +3007,49 @@ func (drtc *DomainResourceTestCase) Exists() resource.TestCheckFunc {
 type DomainRouteResourceTestCase struct {
 	ProviderTestCase
 }
+
+/*** Unit Tests ***/
+
+// TestDomainRouteImportStateErrors verifies malformed domain route import identifiers are rejected with a clear error.
+func TestDomainRouteImportStateErrors(t *testing.T) {
+	ctx := context.Background()
+	drr := &DomainRouteResource{}
+
+	// Read the schema of the resource to build an empty state
+	schemaResp := &frameworkresource.SchemaResponse{}
+	drr.Schema(ctx, frameworkresource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	// Define the table of cases
+	cases := []struct {
+		name       string
+		id         string
+		wantDetail string
+	}{
+		{name: "missing prefix", id: "example.com:443", wantDetail: "DOMAIN:DOMAIN_PORT:[PREFIX|REGEX]"},
+		{name: "empty port", id: "example.com::/", wantDetail: "DOMAIN:DOMAIN_PORT:[PREFIX|REGEX]"},
+		{name: "non numeric port", id: "example.com:https:/", wantDetail: "DOMAIN_PORT must be a port number"},
+		{name: "port out of range", id: "example.com:70000:/", wantDetail: "DOMAIN_PORT must be a port number"},
+		{name: "self link of another kind", id: "/org/my-org/gvc/example:443:/", wantDetail: "Invalid domain self link"},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &frameworkresource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+
+			// Import the identifier
+			drr.ImportState(ctx, frameworkresource.ImportStateRequest{ID: tc.id}, resp)
+
+			// Verify the error
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected an error for %q", tc.id)
+			}
+
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, tc.wantDetail) {
+				t.Fatalf("error detail %q does not contain %q", detail, tc.wantDetail)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/group"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,18 +24,23 @@ import (
 var (
 	_ resource.Resource                = &GroupResource{}
 	_ resource.ResourceWithImportState = &GroupResource{}
+	_ resource.ResourceWithIdentity    = &GroupResource{}
 )
 
+// groupEntityIdentity describes the identity of the group entity.
+var groupEntityIdentity = EntityIdentity{Kind: "group"}
+
 /*** Resource Model ***/
 
 // GroupResourceModel holds the Terraform state for the resource.
 type GroupResourceModel struct {
 	EntityBaseModel
-	UserIdsAndEmails types.Set    `tfsdk:"user_ids_and_emails"`
-	ServiceAccounts  types.Set    `tfsdk:"service_accounts"`
-	MemberQuery      types.List   `tfsdk:"member_query"`
-	IdentityMatcher  types.List   `tfsdk:"identity_matcher"`
-	Origin           types.String `tfsdk:"origin"`
+	UserIdsAndEmails types.Set      `tfsdk:"user_ids_and_emails"`
+	ServiceAccounts  types.Set      `tfsdk:"service_accounts"`
+	MemberQuery      types.List     `tfsdk:"member_query"`
+	IdentityMatcher  types.List     `tfsdk:"identity_matcher"`
+	Origin           types.String   `tfsdk:"origin"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -54,10 +60,17 @@ func NewGroupResource() resource.Resource {
 func (gr *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	gr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	gr.Operations = NewEntityOperations(gr.client, &GroupResourceOperator{})
+	gr.Operations.Identity = &groupEntityIdentity
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (gr *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		groupEntityIdentity.ImportState(ctx, req, resp, gr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -66,6 +79,11 @@ func (gr *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequ
 	resp.TypeName = "cpln_group"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (gr *GroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = groupEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (gr *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -119,6 +137,7 @@ func (gr *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"timeouts": gr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -181,28 +200,31 @@ func (gro *GroupResourceOperator) MapResponseToState(group *client.Group, isCrea
 	state.IdentityMatcher = gro.flattenIdentityMatcher(group.IdentityMatcher)
 	state.Origin = types.StringPointerValue(group.Origin)
 
+	// Preserve the configured timeouts
+	state.Timeouts = gro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (gro *GroupResourceOperator) InvokeCreate(req client.Group) (*client.Group, int, error) {
-	return gro.Client.CreateGroup(req)
+	return gro.Client.CreateGroup(gro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (gro *GroupResourceOperator) InvokeRead(name string) (*client.Group, int, error) {
-	return gro.Client.GetGroup(name)
+	return gro.Client.GetGroup(gro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (gro *GroupResourceOperator) InvokeUpdate(req client.Group) (*client.Group, int, error) {
-	return gro.Client.UpdateGroup(req)
+	return gro.Client.UpdateGroup(gro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (gro *GroupResourceOperator) InvokeDelete(name string) error {
-	return gro.Client.DeleteGroup(name)
+	return gro.Client.DeleteGroup(gro.Ctx, name)
 }
 
 // Builders //
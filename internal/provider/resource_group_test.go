@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -78,7 +79,7 @@ func (grt *GroupResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of group with name: %s", groupName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		group, code, err := TestProvider.client.GetGroup(groupName)
+		group, code, err := TestProvider.client.GetGroup(context.Background(), groupName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -143,6 +144,8 @@ func (grt *GroupResourceTest) NewDefaultScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -420,7 +423,7 @@ func (grtc *GroupResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteGroup, _, err := TestProvider.client.GetGroup(grtc.Name)
+		remoteGroup, _, err := TestProvider.client.GetGroup(context.Background(), grtc.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving group from external system: %w", err)
 		}
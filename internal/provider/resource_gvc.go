@@ -10,6 +10,7 @@ import (
 	commonmodels "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/common"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/gvc"
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -29,28 +30,33 @@ var (
 	_ resource.Resource                = &GvcResource{}
 	_ resource.ResourceWithImportState = &GvcResource{}
 	_ resource.ResourceWithModifyPlan  = &GvcResource{}
+	_ resource.ResourceWithIdentity    = &GvcResource{}
 )
 
+// gvcEntityIdentity describes the identity of the gvc entity.
+var gvcEntityIdentity = EntityIdentity{Kind: "gvc"}
+
 /*** Resource Model ***/
 
 // GvcResourceModel holds the Terraform state for the resource.
 type GvcResourceModel struct {
 	EntityBaseModel
-	Alias                types.String `tfsdk:"alias"`
-	Locations            types.Set    `tfsdk:"locations"`
-	LocationQuery        types.List   `tfsdk:"location_query"`
-	LocationOptions      types.Set    `tfsdk:"location_options"`
-	PullSecrets          types.Set    `tfsdk:"pull_secrets"`
-	Domain               types.String `tfsdk:"domain"`
-	EndpointNamingFormat types.String `tfsdk:"endpoint_naming_format"`
-	AliasWorkloadLink    types.String `tfsdk:"alias_workload_link"`
-	Env                  types.Map    `tfsdk:"env"`
-	LightstepTracing     types.List   `tfsdk:"lightstep_tracing"`
-	OtelTracing          types.List   `tfsdk:"otel_tracing"`
-	ControlPlaneTracing  types.List   `tfsdk:"controlplane_tracing"`
-	Sidecar              types.List   `tfsdk:"sidecar"`
-	LoadBalancer         types.List   `tfsdk:"load_balancer"`
-	Keda                 types.List   `tfsdk:"keda"`
+	Alias                types.String   `tfsdk:"alias"`
+	Locations            types.Set      `tfsdk:"locations"`
+	LocationQuery        types.List     `tfsdk:"location_query"`
+	LocationOptions      types.Set      `tfsdk:"location_options"`
+	PullSecrets          types.Set      `tfsdk:"pull_secrets"`
+	Domain               types.String   `tfsdk:"domain"`
+	EndpointNamingFormat types.String   `tfsdk:"endpoint_naming_format"`
+	AliasWorkloadLink    types.String   `tfsdk:"alias_workload_link"`
+	Env                  types.Map      `tfsdk:"env"`
+	LightstepTracing     types.List     `tfsdk:"lightstep_tracing"`
+	OtelTracing          types.List     `tfsdk:"otel_tracing"`
+	ControlPlaneTracing  types.List     `tfsdk:"controlplane_tracing"`
+	Sidecar              types.List     `tfsdk:"sidecar"`
+	LoadBalancer         types.List     `tfsdk:"load_balancer"`
+	Keda                 types.List     `tfsdk:"keda"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -72,10 +78,20 @@ func NewGvcResource() resource.Resource {
 func (gr *GvcResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	gr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	gr.Operations = NewEntityOperations(gr.client, &GvcResourceOperator{})
+	gr.Operations.Identity = &gvcEntityIdentity
+
+	// Fields configured through attributes named differently
+	gr.Operations.APIFieldAttributes = map[string]string{
+		"spec.staticPlacement": "locations",
+		"spec.pullSecretLinks": "pull_secrets",
+	}
 }
 
 // ModifyPlan adds deprecation warnings for deprecated configuration choices.
 func (gr *GvcResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Fail the plan if the entity was changed outside of Terraform
+	gr.CheckExternalChanges(ctx, req, resp)
+
 	// Skip evaluation when the resource is being destroyed
 	if req.Plan.Raw.IsNull() {
 		return
@@ -155,6 +171,12 @@ func (gr *GvcResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRe
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (gr *GvcResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		gvcEntityIdentity.ImportState(ctx, req, resp, gr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -163,6 +185,11 @@ func (gr *GvcResource) Metadata(ctx context.Context, req resource.MetadataReques
 	resp.TypeName = "cpln_gvc"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (gr *GvcResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = gvcEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (gr *GvcResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -369,6 +396,7 @@ func (gr *GvcResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"timeouts": gr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -557,28 +585,31 @@ func (gro *GvcResourceOperator) MapResponseToState(gvc *client.Gvc, isCreate boo
 		state.Keda = types.ListNull(models.KedaModel{}.AttributeTypes())
 	}
 
+	// Preserve the configured timeouts
+	state.Timeouts = gro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (aro *GvcResourceOperator) InvokeCreate(req client.Gvc) (*client.Gvc, int, error) {
-	return aro.Client.CreateGvc(req)
+	return aro.Client.CreateGvc(aro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (aro *GvcResourceOperator) InvokeRead(name string) (*client.Gvc, int, error) {
-	return aro.Client.GetGvc(name)
+	return aro.Client.GetGvc(aro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (aro *GvcResourceOperator) InvokeUpdate(req client.Gvc) (*client.Gvc, int, error) {
-	return aro.Client.UpdateGvc(req)
+	return aro.Client.UpdateGvc(aro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (aro *GvcResourceOperator) InvokeDelete(name string) error {
-	return aro.Client.DeleteGvc(name)
+	return aro.Client.DeleteGvc(aro.Ctx, name)
 }
 
 // Builders //
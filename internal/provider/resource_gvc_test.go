@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -82,7 +83,7 @@ func (grt *GvcResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of GVC with name: %s", gvcName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		gvc, code, err := TestProvider.client.GetGvc(gvcName)
+		gvc, code, err := TestProvider.client.GetGvc(context.Background(), gvcName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -149,6 +150,8 @@ func (grt *GvcResourceTest) NewOrgNamingScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -198,6 +201,8 @@ func (grt *GvcResourceTest) NewDefaultNamingScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -1347,7 +1352,7 @@ func (grtc *GvcResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteGvc, _, err := TestProvider.client.GetGvc(grtc.Name)
+		remoteGvc, _, err := TestProvider.client.GetGvc(context.Background(), grtc.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving GVC from external system: %w", err)
 		}
@@ -2,12 +2,12 @@ package cpln
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
-	"time"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -30,34 +30,36 @@ var (
 
 // HelmReleaseResourceModel holds the Terraform state for the resource.
 type HelmReleaseResourceModel struct {
-	ID                    types.String `tfsdk:"id"`
-	Name                  types.String `tfsdk:"name"`
-	Gvc                   types.String `tfsdk:"gvc"`
-	Chart                 types.String `tfsdk:"chart"`
-	Repository            types.String `tfsdk:"repository"`
-	Version               types.String `tfsdk:"version"`
-	Values                types.List   `tfsdk:"values"`
-	Set                   types.Map    `tfsdk:"set"`
-	SetString             types.Map    `tfsdk:"set_string"`
-	SetFile               types.Map    `tfsdk:"set_file"`
-	Wait                  types.Bool   `tfsdk:"wait"`
-	Timeout               types.Int32  `tfsdk:"timeout"`
-	DependencyUpdate      types.Bool   `tfsdk:"dependency_update"`
-	Description           types.String `tfsdk:"description"`
-	Verify                types.Bool   `tfsdk:"verify"`
-	RepositoryUsername    types.String `tfsdk:"repository_username"`
-	RepositoryPassword    types.String `tfsdk:"repository_password"`
-	RepositoryCaFile      types.String `tfsdk:"repository_ca_file"`
-	RepositoryCertFile    types.String `tfsdk:"repository_cert_file"`
-	RepositoryKeyFile     types.String `tfsdk:"repository_key_file"`
-	InsecureSkipTLSVerify types.Bool   `tfsdk:"insecure_skip_tls_verify"`
-	RenderSubchartNotes   types.Bool   `tfsdk:"render_subchart_notes"`
-	Postrender            types.Object `tfsdk:"postrender"`
-	MaxHistory            types.Int32  `tfsdk:"max_history"`
-	Status                types.String `tfsdk:"status"`
-	Revision              types.Int32  `tfsdk:"revision"`
-	Manifest              types.String `tfsdk:"manifest"`
-	Resources             types.Map    `tfsdk:"resources"`
+	ID                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	Gvc                   types.String   `tfsdk:"gvc"`
+	Org                   types.String   `tfsdk:"org"`
+	Chart                 types.String   `tfsdk:"chart"`
+	Repository            types.String   `tfsdk:"repository"`
+	Version               types.String   `tfsdk:"version"`
+	Values                types.List     `tfsdk:"values"`
+	Set                   types.Map      `tfsdk:"set"`
+	SetString             types.Map      `tfsdk:"set_string"`
+	SetFile               types.Map      `tfsdk:"set_file"`
+	Wait                  types.Bool     `tfsdk:"wait"`
+	Timeout               types.Int32    `tfsdk:"timeout"`
+	DependencyUpdate      types.Bool     `tfsdk:"dependency_update"`
+	Description           types.String   `tfsdk:"description"`
+	Verify                types.Bool     `tfsdk:"verify"`
+	RepositoryUsername    types.String   `tfsdk:"repository_username"`
+	RepositoryPassword    types.String   `tfsdk:"repository_password"`
+	RepositoryCaFile      types.String   `tfsdk:"repository_ca_file"`
+	RepositoryCertFile    types.String   `tfsdk:"repository_cert_file"`
+	RepositoryKeyFile     types.String   `tfsdk:"repository_key_file"`
+	InsecureSkipTLSVerify types.Bool     `tfsdk:"insecure_skip_tls_verify"`
+	RenderSubchartNotes   types.Bool     `tfsdk:"render_subchart_notes"`
+	Postrender            types.Object   `tfsdk:"postrender"`
+	MaxHistory            types.Int32    `tfsdk:"max_history"`
+	Status                types.String   `tfsdk:"status"`
+	Revision              types.Int32    `tfsdk:"revision"`
+	Manifest              types.String   `tfsdk:"manifest"`
+	Resources             types.Map      `tfsdk:"resources"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetID returns the ID field from the helm release resource model.
@@ -65,6 +67,11 @@ func (m HelmReleaseResourceModel) GetID() types.String {
 	return m.ID
 }
 
+// GetOrg returns the org the helm release is deployed to.
+func (m HelmReleaseResourceModel) GetOrg() types.String {
+	return m.Org
+}
+
 /*** Resource Configuration ***/
 
 // HelmReleaseResource is the resource implementation.
@@ -97,7 +104,7 @@ func (r *HelmReleaseResource) Metadata(ctx context.Context, req resource.Metadat
 // Schema defines the schema for the resource.
 func (r *HelmReleaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages Helm chart deployments on Control Plane using the `cpln helm` command. This resource allows you to install, upgrade, and uninstall Helm charts that deploy Control Plane resources.",
+		MarkdownDescription: "Manages Helm chart deployments on Control Plane. Charts are rendered by the provider and their resources are applied directly through the Control Plane API, so the `cpln` CLI is not required. This resource allows you to install, upgrade, and uninstall Helm charts that deploy Control Plane resources.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier for this helm release (same as name).",
@@ -117,6 +124,7 @@ func (r *HelmReleaseResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "The GVC (Global Virtual Cloud) to use for the helm deployment. Required only if the chart deploys GVC-scoped resources and the GVC is not defined within the chart manifests.",
 				Optional:    true,
 			},
+			"org": r.OrgSchema("helm release"),
 			"chart": schema.StringAttribute{
 				Description: "Path to the chart. This can be a local path to a chart directory or packaged chart, or a URL/path when used with --repo.",
 				Required:    true,
@@ -150,7 +158,7 @@ func (r *HelmReleaseResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:    true,
 			},
 			"wait": schema.BoolAttribute{
-				Description: "If set to true, will wait until all Workloads are in a ready state before marking the release as successful.",
+				Description: "If set to true, will wait until the applied version of every Workload is rolled out and ready before marking the release as successful.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
@@ -239,6 +247,9 @@ func (r *HelmReleaseResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": r.TimeoutsSchema(ctx),
+		},
 	}
 }
 
@@ -308,77 +319,63 @@ func (op *HelmReleaseResourceOperator) MapResponseToState(resp *client.HelmRelea
 	// Build the resources map
 	state.Resources = op.flattenResources(resp.Resources)
 
+	// Preserve the configured timeouts
+	state.Timeouts = op.Plan.Timeouts
+
 	return state
 }
 
-// InvokeCreate invokes helm install to create a new release.
-// If install fails with a 409 conflict (release already exists from a partial install), it falls back to helm upgrade.
+// InvokeCreate renders the chart and installs it as a new release.
+// If the release already exists (e.g. a previous install partially succeeded), it is upgraded instead.
 func (op *HelmReleaseResourceOperator) InvokeCreate(req client.HelmReleaseState) (*client.HelmReleaseState, int, error) {
-	// Build the command arguments
-	args := []string{"helm", "install", op.Plan.Name.ValueString(), op.Plan.Chart.ValueString()}
-
-	// Add common arguments
-	commonArgs, tempFiles, err := op.Client.BuildHelmArgs(args, op.buildCommonConfig())
-	defer client.RemoveTempFiles(tempFiles)
+	// Render and apply the chart
+	release, err := op.Client.InstallHelmRelease(op.Ctx, req.Name, op.Plan.Chart.ValueString(), op.buildCommonConfig())
 	if err != nil {
 		return nil, 0, fmt.Errorf("could not install release %s: %w", req.Name, err)
 	}
 
-	// Execute helm install
-	if _, err := ExecuteCplnCommand(commonArgs); err != nil {
-		// On 409 conflict, the release already exists (e.g. a previous install partially succeeded), fall back to upgrade
-		if strings.Contains(err.Error(), "409") {
-			return op.InvokeUpdate(req)
-		}
-
-		return nil, 0, fmt.Errorf("could not install release %s: %w", req.Name, err)
-	}
-
-	// Fetch release info
-	return op.getRelease(req.Name)
+	return op.buildReleaseState(release), 0, nil
 }
 
 // InvokeRead fetches the current state of an existing release.
 func (op *HelmReleaseResourceOperator) InvokeRead(name string) (*client.HelmReleaseState, int, error) {
-	return op.getRelease(name)
+	// Fetch the latest revision of the release
+	release, code, err := op.Client.GetHelmRelease(op.Ctx, name)
+	if err != nil {
+		return nil, code, err
+	}
+
+	return op.buildReleaseState(release), code, nil
 }
 
-// InvokeUpdate invokes helm upgrade to update an existing release.
+// InvokeUpdate renders the chart and applies it as a new revision of an existing release.
 func (op *HelmReleaseResourceOperator) InvokeUpdate(req client.HelmReleaseState) (*client.HelmReleaseState, int, error) {
-	// Build the command arguments
-	args := []string{"helm", "upgrade", op.Plan.Name.ValueString(), op.Plan.Chart.ValueString()}
-
-	// Add common arguments including max_history for upgrade
-	cfg := op.buildCommonConfig()
-	cfg.MaxHistory = op.Plan.MaxHistory
+	// Render and apply the chart, retrying on 409 conflict
+	var release *client.HelmRelease
+	_, err := op.Client.Retry(op.Ctx, http.MethodPost, func() (int, error) {
+		var err error
+		release, err = op.Client.UpgradeHelmRelease(op.Ctx, req.Name, op.Plan.Chart.ValueString(), op.buildCommonConfig())
+
+		// Surface conflicts so they are retried
+		if client.IsConflict(err) {
+			return http.StatusConflict, err
+		}
 
-	// Build and validate the full argument list
-	commonArgs, tempFiles, err := op.Client.BuildHelmArgs(args, cfg)
-	defer client.RemoveTempFiles(tempFiles)
+		return 0, err
+	}, http.StatusConflict)
 	if err != nil {
 		return nil, 0, fmt.Errorf("could not upgrade release %s: %w", req.Name, err)
 	}
 
-	// Execute helm upgrade with retry on 409 conflict
-	if err := executeHelmWithRetry(commonArgs); err != nil {
-		return nil, 0, fmt.Errorf("could not upgrade release %s: %w", req.Name, err)
-	}
-
-	// Fetch release info
-	return op.getRelease(req.Name)
+	return op.buildReleaseState(release), 0, nil
 }
 
-// InvokeDelete invokes helm uninstall to delete a release.
+// InvokeDelete uninstalls a release by removing its resources and revision history.
 func (op *HelmReleaseResourceOperator) InvokeDelete(name string) error {
-	// Build the command arguments
-	args := []string{"helm", "uninstall", name}
-	args = op.Client.AppendCplnContextArgs(args, op.Plan.Gvc.ValueString())
-
-	// Execute helm uninstall
-	_, err := ExecuteCplnCommand(args)
-	if err != nil {
+	// Remove the release
+	if err := op.Client.UninstallHelmRelease(op.Ctx, name); err != nil {
 		// If release is already gone, that's fine
-		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "release: not found") {
+		if client.IsNotFound(err) {
 			return nil
 		}
 
@@ -406,38 +403,15 @@ func (op *HelmReleaseResourceOperator) flattenResources(resources map[string]str
 
 // Helpers //
 
-// getRelease fetches all release info using cpln helm get all <name> -o json.
-func (op *HelmReleaseResourceOperator) getRelease(releaseName string) (*client.HelmReleaseState, int, error) {
-	// Build the command arguments
-	args := []string{"helm", "get", "all", releaseName, "-o", "json"}
-	args = op.Client.AppendCplnAuthArgs(args)
-
-	// Execute helm get all
-	output, err := ExecuteCplnCommand(args)
-	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, 404, fmt.Errorf("release %s not found", releaseName)
-		}
-
-		return nil, 0, fmt.Errorf("could not get release %s: %w", releaseName, err)
-	}
-
-	// Parse the JSON response
-	var resp client.HelmGetAllResponse
-	if err := json.Unmarshal([]byte(output), &resp); err != nil {
-		return nil, 0, fmt.Errorf("failed to parse helm get all output: %w", err)
-	}
-
-	// Parse manifest YAML documents into resources map
-	resources := op.parseManifestResources(resp.Manifest)
-
+// buildReleaseState converts a release revision into the internal state carrier.
+func (op *HelmReleaseResourceOperator) buildReleaseState(release *client.HelmRelease) *client.HelmReleaseState {
 	return &client.HelmReleaseState{
-		Name:      resp.Name,
-		Status:    resp.Info.Status,
-		Revision:  resp.Version,
-		Manifest:  strings.TrimSpace(resp.Manifest),
-		Resources: resources,
-	}, 0, nil
+		Name:      release.Name,
+		Status:    release.Info.Status,
+		Revision:  release.Version,
+		Manifest:  strings.TrimSpace(release.Manifest),
+		Resources: op.parseManifestResources(release.Manifest),
+	}
 }
 
 // parseManifestResources splits a multi-document YAML manifest into a map keyed by resource identity.
@@ -472,38 +446,6 @@ func (op *HelmReleaseResourceOperator) parseManifestResources(manifest string) m
 	return resources
 }
 
-// executeHelmWithRetry executes a cpln helm command with retry logic on 409 conflict errors.
-func executeHelmWithRetry(args []string) error {
-	const maxRetries = 5
-	backoff := 2 * time.Second
-	var lastErr error
-
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		_, err := ExecuteCplnCommand(args)
-		if err == nil {
-			return nil
-		}
-
-		lastErr = err
-
-		// Retry on 409 conflict
-		if strings.Contains(err.Error(), "409") {
-			if attempt == maxRetries {
-				break
-			}
-
-			time.Sleep(backoff)
-			backoff *= 2
-			continue
-		}
-
-		// Non-retryable error
-		return err
-	}
-
-	return lastErr
-}
-
 // buildCommonConfig builds a HelmCommonConfig from the current plan.
 func (op *HelmReleaseResourceOperator) buildCommonConfig() client.HelmCommonConfig {
 	return client.HelmCommonConfig{
@@ -527,6 +469,7 @@ func (op *HelmReleaseResourceOperator) buildCommonConfig() client.HelmCommonConf
 		RenderSubchartNotes:   op.Plan.RenderSubchartNotes,
 		Postrender:            op.Plan.Postrender,
 		DependencyUpdate:      op.Plan.DependencyUpdate,
+		MaxHistory:            op.Plan.MaxHistory,
 	}
 }
 
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -76,6 +77,24 @@ func (hrt *HelmReleaseResourceTest) CheckDestroy(s *terraform.State) error {
 		// Retrieve the name for the current resource
 		helmReleaseName := rs.Primary.ID
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of helm release with name: %s", helmReleaseName))
+
+		// Use the TestProvider client to check if the release still has revisions in the data service
+		release, code, err := TestProvider.client.GetHelmRelease(context.Background(), helmReleaseName)
+
+		// If a 404 status code is returned, it indicates the release was uninstalled
+		if code == 404 {
+			continue
+		}
+
+		// If an error occurs during the request, return an error
+		if err != nil {
+			return fmt.Errorf("error occurred while checking if helm release %s exists: %w", helmReleaseName, err)
+		}
+
+		// If the release is found, return an error indicating it still exists
+		if release != nil {
+			return fmt.Errorf("CheckDestroy failed: helm release %s still exists in the system", release.Name)
+		}
 	}
 
 	// Log successful completion of the destroy check
@@ -430,3 +449,56 @@ type HelmReleaseResourceTestCase struct {
 	Chart           string
 	ValuesFile      string
 }
+
+/*** Unit Tests ***/
+
+// TestHelmReleaseParseManifestResources verifies rendered manifests are split into resources keyed by their identity.
+func TestHelmReleaseParseManifestResources(t *testing.T) {
+	// Build an operator to parse the manifests with
+	op := &HelmReleaseResourceOperator{}
+
+	// Define the table of cases
+	cases := []struct {
+		name     string
+		manifest string
+		want     map[string]string
+	}{
+		{
+			name:     "empty manifest",
+			manifest: "",
+			want:     map[string]string{},
+		},
+		{
+			name:     "org and gvc scoped resources",
+			manifest: "---\n# Source: chart/templates/secret.yaml\nkind: secret\nname: my-secret\n---\n# Source: chart/templates/workload.yaml\nkind: Workload\nname: my-workload\ngvc: my-gvc\n",
+			want: map[string]string{
+				"secret/my-secret":            "# Source: chart/templates/secret.yaml\nkind: secret\nname: my-secret",
+				"workload/my-gvc/my-workload": "# Source: chart/templates/workload.yaml\nkind: Workload\nname: my-workload\ngvc: my-gvc",
+			},
+		},
+		{
+			name:     "documents without identity are skipped",
+			manifest: "---\nkind: secret\n---\nname: orphan\n---\nkind: gvc\nname: my-gvc\n",
+			want: map[string]string{
+				"gvc/my-gvc": "kind: gvc\nname: my-gvc",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Parse the manifest
+			got := op.parseManifestResources(tc.manifest)
+
+			// Verify every expected resource is present with its document
+			if len(got) != len(tc.want) {
+				t.Fatalf("resources = %v, want %v", got, tc.want)
+			}
+			for key, want := range tc.want {
+				if got[key] != want {
+					t.Errorf("resource %s = %q, want %q", key, got[key], want)
+				}
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/identity"
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -30,21 +31,26 @@ import (
 var (
 	_ resource.Resource                = &IdentityResource{}
 	_ resource.ResourceWithImportState = &IdentityResource{}
+	_ resource.ResourceWithIdentity    = &IdentityResource{}
 )
 
+// identityEntityIdentity describes the identity of the identity entity.
+var identityEntityIdentity = EntityIdentity{Kind: "identity", IsGvcScoped: true}
+
 /*** Resource Model ***/
 
 // IdentityResourceModel holds the Terraform state for the resource.
 type IdentityResourceModel struct {
 	EntityBaseModel
-	Gvc                   types.String `tfsdk:"gvc"`
-	Status                types.Map    `tfsdk:"status"`
-	AwsAccessPolicy       types.List   `tfsdk:"aws_access_policy"`
-	GcpAccessPolicy       types.List   `tfsdk:"gcp_access_policy"`
-	AzureAccessPolicy     types.List   `tfsdk:"azure_access_policy"`
-	NgsAccessPolicy       types.List   `tfsdk:"ngs_access_policy"`
-	NetworkResource       types.Set    `tfsdk:"network_resource"`
-	NativeNetworkResource types.Set    `tfsdk:"native_network_resource"`
+	Gvc                   types.String   `tfsdk:"gvc"`
+	Status                types.Map      `tfsdk:"status"`
+	AwsAccessPolicy       types.List     `tfsdk:"aws_access_policy"`
+	GcpAccessPolicy       types.List     `tfsdk:"gcp_access_policy"`
+	AzureAccessPolicy     types.List     `tfsdk:"azure_access_policy"`
+	NgsAccessPolicy       types.List     `tfsdk:"ngs_access_policy"`
+	NetworkResource       types.Set      `tfsdk:"network_resource"`
+	NativeNetworkResource types.Set      `tfsdk:"native_network_resource"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -64,40 +70,29 @@ func NewIdentityResource() resource.Resource {
 func (ir *IdentityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	ir.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	ir.Operations = NewEntityOperations(ir.client, &IdentityResourceOperator{})
+	ir.Operations.Identity = &identityEntityIdentity
+
+	// Fields configured through attributes named differently
+	ir.Operations.APIFieldAttributes = map[string]string{
+		"aws":                    "aws_access_policy",
+		"gcp":                    "gcp_access_policy",
+		"azure":                  "azure_access_policy",
+		"ngs":                    "ngs_access_policy",
+		"networkResources":       "network_resource",
+		"nativeNetworkResources": "native_network_resource",
+	}
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (ir *IdentityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Split the import ID
-	parts := strings.SplitN(req.ID, ":", 2)
-
-	// Validate that ID has exactly three non-empty segments
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		// Report error when import identifier format is unexpected
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf(
-				"Expected import identifier with format: "+
-					"'gvc:identity_name'. Got: %q", req.ID,
-			),
-		)
-
-		// Abort import operation on error
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		identityEntityIdentity.ImportState(ctx, req, resp, ir.client.Org)
 		return
 	}
 
-	// Extract gvc and identityName from parts
-	gvc, identityName := parts[0], parts[1]
-
-	// Set the generated ID attribute in the Terraform state
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(identityName))...,
-	)
-
-	// Set the GVC attribute in the Terraform state
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("gvc"), types.StringValue(gvc))...,
-	)
+	// Map the GVC and name, or the self link, to the state
+	ImportGvcScopedState(ctx, req, resp, "identity", ir.client.Org)
 }
 
 // Metadata provides the resource type name.
@@ -105,6 +100,11 @@ func (ir *IdentityResource) Metadata(ctx context.Context, req resource.MetadataR
 	resp.TypeName = "cpln_identity"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (ir *IdentityResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (ir *IdentityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -464,6 +464,7 @@ func (ir *IdentityResource) Schema(ctx context.Context, req resource.SchemaReque
 					setvalidator.SizeAtMost(50),
 				},
 			},
+			"timeouts": ir.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -567,28 +568,31 @@ func (iro *IdentityResourceOperator) MapResponseToState(apiResp *client.Identity
 	state.NativeNetworkResource = iro.flattenNativeNetworkResources(apiResp.NativeNetworkResources)
 	state.Status = iro.flattenStatus(apiResp.Status)
 
+	// Preserve the configured timeouts
+	state.Timeouts = iro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (iro *IdentityResourceOperator) InvokeCreate(req client.Identity) (*client.Identity, int, error) {
-	return iro.Client.CreateIdentity(req, iro.Plan.Gvc.ValueString())
+	return iro.Client.CreateIdentity(iro.Ctx, req, iro.Plan.Gvc.ValueString())
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (iro *IdentityResourceOperator) InvokeRead(name string) (*client.Identity, int, error) {
-	return iro.Client.GetIdentity(name, iro.Plan.Gvc.ValueString())
+	return iro.Client.GetIdentity(iro.Ctx, name, iro.Plan.Gvc.ValueString())
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (iro *IdentityResourceOperator) InvokeUpdate(req client.Identity) (*client.Identity, int, error) {
-	return iro.Client.UpdateIdentity(req, iro.Plan.Gvc.ValueString())
+	return iro.Client.UpdateIdentity(iro.Ctx, req, iro.Plan.Gvc.ValueString())
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (iro *IdentityResourceOperator) InvokeDelete(name string) error {
-	return iro.Client.DeleteIdentity(name, iro.Plan.Gvc.ValueString())
+	return iro.Client.DeleteIdentity(iro.Ctx, name, iro.Plan.Gvc.ValueString())
 }
 
 // Builders //
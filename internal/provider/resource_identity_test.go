@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -83,7 +84,7 @@ func (irt *IdentityResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of GVC with name: %s", gvcName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		gvc, code, err := TestProvider.client.GetGvc(gvcName)
+		gvc, code, err := TestProvider.client.GetGvc(context.Background(), gvcName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -150,6 +151,8 @@ func (irt *IdentityResourceTest) NewDefaultScenario() []resource.TestStep {
 			ImportState:   true,
 			ImportStateId: fmt.Sprintf("%s:%s", gvcName, name),
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, fmt.Sprintf("%s:%s", gvcName, name)),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -863,7 +866,7 @@ func (irtc *IdentityResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteIdentity, _, err := TestProvider.client.GetIdentity(irtc.Name, irtc.GvcCase.Name)
+		remoteIdentity, _, err := TestProvider.client.GetIdentity(context.Background(), irtc.Name, irtc.GvcCase.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving identity from external system: %w", err)
 		}
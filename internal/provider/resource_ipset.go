@@ -5,6 +5,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/ipset"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,16 +18,21 @@ import (
 var (
 	_ resource.Resource                = &IpSetResource{}
 	_ resource.ResourceWithImportState = &IpSetResource{}
+	_ resource.ResourceWithIdentity    = &IpSetResource{}
 )
 
+// ipSetEntityIdentity describes the identity of the ipset entity.
+var ipSetEntityIdentity = EntityIdentity{Kind: "ipset"}
+
 /*** Resource Model ***/
 
 // IpSetResourceModel holds the Terraform state for the resource.
 type IpSetResourceModel struct {
 	EntityBaseModel
-	Link      types.String `tfsdk:"link"`
-	Locations types.Set    `tfsdk:"location"`
-	Status    types.List   `tfsdk:"status"`
+	Link      types.String   `tfsdk:"link"`
+	Locations types.Set      `tfsdk:"location"`
+	Status    types.List     `tfsdk:"status"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -46,10 +52,20 @@ func NewIpSetResource() resource.Resource {
 func (isr *IpSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	isr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	isr.Operations = NewEntityOperations(isr.client, &IpSetResourceOperator{})
+	isr.Operations.Identity = &ipSetEntityIdentity
+
+	// Fields configured through attributes named differently
+	isr.Operations.APIFieldAttributes = map[string]string{"spec.locations": "location"}
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (isr *IpSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		ipSetEntityIdentity.ImportState(ctx, req, resp, isr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -58,6 +74,11 @@ func (isr *IpSetResource) Metadata(ctx context.Context, req resource.MetadataReq
 	resp.TypeName = "cpln_ipset"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (isr *IpSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = ipSetEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (isr *IpSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -130,6 +151,7 @@ func (isr *IpSetResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"timeouts": isr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -209,28 +231,31 @@ func (isro *IpSetResourceOperator) MapResponseToState(apiResp *client.IpSet, isC
 	// Set specific attributes
 	state.Status = isro.flattenStatus(apiResp.Status)
 
+	// Preserve the configured timeouts
+	state.Timeouts = isro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (isro *IpSetResourceOperator) InvokeCreate(req client.IpSet) (*client.IpSet, int, error) {
-	return isro.Client.CreateIpSet(req)
+	return isro.Client.CreateIpSet(isro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (isro *IpSetResourceOperator) InvokeRead(name string) (*client.IpSet, int, error) {
-	return isro.Client.GetIpSet(name)
+	return isro.Client.GetIpSet(isro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (isro *IpSetResourceOperator) InvokeUpdate(req client.IpSet) (*client.IpSet, int, error) {
-	return isro.Client.UpdateIpSet(req)
+	return isro.Client.UpdateIpSet(isro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (isro *IpSetResourceOperator) InvokeDelete(name string) error {
-	return isro.Client.DeleteIpSet(name)
+	return isro.Client.DeleteIpSet(isro.Ctx, name)
 }
 
 // Builders //
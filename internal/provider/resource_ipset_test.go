@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -77,7 +78,7 @@ func (isrt *IpSetResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of ipset with name: %s", ipsetName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		ipset, code, err := TestProvider.client.GetIpSet(ipsetName)
+		ipset, code, err := TestProvider.client.GetIpSet(context.Background(), ipsetName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -122,6 +123,8 @@ func (isrt *IpSetResourceTest) NewDefaultScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -249,7 +252,7 @@ func (isrtc *IpSetResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteIpSet, _, err := TestProvider.client.GetIpSet(isrtc.Name)
+		remoteIpSet, _, err := TestProvider.client.GetIpSet(context.Background(), isrtc.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving ipset from external system: %w", err)
 		}
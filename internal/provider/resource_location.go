@@ -7,6 +7,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/location"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,19 +21,24 @@ import (
 var (
 	_ resource.Resource                = &LocationResource{}
 	_ resource.ResourceWithImportState = &LocationResource{}
+	_ resource.ResourceWithIdentity    = &LocationResource{}
 )
 
+// locationEntityIdentity describes the identity of the location entity.
+var locationEntityIdentity = EntityIdentity{Kind: "location"}
+
 /*** Resource Model ***/
 
 // LocationResourceModel holds the Terraform state for the resource.
 type LocationResourceModel struct {
 	EntityBaseModel
-	Origin        types.String `tfsdk:"origin"`
-	CloudProvider types.String `tfsdk:"cloud_provider"`
-	Region        types.String `tfsdk:"region"`
-	Enabled       types.Bool   `tfsdk:"enabled"`
-	Geo           types.List   `tfsdk:"geo"`
-	IpRanges      types.Set    `tfsdk:"ip_ranges"`
+	Origin        types.String   `tfsdk:"origin"`
+	CloudProvider types.String   `tfsdk:"cloud_provider"`
+	Region        types.String   `tfsdk:"region"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	Geo           types.List     `tfsdk:"geo"`
+	IpRanges      types.Set      `tfsdk:"ip_ranges"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -56,10 +62,20 @@ func NewLocationResource() resource.Resource {
 func (lr *LocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	lr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	lr.Operations = NewEntityOperations(lr.client, &LocationResourceOperator{})
+	lr.Operations.Identity = &locationEntityIdentity
+
+	// Fields configured through attributes named differently
+	lr.Operations.APIFieldAttributes = map[string]string{"provider": "cloud_provider"}
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (lr *LocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		locationEntityIdentity.ImportState(ctx, req, resp, lr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -68,6 +84,11 @@ func (lr *LocationResource) Metadata(ctx context.Context, req resource.MetadataR
 	resp.TypeName = "cpln_location"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (lr *LocationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = locationEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (lr *LocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -135,6 +156,9 @@ func (lr *LocationResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": lr.TimeoutsSchema(ctx),
+		},
 	}
 }
 
@@ -205,6 +229,9 @@ func (leo *LocationResourceOperator) MapResponseToState(apiResp *client.Location
 		state.IpRanges = types.SetNull(types.StringType)
 	}
 
+	// Preserve the configured timeouts
+	state.Timeouts = leo.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
@@ -212,10 +239,10 @@ func (leo *LocationResourceOperator) MapResponseToState(apiResp *client.Location
 // InvokeCreate invokes the Create API to create a new resource.
 func (leo *LocationResourceOperator) InvokeCreate(req client.Location) (*client.Location, int, error) {
 	// Fetch the location by name
-	l, code, err := leo.Client.GetLocation(*req.Name)
+	l, code, err := leo.Client.GetLocation(leo.Ctx, *req.Name)
 
 	// If the location doesn't exist, then maybe the user attempted to create it, let them know that
-	if code == 404 {
+	if client.IsNotFound(err) {
 		return nil, 0, fmt.Errorf("location '/org/%s/location/%s' does not exist. Did you want to create a BYOK location? Please refer to the 'cpln_custom_location' resource. You can find more info here: https://registry.terraform.io/providers/controlplane-com/cpln/latest/docs/resources/custom_location", leo.Client.Org, *req.Name)
 	}
 
@@ -231,7 +258,7 @@ func (leo *LocationResourceOperator) InvokeCreate(req client.Location) (*client.
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (leo *LocationResourceOperator) InvokeRead(name string) (*client.Location, int, error) {
 	// Fetch the location by name
-	l, code, err := leo.Client.GetLocation(name)
+	l, code, err := leo.Client.GetLocation(leo.Ctx, name)
 
 	// If this is one of the custom locations, tell users to use the custom location resource
 	if l.Provider != nil && IsCustomLocation(*l.Provider) {
@@ -244,13 +271,13 @@ func (leo *LocationResourceOperator) InvokeRead(name string) (*client.Location,
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (leo *LocationResourceOperator) InvokeUpdate(req client.Location) (*client.Location, int, error) {
-	return leo.Client.UpdateLocation(req)
+	return leo.Client.UpdateLocation(leo.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (leo *LocationResourceOperator) InvokeDelete(name string) error {
 	// Retrieve the location resource using client
-	location, _, err := leo.Client.GetLocation(name)
+	location, _, err := leo.Client.GetLocation(leo.Ctx, name)
 
 	// Return error if retrieval fails
 	if err != nil {
@@ -269,12 +296,16 @@ func (leo *LocationResourceOperator) InvokeDelete(name string) error {
 	// Clear the status to indicate deletion
 	location.Status = nil
 
+	// Clear the read-only version metadata before sending the location back
+	location.Version = nil
+	location.LastModified = nil
+
 	// Set the TagsReplace just so we don't get a panic
 	location.TagsReplace = location.Tags
 	location.Tags = nil
 
 	// Update the location resource on the server
-	_, _, err = leo.Client.UpdateLocationToDefault(*location)
+	_, _, err = leo.Client.UpdateLocationToDefault(leo.Ctx, *location)
 
 	// Return error if update fails
 	if err != nil {
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -78,7 +79,7 @@ func (lrt *LocationResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of location with name: %s", locationName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		location, code, err := TestProvider.client.GetLocation(locationName)
+		location, code, err := TestProvider.client.GetLocation(context.Background(), locationName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -132,6 +133,8 @@ func (lrt *LocationResourceTest) NewDefaultScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -239,7 +242,7 @@ func (lrtc *LocationResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteLocation, _, err := TestProvider.client.GetLocation(lrtc.Name)
+		remoteLocation, _, err := TestProvider.client.GetLocation(context.Background(), lrtc.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving location from external system: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/mk8s"
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -36,30 +37,35 @@ import (
 var (
 	_ resource.Resource                = &Mk8sResource{}
 	_ resource.ResourceWithImportState = &Mk8sResource{}
+	_ resource.ResourceWithIdentity    = &Mk8sResource{}
 )
 
+// mk8sEntityIdentity describes the identity of the mk8s entity.
+var mk8sEntityIdentity = EntityIdentity{Kind: "mk8s"}
+
 /*** Resource Model ***/
 
 // Mk8sResourceModel holds the Terraform state for the resource.
 type Mk8sResourceModel struct {
 	EntityBaseModel
-	Alias                types.String `tfsdk:"alias"`
-	Version              types.String `tfsdk:"version"`
-	Firewall             types.Set    `tfsdk:"firewall"`
-	GenericProvider      types.List   `tfsdk:"generic_provider"`
-	HetznerProvider      types.List   `tfsdk:"hetzner_provider"`
-	AwsProvider          types.List   `tfsdk:"aws_provider"`
-	LinodeProvider       types.List   `tfsdk:"linode_provider"`
-	OblivusProvider      types.List   `tfsdk:"oblivus_provider"`
-	LambdalabsProvider   types.List   `tfsdk:"lambdalabs_provider"`
-	PaperspaceProvider   types.List   `tfsdk:"paperspace_provider"`
-	EphemeralProvider    types.List   `tfsdk:"ephemeral_provider"`
-	TritonProvider       types.List   `tfsdk:"triton_provider"`
-	AzureProvider        types.List   `tfsdk:"azure_provider"`
-	DigitalOceanProvider types.List   `tfsdk:"digital_ocean_provider"`
-	GcpProvider          types.List   `tfsdk:"gcp_provider"`
-	AddOns               types.List   `tfsdk:"add_ons"`
-	Status               types.List   `tfsdk:"status"`
+	Alias                types.String   `tfsdk:"alias"`
+	Version              types.String   `tfsdk:"version"`
+	Firewall             types.Set      `tfsdk:"firewall"`
+	GenericProvider      types.List     `tfsdk:"generic_provider"`
+	HetznerProvider      types.List     `tfsdk:"hetzner_provider"`
+	AwsProvider          types.List     `tfsdk:"aws_provider"`
+	LinodeProvider       types.List     `tfsdk:"linode_provider"`
+	OblivusProvider      types.List     `tfsdk:"oblivus_provider"`
+	LambdalabsProvider   types.List     `tfsdk:"lambdalabs_provider"`
+	PaperspaceProvider   types.List     `tfsdk:"paperspace_provider"`
+	EphemeralProvider    types.List     `tfsdk:"ephemeral_provider"`
+	TritonProvider       types.List     `tfsdk:"triton_provider"`
+	AzureProvider        types.List     `tfsdk:"azure_provider"`
+	DigitalOceanProvider types.List     `tfsdk:"digital_ocean_provider"`
+	GcpProvider          types.List     `tfsdk:"gcp_provider"`
+	AddOns               types.List     `tfsdk:"add_ons"`
+	Status               types.List     `tfsdk:"status"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -79,10 +85,17 @@ func NewMk8sResource() resource.Resource {
 func (mr *Mk8sResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	mr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	mr.Operations = NewEntityOperations(mr.client, &Mk8sResourceOperator{})
+	mr.Operations.Identity = &mk8sEntityIdentity
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (mr *Mk8sResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		mk8sEntityIdentity.ImportState(ctx, req, resp, mr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -91,6 +104,11 @@ func (mr *Mk8sResource) Metadata(ctx context.Context, req resource.MetadataReque
 	resp.TypeName = "cpln_mk8s"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (mr *Mk8sResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = mk8sEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (mr *Mk8sResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -1989,6 +2007,7 @@ func (mr *Mk8sResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"timeouts": mr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -2733,28 +2752,31 @@ func (mro *Mk8sResourceOperator) MapResponseToState(apiResp *client.Mk8s, isCrea
 	state.AddOns = mro.flattenAddOns(apiResp.Spec.AddOns)
 	state.Status = mro.flattenStatus(apiResp.Status)
 
+	// Preserve the configured timeouts
+	state.Timeouts = mro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (mro *Mk8sResourceOperator) InvokeCreate(req client.Mk8s) (*client.Mk8s, int, error) {
-	return mro.Client.CreateMk8s(req)
+	return mro.Client.CreateMk8s(mro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (mro *Mk8sResourceOperator) InvokeRead(name string) (*client.Mk8s, int, error) {
-	return mro.Client.GetMk8s(name)
+	return mro.Client.GetMk8s(mro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (mro *Mk8sResourceOperator) InvokeUpdate(req client.Mk8s) (*client.Mk8s, int, error) {
-	return mro.Client.UpdateMk8s(req)
+	return mro.Client.UpdateMk8s(mro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (mro *Mk8sResourceOperator) InvokeDelete(name string) error {
-	return mro.Client.DeleteMk8s(name)
+	return mro.Client.DeleteMk8s(mro.Ctx, name)
 }
 
 // Builders //
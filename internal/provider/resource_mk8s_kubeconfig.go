@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,11 +25,13 @@ var (
 
 // Mk8sKubeconfigResourceModel holds the Terraform state for the resource.
 type Mk8sKubeconfigResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Profile        types.String `tfsdk:"profile"`
-	ServiceAccount types.String `tfsdk:"service_account"`
-	Kubeconfig     types.String `tfsdk:"kubeconfig"`
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Profile        types.String   `tfsdk:"profile"`
+	ServiceAccount types.String   `tfsdk:"service_account"`
+	Kubeconfig     types.String   `tfsdk:"kubeconfig"`
+	Org            types.String   `tfsdk:"org"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -102,6 +105,10 @@ func (mkr *Mk8sKubeconfigResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org": mkr.OrgSchema("MK8s"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": mkr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -115,6 +122,10 @@ func (mkr *Mk8sKubeconfigResource) ConfigValidators(ctx context.Context) []resou
 
 // Create creates the resource.
 func (mkr *Mk8sKubeconfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Bound the create operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Create, DefaultCreateTimeout)
+	defer cancel()
+
 	var plannedState Mk8sKubeconfigResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -133,8 +144,11 @@ func (mkr *Mk8sKubeconfigResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Target the org the MK8s belongs to
+	orgClient := mkr.client.WithOrg(plannedState.Org.ValueString())
+
 	// Create a new MK8s Kubeconfig using the API client
-	kubeconfig, err := mkr.client.CreateMk8sKubeconfig(mk8sName, profileName, serviceAccountName)
+	kubeconfig, _, err := orgClient.CreateMk8sKubeconfig(ctx, mk8sName, profileName, serviceAccountName)
 
 	// Handle any other errors that occurred during the API request
 	if err != nil {
@@ -145,6 +159,12 @@ func (mkr *Mk8sKubeconfigResource) Create(ctx context.Context, req resource.Crea
 	// Map the API response to the Terraform state
 	finalState := mkr.buildState(mk8sName, profileName, serviceAccountName, *kubeconfig)
 
+	// Preserve the configured timeouts
+	finalState.Timeouts = plannedState.Timeouts
+
+	// Persist the org the MK8s belongs to
+	finalState.Org = types.StringValue(orgClient.Org)
+
 	// Return if an error has occurred during the state creation
 	if resp.Diagnostics.HasError() {
 		return
@@ -156,6 +176,10 @@ func (mkr *Mk8sKubeconfigResource) Create(ctx context.Context, req resource.Crea
 
 // Read fetches the current state of the resource.
 func (mkr *Mk8sKubeconfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Bound the read operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Read, DefaultReadTimeout)
+	defer cancel()
+
 	var plannedState Mk8sKubeconfigResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -172,10 +196,14 @@ func (mkr *Mk8sKubeconfigResource) Read(ctx context.Context, req resource.ReadRe
 
 // Update modifies the resource.
 func (mkr *Mk8sKubeconfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Bound the update operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Update, DefaultUpdateTimeout)
+	defer cancel()
+
 	var plannedState Mk8sKubeconfigResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
-	resp.Diagnostics.Append(req.State.Get(ctx, &plannedState)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedState)...)
 
 	// Abort on errors to avoid partial or inconsistent state
 	if resp.Diagnostics.HasError() {
@@ -188,6 +216,10 @@ func (mkr *Mk8sKubeconfigResource) Update(ctx context.Context, req resource.Upda
 
 // Delete removes the resource.
 func (mkr *Mk8sKubeconfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Bound the delete operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Delete, DefaultDeleteTimeout)
+	defer cancel()
+
 	// Remove the resource from Terraform's state, indicating successful deletion
 	resp.State.RemoveResource(ctx)
 }
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -92,7 +93,7 @@ func (mrt *Mk8sResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of mk8s with name: %s", mk8sName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		mk8s, code, err := TestProvider.client.GetMk8s(mk8sName)
+		mk8s, code, err := TestProvider.client.GetMk8s(context.Background(), mk8sName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -138,6 +139,8 @@ func (mrt *Mk8sResourceTest) NewMk8sGenericProviderScenario() []resource.TestSte
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -167,6 +170,8 @@ func (mrt *Mk8sResourceTest) NewMk8sHetznerProviderScenario() []resource.TestSte
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -188,6 +193,8 @@ func (mrt *Mk8sResourceTest) NewMk8sAwsProviderScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -209,6 +216,8 @@ func (mrt *Mk8sResourceTest) NewMk8sLinodeProviderScenario() []resource.TestStep
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -230,6 +239,8 @@ func (mrt *Mk8sResourceTest) NewMk8sOblivusProviderScenario() []resource.TestSte
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -251,6 +262,8 @@ func (mrt *Mk8sResourceTest) NewMk8sLambdalabsProviderScenario() []resource.Test
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -272,6 +285,8 @@ func (mrt *Mk8sResourceTest) NewMk8sPaperspaceProviderScenario() []resource.Test
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -293,6 +308,8 @@ func (mrt *Mk8sResourceTest) NewMk8sEphemeralProviderScenario() []resource.TestS
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -316,6 +333,8 @@ func (mrt *Mk8sResourceTest) NewMk8sTritonProviderScenario() []resource.TestStep
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -342,6 +361,8 @@ func (mrt *Mk8sResourceTest) NewMk8sAzureProviderScenario() []resource.TestStep
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -363,6 +384,8 @@ func (mrt *Mk8sResourceTest) NewMk8sDigitalOceanProviderScenario() []resource.Te
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 	}
 }
 
@@ -385,6 +408,8 @@ func (mrt *Mk8sResourceTest) NewMk8sGcpProviderScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -7203,7 +7228,7 @@ func (mrtc *Mk8sResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteMk8s, _, err := TestProvider.client.GetMk8s(mrtc.Name)
+		remoteMk8s, _, err := TestProvider.client.GetMk8s(context.Background(), mrtc.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving mk8s from external system: %w", err)
 		}
@@ -7,6 +7,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/org"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -34,20 +35,25 @@ var orgOperationLock = &sync.Mutex{}
 var (
 	_ resource.Resource                = &OrgResource{}
 	_ resource.ResourceWithImportState = &OrgResource{}
+	_ resource.ResourceWithIdentity    = &OrgResource{}
 )
 
+// orgEntityIdentity describes the identity of the org entity.
+var orgEntityIdentity = EntityIdentity{Kind: "org"}
+
 /*** Resource Model ***/
 
 // OrgResourceModel holds the Terraform state for the resource.
 type OrgResourceModel struct {
 	EntityBaseModel
-	AccountId             types.String `tfsdk:"account_id"`
-	Invitees              types.Set    `tfsdk:"invitees"`
-	SessionTimeoutSeconds types.Int32  `tfsdk:"session_timeout_seconds"`
-	AuthConfig            types.List   `tfsdk:"auth_config"`
-	Observability         types.List   `tfsdk:"observability"`
-	Security              types.List   `tfsdk:"security"`
-	Status                types.List   `tfsdk:"status"`
+	AccountId             types.String   `tfsdk:"account_id"`
+	Invitees              types.Set      `tfsdk:"invitees"`
+	SessionTimeoutSeconds types.Int32    `tfsdk:"session_timeout_seconds"`
+	AuthConfig            types.List     `tfsdk:"auth_config"`
+	Observability         types.List     `tfsdk:"observability"`
+	Security              types.List     `tfsdk:"security"`
+	Status                types.List     `tfsdk:"status"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -74,11 +80,24 @@ func NewOrgResource() resource.Resource {
 func (or *OrgResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	or.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	or.Operations = NewEntityOperations(or.client, &OrgResourceOperator{})
+	or.Operations.Identity = &orgEntityIdentity
+
+	// Logging and tracing are also managed by the cpln_org_logging and cpln_org_tracing resources
+	or.Operations.DriftIgnoredPaths = []string{"spec.logging", "spec.extraLogging", "spec.tracing"}
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (or *OrgResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		orgEntityIdentity.ImportState(ctx, req, resp, or.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// The import ID is the name of the org to manage
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org"), types.StringValue(req.ID))...)
 }
 
 // Metadata provides the resource type name.
@@ -86,6 +105,11 @@ func (or *OrgResource) Metadata(ctx context.Context, req resource.MetadataReques
 	resp.TypeName = "cpln_org"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (or *OrgResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = orgEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (or *OrgResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -266,12 +290,16 @@ func (or *OrgResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"timeouts": or.TimeoutsSchema(ctx),
 		},
 	}
 }
 
 // ModifyPlan modifies the plan for the resource.
 func (or *OrgResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Fail the plan if the entity was changed outside of Terraform
+	or.CheckExternalChanges(ctx, req, resp)
+
 	// If this is a destroy plan, leave everything null and return immediately
 	if req.Plan.Raw.IsNull() {
 		return
@@ -291,7 +319,7 @@ func (or *OrgResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRe
 
 	// Modify autoscaling in options if specified
 	if plan.Description.IsNull() || plan.Description.IsUnknown() {
-		plan.Description = types.StringValue(or.client.Org)
+		plan.Description = types.StringValue(or.Operations.OrgOf(plan))
 	}
 
 	// Persist new plan into Terraform
@@ -389,6 +417,9 @@ func (oro *OrgResourceOperator) MapResponseToState(apiResp *client.Org, isCreate
 	state.Security = oro.flattenSecurity(apiResp.Spec.Security)
 	state.Status = oro.flattenStatus(apiResp.Status)
 
+	// Preserve the configured timeouts
+	state.Timeouts = oro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
@@ -396,7 +427,7 @@ func (oro *OrgResourceOperator) MapResponseToState(apiResp *client.Org, isCreate
 // InvokeCreate invokes the Create API to create a new resource.
 func (oro *OrgResourceOperator) InvokeCreate(req client.Org) (*client.Org, int, error) {
 	// Attempt to fetch the org
-	currentOrg, code, err := oro.Client.GetOrg()
+	currentOrg, code, err := oro.Client.GetOrg(oro.Ctx)
 
 	// In case there is any error, attempt to create the org
 	if err != nil {
@@ -430,12 +461,12 @@ func (oro *OrgResourceOperator) InvokeCreate(req client.Org) (*client.Org, int,
 			responseCode := 0
 
 			// Make the request to create the org
-			currentOrg, responseCode, err = oro.Client.CreateOrg(*accountId, createOrgRequest)
+			currentOrg, responseCode, err = oro.Client.CreateOrg(oro.Ctx, *accountId, createOrgRequest)
 
 			// Handle any errors from the create request
 			if err != nil {
-				// If a 409 Conflict occurs, the org already exists; set currentOrg accordingly
-				if responseCode == 409 {
+				// If a conflict occurs, the org already exists; set currentOrg accordingly
+				if client.IsConflict(err) {
 					currentOrg = &client.Org{}
 					currentOrg.Name = req.Name
 				} else {
@@ -481,7 +512,7 @@ func (oro *OrgResourceOperator) InvokeCreate(req client.Org) (*client.Org, int,
 	}
 
 	// Update the org
-	updateOrg, code, err := oro.Client.UpdateOrg(*currentOrg)
+	updateOrg, code, err := oro.Client.UpdateOrg(oro.Ctx, *currentOrg)
 
 	// Handle any errors from the update operation
 	if err != nil {
@@ -494,16 +525,16 @@ func (oro *OrgResourceOperator) InvokeCreate(req client.Org) (*client.Org, int,
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (oro *OrgResourceOperator) InvokeRead(name string) (*client.Org, int, error) {
-	return oro.Client.GetOrg()
+	return oro.Client.GetOrg(oro.Ctx)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (oro *OrgResourceOperator) InvokeUpdate(req client.Org) (*client.Org, int, error) {
 	// Fetch the current org to preserve logging and tracing set by other resources
-	currentOrg, _, err := oro.Client.GetOrg()
+	currentOrg, _, err := oro.Client.GetOrg(oro.Ctx)
 	if err != nil {
 		// If we can't fetch the current org, proceed with the update anyway
-		return oro.Client.UpdateOrg(req)
+		return oro.Client.UpdateOrg(oro.Ctx, req)
 	}
 
 	// Preserve existing logging and tracing if they exist in the current org
@@ -520,13 +551,13 @@ func (oro *OrgResourceOperator) InvokeUpdate(req client.Org) (*client.Org, int,
 		}
 	}
 
-	return oro.Client.UpdateOrg(req)
+	return oro.Client.UpdateOrg(oro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (oro *OrgResourceOperator) InvokeDelete(name string) error {
 	// Fetch the current org to preserve logging and tracing set by other resources
-	currentOrg, _, err := oro.Client.GetOrg()
+	currentOrg, _, err := oro.Client.GetOrg(oro.Ctx)
 
 	// Preserve existing logging and tracing if they exist in the current org
 	// This ensures we don't remove logging/tracing set by resource_org_logging or resource_org_tracing
@@ -564,7 +595,7 @@ func (oro *OrgResourceOperator) InvokeDelete(name string) error {
 	}
 
 	// Call UpdateOrg API to apply changes (deletion is represented by updating to default state)
-	_, _, err = oro.Client.UpdateOrg(org)
+	_, _, err = oro.Client.UpdateOrg(oro.Ctx, org)
 
 	// If an error occurred during the API call, return it
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/org"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -35,23 +36,25 @@ var (
 
 // OrgLoggingResourceModel holds the Terraform state for the resource.
 type OrgLoggingResourceModel struct {
-	ID                   types.String `tfsdk:"id"`
-	CplnID               types.String `tfsdk:"cpln_id"`
-	Name                 types.String `tfsdk:"name"`
-	Description          types.String `tfsdk:"description"`
-	Tags                 types.Map    `tfsdk:"tags"`
-	SelfLink             types.String `tfsdk:"self_link"`
-	S3Logging            types.Set    `tfsdk:"s3_logging"`
-	CoralogixLogging     types.Set    `tfsdk:"coralogix_logging"`
-	DatadogLogging       types.Set    `tfsdk:"datadog_logging"`
-	LogzioLogging        types.Set    `tfsdk:"logzio_logging"`
-	ElasticLogging       types.Set    `tfsdk:"elastic_logging"`
-	CloudWatchLogging    types.Set    `tfsdk:"cloud_watch_logging"`
-	FluentdLogging       types.Set    `tfsdk:"fluentd_logging"`
-	StackdriverLogging   types.Set    `tfsdk:"stackdriver_logging"`
-	SyslogLogging        types.Set    `tfsdk:"syslog_logging"`
-	OpenTelemetryLogging types.Set    `tfsdk:"opentelemetry_logging"`
-	LokiLogging          types.Set    `tfsdk:"loki_logging"`
+	ID                   types.String   `tfsdk:"id"`
+	CplnID               types.String   `tfsdk:"cpln_id"`
+	Name                 types.String   `tfsdk:"name"`
+	Description          types.String   `tfsdk:"description"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	SelfLink             types.String   `tfsdk:"self_link"`
+	Org                  types.String   `tfsdk:"org"`
+	S3Logging            types.Set      `tfsdk:"s3_logging"`
+	CoralogixLogging     types.Set      `tfsdk:"coralogix_logging"`
+	DatadogLogging       types.Set      `tfsdk:"datadog_logging"`
+	LogzioLogging        types.Set      `tfsdk:"logzio_logging"`
+	ElasticLogging       types.Set      `tfsdk:"elastic_logging"`
+	CloudWatchLogging    types.Set      `tfsdk:"cloud_watch_logging"`
+	FluentdLogging       types.Set      `tfsdk:"fluentd_logging"`
+	StackdriverLogging   types.Set      `tfsdk:"stackdriver_logging"`
+	SyslogLogging        types.Set      `tfsdk:"syslog_logging"`
+	OpenTelemetryLogging types.Set      `tfsdk:"opentelemetry_logging"`
+	LokiLogging          types.Set      `tfsdk:"loki_logging"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -74,6 +77,9 @@ func (olr *OrgLoggingResource) Configure(ctx context.Context, req resource.Confi
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (olr *OrgLoggingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// The import ID is the name of the org to manage
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org"), types.StringValue(req.ID))...)
 }
 
 // Metadata provides the resource type name.
@@ -122,6 +128,7 @@ func (olr *OrgLoggingResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org": olr.OrgSchema("Org Logging"),
 		},
 		Blocks: map[string]schema.Block{
 			"s3_logging": schema.SetNestedBlock{
@@ -439,12 +446,17 @@ func (olr *OrgLoggingResource) Schema(ctx context.Context, req resource.SchemaRe
 					},
 				},
 			},
+			"timeouts": olr.TimeoutsSchema(ctx),
 		},
 	}
 }
 
 // Create creates the resource.
 func (olr *OrgLoggingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Bound the create operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Create, DefaultCreateTimeout)
+	defer cancel()
+
 	// Acquire lock to ensure only one operation modifies the resource at a time
 	orgOperationLock.Lock()
 	defer orgOperationLock.Unlock()
@@ -469,7 +481,7 @@ func (olr *OrgLoggingResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Send the create request to the API client
-	responsePayload, _, err := olr.client.UpdateOrgLogging(loggings)
+	responsePayload, _, err := olr.client.WithOrg(plannedState.Org.ValueString()).UpdateOrgLogging(ctx, loggings)
 
 	// Handle any other errors that occurred during the API request
 	if err != nil {
@@ -491,6 +503,10 @@ func (olr *OrgLoggingResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read fetches the current state of the resource.
 func (olr *OrgLoggingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Bound the read operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Read, DefaultReadTimeout)
+	defer cancel()
+
 	var plannedState OrgLoggingResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -502,11 +518,11 @@ func (olr *OrgLoggingResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Fetch the org
-	responsePayload, code, err := olr.client.GetOrg()
+	responsePayload, _, err := olr.client.WithOrg(plannedState.Org.ValueString()).GetOrg(ctx)
 
 	// Handle the case where the org is not found (HTTP 404),
 	// indicating it has been deleted outside of Terraform. Remove it from state
-	if code == 404 {
+	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -531,6 +547,10 @@ func (olr *OrgLoggingResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update modifies the resource.
 func (olr *OrgLoggingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Bound the update operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Update, DefaultUpdateTimeout)
+	defer cancel()
+
 	// Acquire lock to ensure only one operation modifies the resource at a time
 	orgOperationLock.Lock()
 	defer orgOperationLock.Unlock()
@@ -554,7 +574,7 @@ func (olr *OrgLoggingResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Send the update request to the API with the modified data
-	responsePayload, _, err := olr.client.UpdateOrgLogging(loggings)
+	responsePayload, _, err := olr.client.WithOrg(plannedState.Org.ValueString()).UpdateOrgLogging(ctx, loggings)
 
 	// Handle errors from the API update request
 	if err != nil {
@@ -576,6 +596,10 @@ func (olr *OrgLoggingResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete removes the resource.
 func (olr *OrgLoggingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Bound the delete operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Delete, DefaultDeleteTimeout)
+	defer cancel()
+
 	// Acquire lock to ensure only one operation modifies the resource at a time
 	orgOperationLock.Lock()
 	defer orgOperationLock.Unlock()
@@ -591,7 +615,7 @@ func (olr *OrgLoggingResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Send a delete request to the API using the name from the state
-	_, _, err := olr.client.UpdateOrgLogging(nil)
+	_, _, err := olr.client.WithOrg(state.Org.ValueString()).UpdateOrgLogging(ctx, nil)
 
 	// Handle errors from the API delete request
 	if err != nil {
@@ -671,6 +695,7 @@ func (olr *OrgLoggingResource) buildState(ctx context.Context, diags *diag.Diagn
 	state.Description = types.StringPointerValue(apiResp.Description)
 	state.Tags = FlattenTags(apiResp.Tags)
 	state.SelfLink = FlattenSelfLink(apiResp.Links)
+	state.Org = types.StringPointerValue(apiResp.Name)
 
 	// Only process logging if Spec is non-nil
 	if apiResp.Spec != nil {
@@ -772,6 +797,9 @@ func (olr *OrgLoggingResource) buildState(ctx context.Context, diags *diag.Diagn
 		state.LokiLogging = olr.flattenLokiLogging(ctx, diags, priorCredentials, &lokiArray)
 	}
 
+	// Preserve the configured timeouts
+	state.Timeouts = plan.Timeouts
+
 	// Return completed state model
 	return state
 }
@@ -1684,8 +1712,14 @@ func (olr *OrgLoggingResource) flattenCredentialLink(priorCredentials map[string
 	// Resolve the prior credential link sharing this secret name (zero value when absent)
 	prior := priorCredentials[GetNameFromSelfLink(*input)]
 
+	// Resolve the org the API returned the link within
+	org := ""
+	if parts, err := ParseSelfLink(*input); err == nil {
+		org = parts.Org
+	}
+
 	// Preserve the user's chosen link form
-	return FlattenLinkString(prior, input, olr.client.Org)
+	return FlattenLinkString(prior, input, org)
 }
 
 // collectPriorCredentialLinks builds a lookup of prior credential link values keyed by their trailing secret name.
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -81,7 +82,7 @@ func (olrt *OrgLoggingResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of org with name: %s", orgName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		org, _, _ := TestProvider.client.GetOrg()
+		org, _, _ := TestProvider.client.GetOrg(context.Background())
 
 		// Make sure the org has no logging spec at all
 		if org.Spec.Logging != nil || (org.Spec.ExtraLogging != nil && len(*org.Spec.ExtraLogging) != 0) {
@@ -128,6 +129,8 @@ func (olrt *OrgLoggingResourceTest) NewDefaultScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
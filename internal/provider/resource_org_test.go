@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -81,7 +82,7 @@ func (ort *OrgResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of org with name: %s", orgName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		org, code, err := TestProvider.client.GetOrg()
+		org, code, err := TestProvider.client.GetOrg(context.Background())
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -596,7 +597,7 @@ func (ortc *OrgResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteOrg, _, err := TestProvider.client.GetOrg()
+		remoteOrg, _, err := TestProvider.client.GetOrg(context.Background())
 		if err != nil {
 			return fmt.Errorf("error retrieving org from external system: %w", err)
 		}
@@ -6,6 +6,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	commonmodels "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,15 +27,17 @@ var (
 
 // OrgTracingResourceModel holds the Terraform state for the resource.
 type OrgTracingResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	CplnID              types.String `tfsdk:"cpln_id"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	Tags                types.Map    `tfsdk:"tags"`
-	SelfLink            types.String `tfsdk:"self_link"`
-	LightstepTracing    types.List   `tfsdk:"lightstep_tracing"`
-	OtelTracing         types.List   `tfsdk:"otel_tracing"`
-	ControlPlaneTracing types.List   `tfsdk:"controlplane_tracing"`
+	ID                  types.String   `tfsdk:"id"`
+	CplnID              types.String   `tfsdk:"cpln_id"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	Tags                types.Map      `tfsdk:"tags"`
+	SelfLink            types.String   `tfsdk:"self_link"`
+	Org                 types.String   `tfsdk:"org"`
+	LightstepTracing    types.List     `tfsdk:"lightstep_tracing"`
+	OtelTracing         types.List     `tfsdk:"otel_tracing"`
+	ControlPlaneTracing types.List     `tfsdk:"controlplane_tracing"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -57,6 +60,9 @@ func (otr *OrgTracingResource) Configure(ctx context.Context, req resource.Confi
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (otr *OrgTracingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// The import ID is the name of the org to manage
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org"), types.StringValue(req.ID))...)
 }
 
 // Metadata provides the resource type name.
@@ -105,11 +111,13 @@ func (otr *OrgTracingResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org": otr.OrgSchema("Org Tracing"),
 		},
 		Blocks: map[string]schema.Block{
 			"lightstep_tracing":    otr.LightstepTracingSchema(),
 			"otel_tracing":         otr.OtelTracingSchema(),
 			"controlplane_tracing": otr.ControlPlaneTracingSchema(),
+			"timeouts":             otr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -129,6 +137,10 @@ func (otr *OrgTracingResource) ConfigValidators(ctx context.Context) []resource.
 
 // Create creates the resource.
 func (otr *OrgTracingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Bound the create operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Create, DefaultCreateTimeout)
+	defer cancel()
+
 	// Acquire lock to ensure only one operation modifies the resource at a time
 	orgOperationLock.Lock()
 	defer orgOperationLock.Unlock()
@@ -153,7 +165,7 @@ func (otr *OrgTracingResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Send the create request to the API client
-	responsePayload, _, err := otr.client.UpdateOrgTracing(tracing)
+	responsePayload, _, err := otr.client.WithOrg(plannedState.Org.ValueString()).UpdateOrgTracing(ctx, tracing)
 
 	// Handle any other errors that occurred during the API request
 	if err != nil {
@@ -175,6 +187,10 @@ func (otr *OrgTracingResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read fetches the current state of the resource.
 func (otr *OrgTracingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Bound the read operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Read, DefaultReadTimeout)
+	defer cancel()
+
 	var plannedState OrgTracingResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -186,11 +202,11 @@ func (otr *OrgTracingResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Fetch the org
-	responsePayload, code, err := otr.client.GetOrg()
+	responsePayload, _, err := otr.client.WithOrg(plannedState.Org.ValueString()).GetOrg(ctx)
 
 	// Handle the case where the org is not found (HTTP 404),
 	// indicating it has been deleted outside of Terraform. Remove it from state
-	if code == 404 {
+	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -215,6 +231,10 @@ func (otr *OrgTracingResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update modifies the resource.
 func (otr *OrgTracingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Bound the update operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Update, DefaultUpdateTimeout)
+	defer cancel()
+
 	// Acquire lock to ensure only one operation modifies the resource at a time
 	orgOperationLock.Lock()
 	defer orgOperationLock.Unlock()
@@ -238,7 +258,7 @@ func (otr *OrgTracingResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Send the update request to the API with the modified data
-	responsePayload, _, err := otr.client.UpdateOrgTracing(tracing)
+	responsePayload, _, err := otr.client.WithOrg(plannedState.Org.ValueString()).UpdateOrgTracing(ctx, tracing)
 
 	// Handle errors from the API update request
 	if err != nil {
@@ -260,6 +280,10 @@ func (otr *OrgTracingResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete removes the resource.
 func (otr *OrgTracingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Bound the delete operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Delete, DefaultDeleteTimeout)
+	defer cancel()
+
 	// Acquire lock to ensure only one operation modifies the resource at a time
 	orgOperationLock.Lock()
 	defer orgOperationLock.Unlock()
@@ -275,7 +299,7 @@ func (otr *OrgTracingResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Send a delete request to the API using the name from the state
-	_, _, err := otr.client.UpdateOrgTracing(nil)
+	_, _, err := otr.client.WithOrg(state.Org.ValueString()).UpdateOrgTracing(ctx, nil)
 
 	// Handle errors from the API delete request
 	if err != nil {
@@ -307,11 +331,12 @@ func (otr *OrgTracingResource) buildState(ctx context.Context, diags *diag.Diagn
 	state.Description = types.StringPointerValue(apiResp.Description)
 	state.Tags = FlattenTags(apiResp.Tags)
 	state.SelfLink = FlattenSelfLink(apiResp.Links)
+	state.Org = types.StringPointerValue(apiResp.Name)
 
 	// Only process tracing if Spec is non-nil
 	if apiResp.Spec != nil {
 		// Extract tracing configurations from spec
-		lightstepTracing, otelTracing, cplnTracing := FlattenTracing(ctx, diags, apiResp.Spec.Tracing, plan.LightstepTracing, otr.client.Org)
+		lightstepTracing, otelTracing, cplnTracing := FlattenTracing(ctx, diags, apiResp.Spec.Tracing, plan.LightstepTracing, state.Org.ValueString())
 
 		// Set specific attributes
 		state.LightstepTracing = lightstepTracing
@@ -323,6 +348,9 @@ func (otr *OrgTracingResource) buildState(ctx context.Context, diags *diag.Diagn
 		state.ControlPlaneTracing = types.ListNull(commonmodels.ControlPlaneTracingModel{}.AttributeTypes())
 	}
 
+	// Preserve the configured timeouts
+	state.Timeouts = plan.Timeouts
+
 	// Return completed state model
 	return state
 }
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -82,7 +83,7 @@ func (otrt *OrgTracingResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of org with name: %s", orgName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		org, _, _ := TestProvider.client.GetOrg()
+		org, _, _ := TestProvider.client.GetOrg(context.Background())
 
 		// Make sure the org has no tracing spec at all
 		if org.Spec.Tracing != nil {
@@ -115,6 +116,8 @@ func (otrt *OrgTracingResourceTest) NewLightstepTracingScenario() []resource.Tes
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -140,6 +143,8 @@ func (otrt *OrgTracingResourceTest) NewOtelTracingScenario() []resource.TestStep
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -165,6 +170,8 @@ func (otrt *OrgTracingResourceTest) NewCplnTracingScenario() []resource.TestStep
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -9,6 +9,7 @@ import (
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/policy"
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -27,20 +28,25 @@ import (
 var (
 	_ resource.Resource                = &PolicyResource{}
 	_ resource.ResourceWithImportState = &PolicyResource{}
+	_ resource.ResourceWithIdentity    = &PolicyResource{}
 )
 
+// policyEntityIdentity describes the identity of the policy entity.
+var policyEntityIdentity = EntityIdentity{Kind: "policy"}
+
 /*** Resource Model ***/
 
 // PolicyResourceModel holds the Terraform state for the resource.
 type PolicyResourceModel struct {
 	EntityBaseModel
-	TargetKind  types.String `tfsdk:"target_kind"`
-	Gvc         types.String `tfsdk:"gvc"`
-	TargetLinks types.Set    `tfsdk:"target_links"`
-	TargetQuery types.List   `tfsdk:"target_query"`
-	Target      types.String `tfsdk:"target"`
-	Origin      types.String `tfsdk:"origin"`
-	Binding     types.Set    `tfsdk:"binding"`
+	TargetKind  types.String   `tfsdk:"target_kind"`
+	Gvc         types.String   `tfsdk:"gvc"`
+	TargetLinks types.Set      `tfsdk:"target_links"`
+	TargetQuery types.List     `tfsdk:"target_query"`
+	Target      types.String   `tfsdk:"target"`
+	Origin      types.String   `tfsdk:"origin"`
+	Binding     types.Set      `tfsdk:"binding"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -60,10 +66,20 @@ func NewPolicyResource() resource.Resource {
 func (pr *PolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	pr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	pr.Operations = NewEntityOperations(pr.client, &PolicyResourceOperator{})
+	pr.Operations.Identity = &policyEntityIdentity
+
+	// Fields configured through attributes named differently
+	pr.Operations.APIFieldAttributes = map[string]string{"bindings": "binding"}
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (pr *PolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		policyEntityIdentity.ImportState(ctx, req, resp, pr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -72,6 +88,11 @@ func (pr *PolicyResource) Metadata(ctx context.Context, req resource.MetadataReq
 	resp.TypeName = "cpln_policy"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (pr *PolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = policyEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (pr *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -152,6 +173,7 @@ func (pr *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest
 					setvalidator.SizeAtMost(50),
 				},
 			},
+			"timeouts": pr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -263,31 +285,39 @@ func (pro *PolicyResourceOperator) MapResponseToState(apiResp *client.Policy, is
 		state.Gvc = pro.Plan.Gvc
 	}
 
+	// An imported policy has no GVC in state yet, recover it from the target links
+	if pro.Plan.IsImported() {
+		state.Gvc = pro.flattenGvcFromTargetLinks(apiResp.TargetKind, apiResp.TargetLinks)
+	}
+
 	// Set specific attributes
 	state.TargetKind = types.StringPointerValue(apiResp.TargetKind)
-	state.TargetLinks = pro.flattenTargetLinks(apiResp.TargetLinks, pro.Plan.TargetLinks)
+	state.TargetLinks = pro.flattenTargetLinks(apiResp.TargetLinks, pro.Plan.TargetLinks, state.Gvc)
 	state.TargetQuery = pro.FlattenQuery(apiResp.TargetQuery)
 	state.Target = types.StringPointerValue(apiResp.Target)
 	state.Origin = types.StringPointerValue(apiResp.Origin)
 	state.Binding = pro.flattenBinding(apiResp.Bindings)
 
+	// Preserve the configured timeouts
+	state.Timeouts = pro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (pro *PolicyResourceOperator) InvokeCreate(req client.Policy) (*client.Policy, int, error) {
-	return pro.Client.CreatePolicy(req)
+	return pro.Client.CreatePolicy(pro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (pro *PolicyResourceOperator) InvokeRead(name string) (*client.Policy, int, error) {
-	return pro.Client.GetPolicy(name)
+	return pro.Client.GetPolicy(pro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (pro *PolicyResourceOperator) InvokeUpdate(req client.Policy) (*client.Policy, int, error) {
-	return pro.Client.UpdatePolicy(client.PolicyUpdate{
+	return pro.Client.UpdatePolicy(pro.Ctx, client.PolicyUpdate{
 		Base:        req.Base,
 		TargetKind:  req.TargetKind,
 		TargetLinks: req.TargetLinks,
@@ -299,7 +329,7 @@ func (pro *PolicyResourceOperator) InvokeUpdate(req client.Policy) (*client.Poli
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (pro *PolicyResourceOperator) InvokeDelete(name string) error {
-	return pro.Client.DeletePolicy(name)
+	return pro.Client.DeletePolicy(pro.Ctx, name)
 }
 
 // Builders //
@@ -405,7 +435,7 @@ func (pro *PolicyResourceOperator) buildPrincipalLinks(state types.Set) *[]strin
 // Flatteners //
 
 // flattenTargetLinks converts API returned links into a Terraform set, preserving full paths when user originally specified them.
-func (pro *PolicyResourceOperator) flattenTargetLinks(input *[]string, state types.Set) types.Set {
+func (pro *PolicyResourceOperator) flattenTargetLinks(input *[]string, state types.Set, gvc types.String) types.Set {
 	// Return a null set if input list is nil
 	if input == nil {
 		return types.SetNull(types.StringType)
@@ -426,6 +456,15 @@ func (pro *PolicyResourceOperator) flattenTargetLinks(input *[]string, state typ
 		}
 	}
 
+	// An imported policy whose links span several GVCs keeps the links nested within a GVC in their full form
+	if pro.Plan.IsImported() && gvc.IsNull() {
+		for _, apiLink := range *input {
+			if parts, err := ParseSelfLink(apiLink); err == nil && parts.Gvc != "" {
+				fullPathSet[apiLink] = struct{}{}
+			}
+		}
+	}
+
 	// Prepare output slice with capacity matching number of API links
 	output := make([]string, 0, len(*input))
 
@@ -445,6 +484,35 @@ func (pro *PolicyResourceOperator) flattenTargetLinks(input *[]string, state typ
 	return FlattenSetString(&output)
 }
 
+// flattenGvcFromTargetLinks returns the GVC shared by the target links of a GVC scoped target kind, or null when the
+// target kind is not GVC scoped or the links span several GVCs.
+func (pro *PolicyResourceOperator) flattenGvcFromTargetLinks(kind *string, input *[]string) types.String {
+	// Only GVC scoped target kinds reference a GVC
+	if kind == nil || input == nil || len(*input) == 0 || !IsGvcScopedResource(*kind) {
+		return types.StringNull()
+	}
+
+	// Collect the GVC of every target link
+	var gvc string
+	for _, link := range *input {
+		// Skip links that cannot be parsed, they are kept as is
+		parts, err := ParseSelfLink(link)
+		if err != nil {
+			return types.StringNull()
+		}
+
+		// Links spanning several GVCs must be kept in their full form
+		if gvc != "" && parts.Gvc != gvc {
+			return types.StringNull()
+		}
+
+		gvc = parts.Gvc
+	}
+
+	// Return the shared GVC
+	return types.StringValue(gvc)
+}
+
 // flattenBinding transforms *[]client.Binding into a Terraform types.Set.
 func (pro *PolicyResourceOperator) flattenBinding(input *[]client.Binding) types.Set {
 	// Get attribute types
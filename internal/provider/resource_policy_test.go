@@ -1,10 +1,14 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
@@ -82,7 +86,7 @@ func (prt *PolicyResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of policy with name: %s", policyName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		policy, code, err := TestProvider.client.GetPolicy(policyName)
+		policy, code, err := TestProvider.client.GetPolicy(context.Background(), policyName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -126,6 +130,8 @@ func (prt *PolicyResourceTest) NewTargetSecretScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -152,6 +158,8 @@ func (prt *PolicyResourceTest) NewTargetWorkloadScenario() []resource.TestStep {
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -397,7 +405,7 @@ func (prtc *PolicyResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remotePolicy, _, err := TestProvider.client.GetPolicy(prtc.Name)
+		remotePolicy, _, err := TestProvider.client.GetPolicy(context.Background(), prtc.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving policy from external system: %w", err)
 		}
@@ -412,3 +420,66 @@ func (prtc *PolicyResourceTestCase) Exists() resource.TestCheckFunc {
 		return nil
 	}
 }
+
+/*** Unit Tests ***/
+
+// TestPolicyImportTargetLinks verifies an imported policy recovers its GVC and flattens its target links to names.
+func TestPolicyImportTargetLinks(t *testing.T) {
+	// Build an operator holding the state of a freshly imported policy
+	diags := diag.Diagnostics{}
+	pro := &PolicyResourceOperator{}
+	pro.Init(context.Background(), &diags, &client.Client{Org: "my-org"}, PolicyResourceModel{
+		EntityBaseModel: EntityBaseModel{ID: types.StringValue("policy"), Name: types.StringNull()},
+		Gvc:             types.StringNull(),
+		TargetLinks:     types.SetNull(types.StringType),
+	})
+
+	// Define the table of cases
+	cases := []struct {
+		name      string
+		kind      string
+		links     []string
+		wantGvc   types.String
+		wantLinks []string
+	}{
+		{
+			name:      "links within a single gvc",
+			kind:      "workload",
+			links:     []string{"/org/my-org/gvc/gvc-01/workload/a", "/org/my-org/gvc/gvc-01/workload/b"},
+			wantGvc:   types.StringValue("gvc-01"),
+			wantLinks: []string{"a", "b"},
+		},
+		{
+			name:      "links spanning several gvcs",
+			kind:      "workload",
+			links:     []string{"/org/my-org/gvc/gvc-01/workload/a", "/org/my-org/gvc/gvc-02/workload/b"},
+			wantGvc:   types.StringNull(),
+			wantLinks: []string{"/org/my-org/gvc/gvc-01/workload/a", "/org/my-org/gvc/gvc-02/workload/b"},
+		},
+		{
+			name:      "links of a kind outside a gvc",
+			kind:      "secret",
+			links:     []string{"/org/my-org/secret/a"},
+			wantGvc:   types.StringNull(),
+			wantLinks: []string{"a"},
+		},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Recover the GVC
+			gvc := pro.flattenGvcFromTargetLinks(&tc.kind, &tc.links)
+			if !gvc.Equal(tc.wantGvc) {
+				t.Fatalf("gvc = %s, want %s", gvc, tc.wantGvc)
+			}
+
+			// Flatten the target links
+			links := pro.flattenTargetLinks(&tc.links, types.SetNull(types.StringType), gvc)
+			if want := FlattenSetString(&tc.wantLinks); !links.Equal(want) {
+				t.Fatalf("target links = %s, want %s", links, want)
+			}
+		})
+	}
+}
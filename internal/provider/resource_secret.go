@@ -11,11 +11,14 @@ import (
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/secret"
 	modifiers "github.com/controlplane-com/terraform-provider-cpln/internal/provider/modifiers"
 	validators "github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -36,27 +40,32 @@ var secretAttributeNames = []string{
 var (
 	_ resource.Resource                = &SecretResource{}
 	_ resource.ResourceWithImportState = &SecretResource{}
+	_ resource.ResourceWithIdentity    = &SecretResource{}
 )
 
+// secretEntityIdentity describes the identity of the secret entity.
+var secretEntityIdentity = EntityIdentity{Kind: "secret"}
+
 /*** Resource Model ***/
 
 // SecretResourceModel holds the Terraform state for the resource.
 type SecretResourceModel struct {
 	EntityBaseModel
-	Opaque           []models.OpaqueModel         `tfsdk:"opaque"`
-	TLS              []models.TlsModel            `tfsdk:"tls"`
-	GCP              types.String                 `tfsdk:"gcp"`
-	AWS              []models.AwsModel            `tfsdk:"aws"`
-	ECR              []models.EcrModel            `tfsdk:"ecr"`
-	Docker           types.String                 `tfsdk:"docker"`
-	UsernamePassword []models.UserpassModel       `tfsdk:"userpass"`
-	KeyPair          []models.KeyPairModel        `tfsdk:"keypair"`
-	Dictionary       types.Map                    `tfsdk:"dictionary"`
-	DictionaryAsEnvs types.Map                    `tfsdk:"dictionary_as_envs"`
-	AzureSdk         types.String                 `tfsdk:"azure_sdk"`
-	AzureConnector   []models.AzureConnectorModel `tfsdk:"azure_connector"`
-	NatsAccount      []models.NatsAccountModel    `tfsdk:"nats_account"`
-	SecretLink       types.String                 `tfsdk:"secret_link"`
+	Opaque           []models.OpaqueResourceModel         `tfsdk:"opaque"`
+	TLS              []models.TlsResourceModel            `tfsdk:"tls"`
+	GCP              types.String                         `tfsdk:"gcp"`
+	AWS              []models.AwsResourceModel            `tfsdk:"aws"`
+	ECR              []models.EcrResourceModel            `tfsdk:"ecr"`
+	Docker           types.String                         `tfsdk:"docker"`
+	UsernamePassword []models.UserpassResourceModel       `tfsdk:"userpass"`
+	KeyPair          []models.KeyPairResourceModel        `tfsdk:"keypair"`
+	Dictionary       types.Map                            `tfsdk:"dictionary"`
+	DictionaryAsEnvs types.Map                            `tfsdk:"dictionary_as_envs"`
+	AzureSdk         types.String                         `tfsdk:"azure_sdk"`
+	AzureConnector   []models.AzureConnectorResourceModel `tfsdk:"azure_connector"`
+	NatsAccount      []models.NatsAccountResourceModel    `tfsdk:"nats_account"`
+	SecretLink       types.String                         `tfsdk:"secret_link"`
+	Timeouts         timeouts.Value                       `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -76,10 +85,20 @@ func NewSecretResource() resource.Resource {
 func (sr *SecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	sr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	sr.Operations = NewEntityOperations(sr.client, NewSecretResourceOperator())
+	sr.Operations.Identity = &secretEntityIdentity
+
+	// The revealed data is never digested into the private state, keeping the write-only values out of it
+	sr.Operations.DriftIgnoredPaths = []string{"data"}
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (sr *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		secretEntityIdentity.ImportState(ctx, req, resp, sr.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -88,6 +107,11 @@ func (sr *SecretResource) Metadata(ctx context.Context, req resource.MetadataReq
 	resp.TypeName = "cpln_secret"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (sr *SecretResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = secretEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -141,11 +165,14 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"opaque": schema.ListNestedBlock{
 				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#opaque).",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: MergeAttributes(map[string]schema.Attribute{
 						"payload": schema.StringAttribute{
 							Description: "Plain text or base64 encoded string. Use `encoding` attribute to specify encoding.",
-							Required:    true,
+							Optional:    true,
 							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("payload_wo")),
+							},
 						},
 						"encoding": schema.StringAttribute{
 							Description: "Available encodings: `plain`, `base64`. Default: `plain`.",
@@ -156,7 +183,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 								stringvalidator.OneOf("plain", "base64"),
 							},
 						},
-					},
+					}, sr.writeOnlyAttributes("payload")),
 				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -165,11 +192,14 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"tls": schema.ListNestedBlock{
 				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#tls).",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: MergeAttributes(map[string]schema.Attribute{
 						"key": schema.StringAttribute{
 							Description: "Private Certificate.",
 							Optional:    true,
 							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("key_wo")),
+							},
 						},
 						"cert": schema.StringAttribute{
 							Description: "Public Certificate.",
@@ -179,7 +209,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 							Description: "Chain Certificate.",
 							Optional:    true,
 						},
-					},
+					}, sr.writeOnlyAttributes("key")),
 				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -188,7 +218,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"aws": schema.ListNestedBlock{
 				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#aws).",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: MergeAttributes(map[string]schema.Attribute{
 						"access_key": schema.StringAttribute{
 							Description: "Access Key provided by AWS.",
 							Required:    true,
@@ -199,8 +229,11 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 						},
 						"secret_key": schema.StringAttribute{
 							Description: "Secret Key provided by AWS.",
-							Required:    true,
+							Optional:    true,
 							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("secret_key_wo")),
+							},
 						},
 						"role_arn": schema.StringAttribute{
 							Description: "Role ARN provided by AWS.",
@@ -214,7 +247,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 							Description: "AWS IAM Role External ID.",
 							Optional:    true,
 						},
-					},
+					}, sr.writeOnlyAttributes("secret_key")),
 				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -223,7 +256,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"ecr": schema.ListNestedBlock{
 				MarkdownDescription: "",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: MergeAttributes(map[string]schema.Attribute{
 						"access_key": schema.StringAttribute{
 							Description: "Access Key provided by AWS.",
 							Required:    true,
@@ -234,8 +267,11 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 						},
 						"secret_key": schema.StringAttribute{
 							Description: "Secret Key provided by AWS.",
-							Required:    true,
+							Optional:    true,
 							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("secret_key_wo")),
+							},
 						},
 						"role_arn": schema.StringAttribute{
 							Description: "Role ARN provided by AWS.",
@@ -258,7 +294,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 								setvalidator.SizeAtMost(20),
 							},
 						},
-					},
+					}, sr.writeOnlyAttributes("secret_key")),
 				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -267,7 +303,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"userpass": schema.ListNestedBlock{
 				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#username).",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: MergeAttributes(map[string]schema.Attribute{
 						"username": schema.StringAttribute{
 							Description: "Username.",
 							Required:    true,
@@ -277,9 +313,10 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 						},
 						"password": schema.StringAttribute{
 							Description: "Password.",
-							Required:    true,
+							Optional:    true,
 							Sensitive:   true,
 							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password_wo")),
 								stringvalidator.LengthAtLeast(1),
 							},
 						},
@@ -292,7 +329,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 								stringvalidator.OneOf("plain", "base64"),
 							},
 						},
-					},
+					}, sr.writeOnlyAttributes("password", stringvalidator.LengthAtLeast(1))),
 				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -301,11 +338,14 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"keypair": schema.ListNestedBlock{
 				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#keypair).",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: MergeAttributes(map[string]schema.Attribute{
 						"secret_key": schema.StringAttribute{
 							Description: "Secret/Private Key.",
-							Required:    true,
+							Optional:    true,
 							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("secret_key_wo")),
+							},
 						},
 						"public_key": schema.StringAttribute{
 							Description: "Public Key.",
@@ -316,7 +356,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 							Optional:    true,
 							Sensitive:   true,
 						},
-					},
+					}, sr.writeOnlyAttributes("secret_key")),
 				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -325,7 +365,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"azure_connector": schema.ListNestedBlock{
 				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#azure-connector).",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: MergeAttributes(map[string]schema.Attribute{
 						"url": schema.StringAttribute{
 							Description: "Deployment URL.",
 							Required:    true,
@@ -333,10 +373,13 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 						},
 						"code": schema.StringAttribute{
 							Description: "Code/Key to authenticate to deployment URL.",
-							Required:    true,
+							Optional:    true,
 							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("code_wo")),
+							},
 						},
-					},
+					}, sr.writeOnlyAttributes("code")),
 				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -345,7 +388,7 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"nats_account": schema.ListNestedBlock{
 				MarkdownDescription: "[Reference Page](https://docs.controlplane.com/reference/secret#nats-account).",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: MergeAttributes(map[string]schema.Attribute{
 						"account_id": schema.StringAttribute{
 							Description: "Account ID. Must be a 56-character NATS account public key beginning with `A`.",
 							Required:    true,
@@ -358,21 +401,26 @@ func (sr *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest
 						},
 						"private_key": schema.StringAttribute{
 							Description: "Private Key. Must be a 58-character NATS account seed beginning with `SA`.",
-							Required:    true,
+							Optional:    true,
 							Sensitive:   true,
 							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("private_key_wo")),
 								stringvalidator.RegexMatches(
 									regexp.MustCompile(`^SA[A-Z0-9]{56}$`),
 									"must be a 58-character NATS account seed beginning with `SA`",
 								),
 							},
 						},
-					},
+					}, sr.writeOnlyAttributes("private_key", stringvalidator.RegexMatches(
+						regexp.MustCompile(`^SA[A-Z0-9]{56}$`),
+						"must be a 58-character NATS account seed beginning with `SA`",
+					))),
 				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"timeouts": sr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -393,7 +441,7 @@ func (mr *SecretResource) ConfigValidators(ctx context.Context) []resource.Confi
 
 // Create creates the resource.
 func (sr *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	CreateGeneric(ctx, req, resp, sr.Operations)
+	CreateGeneric(ctx, req, resp, sr.withWriteOnlyValues(ctx, req.Config, &resp.Diagnostics))
 }
 
 // Read fetches the current state of the resource.
@@ -403,7 +451,7 @@ func (sr *SecretResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update modifies the resource.
 func (sr *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	UpdateGeneric(ctx, req, resp, sr.Operations)
+	UpdateGeneric(ctx, req, resp, sr.withWriteOnlyValues(ctx, req.Config, &resp.Diagnostics))
 }
 
 // Delete removes the resource.
@@ -411,6 +459,99 @@ func (sr *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest
 	DeleteGeneric(ctx, req, resp, sr.Operations)
 }
 
+// Helpers //
+
+// writeOnlyAttributes returns the write-only variant of a sensitive attribute along with the version attribute that
+// triggers sending its value to the API.
+func (sr *SecretResource) writeOnlyAttributes(name string, validators ...validator.String) map[string]schema.Attribute {
+	// Derive the attribute names from the sensitive attribute
+	writeOnlyName := fmt.Sprintf("%s_wo", name)
+	versionName := fmt.Sprintf("%s_version", writeOnlyName)
+
+	return map[string]schema.Attribute{
+		writeOnlyName: schema.StringAttribute{
+			Description: fmt.Sprintf("Write-only alternative to `%s`, sent to the API but never stored in the plan or state. Must be set along with `%s`. Requires Terraform 1.11 or later.", name, versionName),
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: append([]validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(versionName)),
+			}, validators...),
+		},
+		versionName: schema.Int32Attribute{
+			Description: fmt.Sprintf("Version of `%s`. Change it whenever `%s` changes so the new value is sent to the API.", writeOnlyName, writeOnlyName),
+			Optional:    true,
+			Validators: []validator.Int32{
+				int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName(writeOnlyName)),
+			},
+		},
+	}
+}
+
+// withWriteOnlyValues returns the entity operations with the write-only values of the configuration injected into the
+// plan handed to the operator, since Terraform never includes them in the plan itself.
+func (sr *SecretResource) withWriteOnlyValues(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) EntityOperations[SecretResourceModel, client.Secret] {
+	// Read the configuration, the only place where write-only values are available
+	var configModel SecretResourceModel
+	diags.Append(config.Get(ctx, &configModel)...)
+
+	// Copy the operations so the shared instance is left untouched
+	operations := sr.Operations
+	newOperator := operations.NewOperator
+
+	// Merge the write-only values into the plan before the operator is created
+	operations.NewOperator = func(ctx context.Context, diags *diag.Diagnostics, plan SecretResourceModel) EntityOperatorInterface[SecretResourceModel, client.Secret] {
+		return newOperator(ctx, diags, sr.mergeWriteOnlyValues(plan, configModel))
+	}
+
+	return operations
+}
+
+// mergeWriteOnlyValues copies the write-only values of the configuration into the plan.
+func (sr *SecretResource) mergeWriteOnlyValues(plan SecretResourceModel, config SecretResourceModel) SecretResourceModel {
+	// Opaque payload
+	if len(plan.Opaque) != 0 && len(config.Opaque) != 0 {
+		plan.Opaque[0].PayloadWo = config.Opaque[0].PayloadWo
+	}
+
+	// TLS key
+	if len(plan.TLS) != 0 && len(config.TLS) != 0 {
+		plan.TLS[0].KeyWo = config.TLS[0].KeyWo
+	}
+
+	// AWS secret key
+	if len(plan.AWS) != 0 && len(config.AWS) != 0 {
+		plan.AWS[0].SecretKeyWo = config.AWS[0].SecretKeyWo
+	}
+
+	// ECR secret key
+	if len(plan.ECR) != 0 && len(config.ECR) != 0 {
+		plan.ECR[0].SecretKeyWo = config.ECR[0].SecretKeyWo
+	}
+
+	// Userpass password
+	if len(plan.UsernamePassword) != 0 && len(config.UsernamePassword) != 0 {
+		plan.UsernamePassword[0].PasswordWo = config.UsernamePassword[0].PasswordWo
+	}
+
+	// Keypair secret key
+	if len(plan.KeyPair) != 0 && len(config.KeyPair) != 0 {
+		plan.KeyPair[0].SecretKeyWo = config.KeyPair[0].SecretKeyWo
+	}
+
+	// Azure connector code
+	if len(plan.AzureConnector) != 0 && len(config.AzureConnector) != 0 {
+		plan.AzureConnector[0].CodeWo = config.AzureConnector[0].CodeWo
+	}
+
+	// NATS account private key
+	if len(plan.NatsAccount) != 0 && len(config.NatsAccount) != 0 {
+		plan.NatsAccount[0].PrivateKeyWo = config.NatsAccount[0].PrivateKeyWo
+	}
+
+	return plan
+}
+
 /*** Resource Operator ***/
 
 // SecretResourceOperator is the operator for managing the state.
@@ -680,28 +821,31 @@ func (sro *SecretResourceOperator) MapResponseToState(apiResp *client.Secret, is
 		}
 	}
 
+	// Preserve the configured timeouts
+	state.Timeouts = sro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (sro *SecretResourceOperator) InvokeCreate(req client.Secret) (*client.Secret, int, error) {
-	return sro.Client.CreateSecret(req)
+	return sro.Client.CreateSecret(sro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (sro *SecretResourceOperator) InvokeRead(name string) (*client.Secret, int, error) {
-	return sro.Client.GetSecret(name)
+	return sro.Client.GetSecret(sro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (sro *SecretResourceOperator) InvokeUpdate(req client.Secret) (*client.Secret, int, error) {
-	return sro.Client.UpdateSecret(req)
+	return sro.Client.UpdateSecret(sro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (sro *SecretResourceOperator) InvokeDelete(name string) error {
-	return sro.Client.DeleteSecret(name)
+	return sro.Client.DeleteSecret(sro.Ctx, name)
 }
 
 // Builders //
@@ -773,7 +917,7 @@ func (op *SecretResourceOperator) buildData(state SecretResourceModel, secretTyp
 }
 
 // buildOpaque constructs a map from the given Terraform state.
-func (sro *SecretResourceOperator) buildOpaque(state []models.OpaqueModel) *map[string]interface{} {
+func (sro *SecretResourceOperator) buildOpaque(state []models.OpaqueResourceModel) *map[string]interface{} {
 	// Return nil if state is not specified
 	if len(state) == 0 {
 		return nil
@@ -784,13 +928,13 @@ func (sro *SecretResourceOperator) buildOpaque(state []models.OpaqueModel) *map[
 
 	// Construct and return the output
 	return &map[string]interface{}{
-		"payload":  BuildString(block.Payload),
+		"payload":  sro.buildSensitiveString(block.Payload, block.PayloadWo),
 		"encoding": BuildString(block.Encoding),
 	}
 }
 
 // buildTls constructs a map from the given Terraform state.
-func (sro *SecretResourceOperator) buildTls(state []models.TlsModel) *map[string]interface{} {
+func (sro *SecretResourceOperator) buildTls(state []models.TlsResourceModel) *map[string]interface{} {
 	// Return nil if state is not specified
 	if len(state) == 0 {
 		return nil
@@ -805,7 +949,7 @@ func (sro *SecretResourceOperator) buildTls(state []models.TlsModel) *map[string
 	}
 
 	// Set key if specified
-	if key := BuildString(block.Key); key != nil {
+	if key := sro.buildSensitiveString(block.Key, block.KeyWo); key != nil {
 		output["key"] = key
 	}
 
@@ -819,7 +963,7 @@ func (sro *SecretResourceOperator) buildTls(state []models.TlsModel) *map[string
 }
 
 // buildAws constructs a map from the given Terraform state.
-func (sro *SecretResourceOperator) buildAws(state []models.AwsModel) *map[string]interface{} {
+func (sro *SecretResourceOperator) buildAws(state []models.AwsResourceModel) *map[string]interface{} {
 	// Return nil if state is not specified
 	if len(state) == 0 {
 		return nil
@@ -831,7 +975,7 @@ func (sro *SecretResourceOperator) buildAws(state []models.AwsModel) *map[string
 	// Construct the output
 	output := map[string]interface{}{
 		"accessKey": BuildString(block.AccessKey),
-		"secretKey": BuildString(block.SecretKey),
+		"secretKey": sro.buildSensitiveString(block.SecretKey, block.SecretKeyWo),
 	}
 
 	// Set roleArn if specified
@@ -849,7 +993,7 @@ func (sro *SecretResourceOperator) buildAws(state []models.AwsModel) *map[string
 }
 
 // buildEcr constructs a map from the given Terraform state.
-func (sro *SecretResourceOperator) buildEcr(state []models.EcrModel) *map[string]interface{} {
+func (sro *SecretResourceOperator) buildEcr(state []models.EcrResourceModel) *map[string]interface{} {
 	// Return nil if state is not specified
 	if len(state) == 0 {
 		return nil
@@ -861,7 +1005,7 @@ func (sro *SecretResourceOperator) buildEcr(state []models.EcrModel) *map[string
 	// Construct the output
 	output := map[string]interface{}{
 		"accessKey": BuildString(block.AccessKey),
-		"secretKey": BuildString(block.SecretKey),
+		"secretKey": sro.buildSensitiveString(block.SecretKey, block.SecretKeyWo),
 		"repos":     sro.BuildSetString(block.Repos),
 	}
 
@@ -880,7 +1024,7 @@ func (sro *SecretResourceOperator) buildEcr(state []models.EcrModel) *map[string
 }
 
 // buildUserpass constructs a map from the given Terraform state.
-func (sro *SecretResourceOperator) buildUserpass(state []models.UserpassModel) *map[string]interface{} {
+func (sro *SecretResourceOperator) buildUserpass(state []models.UserpassResourceModel) *map[string]interface{} {
 	// Return nil if state is not specified
 	if len(state) == 0 {
 		return nil
@@ -892,13 +1036,13 @@ func (sro *SecretResourceOperator) buildUserpass(state []models.UserpassModel) *
 	// Construct and return the output
 	return &map[string]interface{}{
 		"username": BuildString(block.Username),
-		"password": BuildString(block.Password),
+		"password": sro.buildSensitiveString(block.Password, block.PasswordWo),
 		"encoding": BuildString(block.Encoding),
 	}
 }
 
 // buildKeypair constructs a map from the given Terraform state.
-func (sro *SecretResourceOperator) buildKeypair(state []models.KeyPairModel) *map[string]interface{} {
+func (sro *SecretResourceOperator) buildKeypair(state []models.KeyPairResourceModel) *map[string]interface{} {
 	// Return nil if state is not specified
 	if len(state) == 0 {
 		return nil
@@ -909,7 +1053,7 @@ func (sro *SecretResourceOperator) buildKeypair(state []models.KeyPairModel) *ma
 
 	// Construct the output
 	output := map[string]interface{}{
-		"secretKey": BuildString(block.SecretKey),
+		"secretKey": sro.buildSensitiveString(block.SecretKey, block.SecretKeyWo),
 	}
 
 	// Set the publicKey if specified
@@ -927,7 +1071,7 @@ func (sro *SecretResourceOperator) buildKeypair(state []models.KeyPairModel) *ma
 }
 
 // buildAzureConnector constructs a map from the given Terraform state.
-func (sro *SecretResourceOperator) buildAzureConnector(state []models.AzureConnectorModel) *map[string]interface{} {
+func (sro *SecretResourceOperator) buildAzureConnector(state []models.AzureConnectorResourceModel) *map[string]interface{} {
 	// Return nil if state is not specified
 	if len(state) == 0 {
 		return nil
@@ -939,12 +1083,12 @@ func (sro *SecretResourceOperator) buildAzureConnector(state []models.AzureConne
 	// Construct and return the output
 	return &map[string]interface{}{
 		"url":  BuildString(block.Url),
-		"code": BuildString(block.Code),
+		"code": sro.buildSensitiveString(block.Code, block.CodeWo),
 	}
 }
 
 // buildNatsAccount constructs a map from the given Terraform state.
-func (sro *SecretResourceOperator) buildNatsAccount(state []models.NatsAccountModel) *map[string]interface{} {
+func (sro *SecretResourceOperator) buildNatsAccount(state []models.NatsAccountResourceModel) *map[string]interface{} {
 	// Return nil if state is not specified
 	if len(state) == 0 {
 		return nil
@@ -956,39 +1100,60 @@ func (sro *SecretResourceOperator) buildNatsAccount(state []models.NatsAccountMo
 	// Construct and return the output
 	return &map[string]interface{}{
 		"accountId":  BuildString(block.AccountId),
-		"privateKey": BuildString(block.PrivateKey),
+		"privateKey": sro.buildSensitiveString(block.PrivateKey, block.PrivateKeyWo),
 	}
 }
 
+// buildSensitiveString returns the write-only value when one is configured, falling back to the value kept in the state.
+func (sro *SecretResourceOperator) buildSensitiveString(value types.String, writeOnlyValue types.String) *string {
+	// Prefer the write-only value
+	if writeOnly := BuildString(writeOnlyValue); writeOnly != nil {
+		return writeOnly
+	}
+
+	// Fall back to the regular value
+	return BuildString(value)
+}
+
 // Flatteners //
 
-// flattenOpaque transforms *interface{} into a []models.OpaqueModel.
-func (sro *SecretResourceOperator) flattenOpaque(input map[string]interface{}) []models.OpaqueModel {
+// flattenOpaque transforms *interface{} into a []models.OpaqueResourceModel.
+func (sro *SecretResourceOperator) flattenOpaque(input map[string]interface{}) []models.OpaqueResourceModel {
 	// Check if the input is nil
 	if input == nil {
 		return nil
 	}
 
 	// Build a single block
-	block := models.OpaqueModel{
-		Payload:  types.StringValue(input["payload"].(string)),
-		Encoding: types.StringValue(input["encoding"].(string)),
+	block := models.OpaqueResourceModel{
+		OpaqueModel: models.OpaqueModel{
+			Payload:  types.StringValue(input["payload"].(string)),
+			Encoding: types.StringValue(input["encoding"].(string)),
+		},
+	}
+
+	// Keep the payload out of the state when it is managed through its write-only attribute
+	if len(sro.Plan.Opaque) != 0 && !sro.Plan.Opaque[0].PayloadWoVersion.IsNull() {
+		block.Payload = types.StringNull()
+		block.PayloadWoVersion = sro.Plan.Opaque[0].PayloadWoVersion
 	}
 
 	// Return a slice containing the single block
-	return []models.OpaqueModel{block}
+	return []models.OpaqueResourceModel{block}
 }
 
-// flattenTls transforms *interface{} into a []models.TlsModel.
-func (sro *SecretResourceOperator) flattenTls(input map[string]interface{}) []models.TlsModel {
+// flattenTls transforms *interface{} into a []models.TlsResourceModel.
+func (sro *SecretResourceOperator) flattenTls(input map[string]interface{}) []models.TlsResourceModel {
 	// Check if the input is nil
 	if input == nil {
 		return nil
 	}
 
 	// Build a single block
-	block := models.TlsModel{
-		Cert: types.StringValue(input["cert"].(string)),
+	block := models.TlsResourceModel{
+		TlsModel: models.TlsModel{
+			Cert: types.StringValue(input["cert"].(string)),
+		},
 	}
 
 	// Set key if specified
@@ -1001,21 +1166,29 @@ func (sro *SecretResourceOperator) flattenTls(input map[string]interface{}) []mo
 		block.Chain = types.StringValue(chain.(string))
 	}
 
+	// Keep the key out of the state when it is managed through its write-only attribute
+	if len(sro.Plan.TLS) != 0 && !sro.Plan.TLS[0].KeyWoVersion.IsNull() {
+		block.Key = types.StringNull()
+		block.KeyWoVersion = sro.Plan.TLS[0].KeyWoVersion
+	}
+
 	// Return a slice containing the single block
-	return []models.TlsModel{block}
+	return []models.TlsResourceModel{block}
 }
 
-// flattenAws transforms *interface{} into a []models.AwsModel.
-func (sro *SecretResourceOperator) flattenAws(input map[string]interface{}) []models.AwsModel {
+// flattenAws transforms *interface{} into a []models.AwsResourceModel.
+func (sro *SecretResourceOperator) flattenAws(input map[string]interface{}) []models.AwsResourceModel {
 	// Check if the input is nil
 	if input == nil {
 		return nil
 	}
 
 	// Build a single block
-	block := models.AwsModel{
-		AccessKey: types.StringValue(input["accessKey"].(string)),
-		SecretKey: types.StringValue(input["secretKey"].(string)),
+	block := models.AwsResourceModel{
+		AwsModel: models.AwsModel{
+			AccessKey: types.StringValue(input["accessKey"].(string)),
+			SecretKey: types.StringValue(input["secretKey"].(string)),
+		},
 	}
 
 	// Set roleArn if specified
@@ -1028,12 +1201,18 @@ func (sro *SecretResourceOperator) flattenAws(input map[string]interface{}) []mo
 		block.ExternalId = types.StringValue(externalId.(string))
 	}
 
+	// Keep the secret key out of the state when it is managed through its write-only attribute
+	if len(sro.Plan.AWS) != 0 && !sro.Plan.AWS[0].SecretKeyWoVersion.IsNull() {
+		block.SecretKey = types.StringNull()
+		block.SecretKeyWoVersion = sro.Plan.AWS[0].SecretKeyWoVersion
+	}
+
 	// Return a slice containing the single block
-	return []models.AwsModel{block}
+	return []models.AwsResourceModel{block}
 }
 
-// flattenEcr transforms *interface{} into a []models.EcrModel.
-func (sro *SecretResourceOperator) flattenEcr(input map[string]interface{}) []models.EcrModel {
+// flattenEcr transforms *interface{} into a []models.EcrResourceModel.
+func (sro *SecretResourceOperator) flattenEcr(input map[string]interface{}) []models.EcrResourceModel {
 	// Check if the input is nil
 	if input == nil {
 		return nil
@@ -1042,10 +1221,12 @@ func (sro *SecretResourceOperator) flattenEcr(input map[string]interface{}) []mo
 	repos := ToStringSlice(input["repos"].([]interface{}))
 
 	// Build a single block
-	block := models.EcrModel{
-		AccessKey: types.StringValue(input["accessKey"].(string)),
-		SecretKey: types.StringValue(input["secretKey"].(string)),
-		Repos:     FlattenSetString(&repos),
+	block := models.EcrResourceModel{
+		EcrModel: models.EcrModel{
+			AccessKey: types.StringValue(input["accessKey"].(string)),
+			SecretKey: types.StringValue(input["secretKey"].(string)),
+			Repos:     FlattenSetString(&repos),
+		},
 	}
 
 	// Set roleArn if specified
@@ -1058,38 +1239,54 @@ func (sro *SecretResourceOperator) flattenEcr(input map[string]interface{}) []mo
 		block.ExternalId = types.StringValue(externalId.(string))
 	}
 
+	// Keep the secret key out of the state when it is managed through its write-only attribute
+	if len(sro.Plan.ECR) != 0 && !sro.Plan.ECR[0].SecretKeyWoVersion.IsNull() {
+		block.SecretKey = types.StringNull()
+		block.SecretKeyWoVersion = sro.Plan.ECR[0].SecretKeyWoVersion
+	}
+
 	// Return a slice containing the single block
-	return []models.EcrModel{block}
+	return []models.EcrResourceModel{block}
 }
 
-// flattenUserpass transforms *interface{} into a []models.UserpassModel.
-func (sro *SecretResourceOperator) flattenUserpass(input map[string]interface{}) []models.UserpassModel {
+// flattenUserpass transforms *interface{} into a []models.UserpassResourceModel.
+func (sro *SecretResourceOperator) flattenUserpass(input map[string]interface{}) []models.UserpassResourceModel {
 	// Check if the input is nil
 	if input == nil {
 		return nil
 	}
 
 	// Build a single block
-	block := models.UserpassModel{
-		Username: types.StringValue(input["username"].(string)),
-		Password: types.StringValue(input["password"].(string)),
-		Encoding: types.StringValue(input["encoding"].(string)),
+	block := models.UserpassResourceModel{
+		UserpassModel: models.UserpassModel{
+			Username: types.StringValue(input["username"].(string)),
+			Password: types.StringValue(input["password"].(string)),
+			Encoding: types.StringValue(input["encoding"].(string)),
+		},
+	}
+
+	// Keep the password out of the state when it is managed through its write-only attribute
+	if len(sro.Plan.UsernamePassword) != 0 && !sro.Plan.UsernamePassword[0].PasswordWoVersion.IsNull() {
+		block.Password = types.StringNull()
+		block.PasswordWoVersion = sro.Plan.UsernamePassword[0].PasswordWoVersion
 	}
 
 	// Return a slice containing the single block
-	return []models.UserpassModel{block}
+	return []models.UserpassResourceModel{block}
 }
 
-// flattenKeyPair transforms *interface{} into a []models.KeyPairModel.
-func (sro *SecretResourceOperator) flattenKeyPair(input map[string]interface{}) []models.KeyPairModel {
+// flattenKeyPair transforms *interface{} into a []models.KeyPairResourceModel.
+func (sro *SecretResourceOperator) flattenKeyPair(input map[string]interface{}) []models.KeyPairResourceModel {
 	// Check if the input is nil
 	if input == nil {
 		return nil
 	}
 
 	// Build a single block
-	block := models.KeyPairModel{
-		SecretKey: types.StringValue(input["secretKey"].(string)),
+	block := models.KeyPairResourceModel{
+		KeyPairModel: models.KeyPairModel{
+			SecretKey: types.StringValue(input["secretKey"].(string)),
+		},
 	}
 
 	// Set publicKey if specified
@@ -1102,40 +1299,62 @@ func (sro *SecretResourceOperator) flattenKeyPair(input map[string]interface{})
 		block.Passphrase = types.StringValue(passphrase.(string))
 	}
 
+	// Keep the secret key out of the state when it is managed through its write-only attribute
+	if len(sro.Plan.KeyPair) != 0 && !sro.Plan.KeyPair[0].SecretKeyWoVersion.IsNull() {
+		block.SecretKey = types.StringNull()
+		block.SecretKeyWoVersion = sro.Plan.KeyPair[0].SecretKeyWoVersion
+	}
+
 	// Return a slice containing the single block
-	return []models.KeyPairModel{block}
+	return []models.KeyPairResourceModel{block}
 }
 
-// flattenAzureConnector transforms *interface{} into a []models.AzureConnectorModel.
-func (sro *SecretResourceOperator) flattenAzureConnector(input map[string]interface{}) []models.AzureConnectorModel {
+// flattenAzureConnector transforms *interface{} into a []models.AzureConnectorResourceModel.
+func (sro *SecretResourceOperator) flattenAzureConnector(input map[string]interface{}) []models.AzureConnectorResourceModel {
 	// Check if the input is nil
 	if input == nil {
 		return nil
 	}
 
 	// Build a single block
-	block := models.AzureConnectorModel{
-		Url:  types.StringValue(input["url"].(string)),
-		Code: types.StringValue(input["code"].(string)),
+	block := models.AzureConnectorResourceModel{
+		AzureConnectorModel: models.AzureConnectorModel{
+			Url:  types.StringValue(input["url"].(string)),
+			Code: types.StringValue(input["code"].(string)),
+		},
+	}
+
+	// Keep the code out of the state when it is managed through its write-only attribute
+	if len(sro.Plan.AzureConnector) != 0 && !sro.Plan.AzureConnector[0].CodeWoVersion.IsNull() {
+		block.Code = types.StringNull()
+		block.CodeWoVersion = sro.Plan.AzureConnector[0].CodeWoVersion
 	}
 
 	// Return a slice containing the single block
-	return []models.AzureConnectorModel{block}
+	return []models.AzureConnectorResourceModel{block}
 }
 
-// flattenNatsAccount transforms *interface{} into a []models.NatsAccountModel.
-func (sro *SecretResourceOperator) flattenNatsAccount(input map[string]interface{}) []models.NatsAccountModel {
+// flattenNatsAccount transforms *interface{} into a []models.NatsAccountResourceModel.
+func (sro *SecretResourceOperator) flattenNatsAccount(input map[string]interface{}) []models.NatsAccountResourceModel {
 	// Check if the input is nil
 	if input == nil {
 		return nil
 	}
 
 	// Build a single block
-	block := models.NatsAccountModel{
-		AccountId:  types.StringValue(input["accountId"].(string)),
-		PrivateKey: types.StringValue(input["privateKey"].(string)),
+	block := models.NatsAccountResourceModel{
+		NatsAccountModel: models.NatsAccountModel{
+			AccountId:  types.StringValue(input["accountId"].(string)),
+			PrivateKey: types.StringValue(input["privateKey"].(string)),
+		},
+	}
+
+	// Keep the private key out of the state when it is managed through its write-only attribute
+	if len(sro.Plan.NatsAccount) != 0 && !sro.Plan.NatsAccount[0].PrivateKeyWoVersion.IsNull() {
+		block.PrivateKey = types.StringNull()
+		block.PrivateKeyWoVersion = sro.Plan.NatsAccount[0].PrivateKeyWoVersion
 	}
 
 	// Return a slice containing the single block
-	return []models.NatsAccountModel{block}
+	return []models.NatsAccountResourceModel{block}
 }
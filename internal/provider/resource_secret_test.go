@@ -1,13 +1,17 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"testing"
 
+	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/secret"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -29,6 +33,82 @@ func TestAccControlPlaneSecret_basic(t *testing.T) {
 	})
 }
 
+// TestAccControlPlaneSecret_writeOnly performs an acceptance test for the write-only secret attributes.
+func TestAccControlPlaneSecret_writeOnly(t *testing.T) {
+	// Initialize the test
+	resourceTest := SecretResourceTest{}
+
+	// Run the acceptance test case for the write-only attributes, covering create and rotation
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t, "SECRET_WRITE_ONLY") },
+		ProtoV6ProviderFactories: GetProviderServer(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Write-only attributes are only supported since Terraform 1.11
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: resourceTest.CheckDestroy,
+		Steps:        resourceTest.NewWriteOnlyScenario(),
+	})
+}
+
+/*** Unit Tests ***/
+
+// TestSecretWriteOnlyValues verifies that write-only values are sent to the API but kept out of the state.
+func TestSecretWriteOnlyValues(t *testing.T) {
+	// Build a plan that carries the payload through the write-only attribute only
+	plan := SecretResourceModel{
+		Opaque: []models.OpaqueResourceModel{
+			{
+				OpaqueModel: models.OpaqueModel{
+					Payload:  types.StringNull(),
+					Encoding: types.StringValue("plain"),
+				},
+				PayloadWoVersion: types.Int32Value(1),
+			},
+		},
+	}
+
+	// Build a configuration holding the write-only value
+	config := SecretResourceModel{
+		Opaque: []models.OpaqueResourceModel{
+			{
+				PayloadWo: types.StringValue("write-only-payload"),
+			},
+		},
+	}
+
+	// Merge the write-only value into the plan
+	plan = (&SecretResource{}).mergeWriteOnlyValues(plan, config)
+
+	// Initialize the operator with the merged plan
+	operator := NewSecretResourceOperator()
+	operator.Plan = plan
+
+	// Verify the write-only value is sent to the API
+	data := operator.buildOpaque(plan.Opaque)
+	if payload := (*data)["payload"].(*string); payload == nil || *payload != "write-only-payload" {
+		t.Fatalf("expected the write-only payload to be sent to the API, got %v", payload)
+	}
+
+	// Verify the revealed payload is kept out of the state
+	blocks := operator.flattenOpaque(map[string]interface{}{"payload": "write-only-payload", "encoding": "plain"})
+	if !blocks[0].Payload.IsNull() || !blocks[0].PayloadWo.IsNull() {
+		t.Fatalf("expected the payload to be kept out of the state, got %s", blocks[0].Payload)
+	}
+
+	// Verify the version is preserved
+	if blocks[0].PayloadWoVersion.ValueInt32() != 1 {
+		t.Fatalf("expected payload_wo_version to be 1, got %s", blocks[0].PayloadWoVersion)
+	}
+
+	// Verify the payload is still tracked when the write-only attribute is not used
+	operator.Plan = SecretResourceModel{}
+	blocks = operator.flattenOpaque(map[string]interface{}{"payload": "payload", "encoding": "plain"})
+	if blocks[0].Payload.ValueString() != "payload" {
+		t.Fatalf("expected the payload to be tracked in the state, got %s", blocks[0].Payload)
+	}
+}
+
 /*** Resource Test ***/
 
 // SecretResourceTest defines the necessary functionality to test the resource.
@@ -79,13 +159,16 @@ func (srt *SecretResourceTest) InitializeSteps() []resource.TestStep {
 	updateChecks := srt.CollectChecks(func(s SecretResourceTestCase) []resource.TestCheckFunc { return s.UpdateChecks })
 
 	// Build import steps for each scenario
-	importSteps := make([]resource.TestStep, len(*srt.Cases))
-	for i, c := range *srt.Cases {
+	importSteps := make([]resource.TestStep, 0, 2*len(*srt.Cases))
+	for _, c := range *srt.Cases {
 		// Create an import test step for the current case
-		importSteps[i] = resource.TestStep{
+		importSteps = append(importSteps, resource.TestStep{
 			ResourceName: c.Scenario.ResourceAddress,
 			ImportState:  true,
-		}
+		})
+
+		// Import with an import block and expect an empty plan
+		importSteps = append(importSteps, BuildImportPlanTestStep(c.Scenario.ResourceAddress, ""))
 	}
 
 	// Declare the full set of test steps
@@ -171,7 +254,7 @@ func (srt *SecretResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of secret with name: %s", secretName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		secret, code, err := TestProvider.client.GetSecret(secretName)
+		secret, code, err := TestProvider.client.GetSecret(context.Background(), secretName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -794,6 +877,52 @@ func (srt *SecretResourceTest) NewNatsAccountScenario() SecretResourceTestCase {
 	}
 }
 
+// NewWriteOnlyScenario creates the test steps for an opaque secret whose payload is managed through its write-only
+// attribute, including a rotation of the payload.
+func (srt *SecretResourceTest) NewWriteOnlyScenario() []resource.TestStep {
+	// Generate a unique name for the secret resource
+	name := fmt.Sprintf("secret-opaque-wo-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	// Define the payloads
+	payload := "opaque_secret_payload"
+	payloadUpdate := "opaque_secret_payload_rotated"
+
+	// Create the secret test scenario with metadata and descriptions
+	scenario := SecretResourceTestScenario{
+		ProviderTestCase: ProviderTestCase{
+			Kind:            "secret",
+			ResourceAddress: "cpln_secret.opaque_wo",
+			Name:            name,
+			Description:     name,
+		},
+	}
+
+	// Return the test steps
+	return []resource.TestStep{
+		// Create
+		{
+			Config: scenario.OpaqueWriteOnly(payload, 1),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				scenario.Exists(),
+				scenario.RevealedOpaquePayload(payload),
+				resource.TestCheckNoResourceAttr(scenario.ResourceAddress, "opaque.0.payload"),
+				resource.TestCheckNoResourceAttr(scenario.ResourceAddress, "opaque.0.payload_wo"),
+				resource.TestCheckResourceAttr(scenario.ResourceAddress, "opaque.0.payload_wo_version", "1"),
+			),
+		},
+		// Rotate the payload
+		{
+			Config: scenario.OpaqueWriteOnly(payloadUpdate, 2),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				scenario.Exists(),
+				scenario.RevealedOpaquePayload(payloadUpdate),
+				resource.TestCheckNoResourceAttr(scenario.ResourceAddress, "opaque.0.payload"),
+				resource.TestCheckResourceAttr(scenario.ResourceAddress, "opaque.0.payload_wo_version", "2"),
+			),
+		},
+	}
+}
+
 /*** Resource Test Case ***/
 
 // SecretResourceTestScenario defines a specific resource test case.
@@ -830,7 +959,7 @@ func (srts *SecretResourceTestScenario) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteSecret, _, err := TestProvider.client.GetSecret(srts.Name)
+		remoteSecret, _, err := TestProvider.client.GetSecret(context.Background(), srts.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving secret from external system: %w", err)
 		}
@@ -846,6 +975,30 @@ func (srts *SecretResourceTestScenario) Exists() resource.TestCheckFunc {
 	}
 }
 
+// RevealedOpaquePayload verifies that the opaque payload stored in the data service matches the expected value.
+func (srts *SecretResourceTestScenario) RevealedOpaquePayload(expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Reveal the secret through the provider client
+		remoteSecret, _, err := TestProvider.client.GetSecret(context.Background(), srts.Name)
+		if err != nil {
+			return fmt.Errorf("error retrieving secret from external system: %w", err)
+		}
+
+		// Extract the opaque payload
+		data, ok := (*remoteSecret.Data).(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected data returned for secret %s", srts.Name)
+		}
+
+		// Compare the payload with the expected value
+		if data["payload"] != expected {
+			return fmt.Errorf("mismatch in payload of secret %s", srts.Name)
+		}
+
+		return nil
+	}
+}
+
 // Configs //
 
 // OpaqueRequiredOnly returns a minimal HCL block for an opaque secret using only required fields.
@@ -861,6 +1014,20 @@ resource "cpln_secret" "opaque" {
 `, srts.Name, payload)
 }
 
+// OpaqueWriteOnly returns an HCL block for an opaque secret whose payload is set through its write-only attribute.
+func (srts *SecretResourceTestScenario) OpaqueWriteOnly(payload string, version int) string {
+	return fmt.Sprintf(`
+resource "cpln_secret" "opaque_wo" {
+  name = "%s"
+
+  opaque {
+    payload_wo         = "%s"
+    payload_wo_version = %d
+  }
+}
+`, srts.Name, payload, version)
+}
+
 // OpaqueUpdateWithOptionals returns an HCL block for an opaque secret including optional fields like description and tags.
 func (srts *SecretResourceTestScenario) OpaqueUpdateWithOptionals(payload string, encoding string) string {
 	return fmt.Sprintf(`
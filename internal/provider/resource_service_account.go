@@ -4,6 +4,7 @@ import (
 	"context"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,14 +17,19 @@ import (
 var (
 	_ resource.Resource                = &ServiceAccountResource{}
 	_ resource.ResourceWithImportState = &ServiceAccountResource{}
+	_ resource.ResourceWithIdentity    = &ServiceAccountResource{}
 )
 
+// serviceAccountEntityIdentity describes the identity of the serviceaccount entity.
+var serviceAccountEntityIdentity = EntityIdentity{Kind: "serviceaccount"}
+
 /*** Resource Model ***/
 
 // ServiceAccountResourceModel holds the Terraform state for the resource.
 type ServiceAccountResourceModel struct {
 	EntityBaseModel
-	Origin types.String `tfsdk:"origin"`
+	Origin   types.String   `tfsdk:"origin"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -43,10 +49,20 @@ func NewServiceAccountResource() resource.Resource {
 func (sar *ServiceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	sar.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	sar.Operations = NewEntityOperations(sar.client, &ServiceAccountResourceOperator{})
+	sar.Operations.Identity = &serviceAccountEntityIdentity
+
+	// Keys are managed by the cpln_service_account_key and cpln_mk8s_kubeconfig resources and ephemeral resources
+	sar.Operations.DriftIgnoredPaths = []string{"keys"}
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (sar *ServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		serviceAccountEntityIdentity.ImportState(ctx, req, resp, sar.client.Org)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -55,6 +71,11 @@ func (sar *ServiceAccountResource) Metadata(ctx context.Context, req resource.Me
 	resp.TypeName = "cpln_service_account"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (sar *ServiceAccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = serviceAccountEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (sar *ServiceAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -67,6 +88,9 @@ func (sar *ServiceAccountResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": sar.TimeoutsSchema(ctx),
+		},
 	}
 }
 
@@ -120,26 +144,29 @@ func (saro *ServiceAccountResourceOperator) MapResponseToState(apiResp *client.S
 	// Set specific attributes
 	state.Origin = types.StringPointerValue(apiResp.Origin)
 
+	// Preserve the configured timeouts
+	state.Timeouts = saro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (saro *ServiceAccountResourceOperator) InvokeCreate(req client.ServiceAccount) (*client.ServiceAccount, int, error) {
-	return saro.Client.CreateServiceAccount(req)
+	return saro.Client.CreateServiceAccount(saro.Ctx, req)
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (saro *ServiceAccountResourceOperator) InvokeRead(name string) (*client.ServiceAccount, int, error) {
-	return saro.Client.GetServiceAccount(name)
+	return saro.Client.GetServiceAccount(saro.Ctx, name)
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (saro *ServiceAccountResourceOperator) InvokeUpdate(req client.ServiceAccount) (*client.ServiceAccount, int, error) {
-	return saro.Client.UpdateServiceAccount(req)
+	return saro.Client.UpdateServiceAccount(saro.Ctx, req)
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (saro *ServiceAccountResourceOperator) InvokeDelete(name string) error {
-	return saro.Client.DeleteServiceAccount(name)
+	return saro.Client.DeleteServiceAccount(saro.Ctx, name)
 }
@@ -7,6 +7,7 @@ import (
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,11 +28,13 @@ var (
 
 // ServiceAccountKeyResourceModel holds the Terraform state for the resource.
 type ServiceAccountKeyResourceModel struct {
-	ServiceAccountName types.String `tfsdk:"service_account_name"`
-	Description        types.String `tfsdk:"description"`
-	Name               types.String `tfsdk:"name"`
-	Created            types.String `tfsdk:"created"`
-	Key                types.String `tfsdk:"key"`
+	ServiceAccountName types.String   `tfsdk:"service_account_name"`
+	Description        types.String   `tfsdk:"description"`
+	Name               types.String   `tfsdk:"name"`
+	Created            types.String   `tfsdk:"created"`
+	Key                types.String   `tfsdk:"key"`
+	Org                types.String   `tfsdk:"org"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -138,12 +141,20 @@ func (sakr *ServiceAccountKeyResource) Schema(ctx context.Context, req resource.
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org": sakr.OrgSchema("Service Account Key"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": sakr.TimeoutsSchema(ctx),
 		},
 	}
 }
 
 // Create creates the resource.
 func (sakr *ServiceAccountKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Bound the create operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Create, DefaultCreateTimeout)
+	defer cancel()
+
 	var plannedState ServiceAccountKeyResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -162,8 +173,11 @@ func (sakr *ServiceAccountKeyResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	// Target the org the service account belongs to
+	orgClient := sakr.client.WithOrg(plannedState.Org.ValueString())
+
 	// Send the create request to the API client
-	responsePayload, err := sakr.client.AddServiceAccountKey(serviceAccountName, description)
+	responsePayload, err := orgClient.AddServiceAccountKey(ctx, serviceAccountName, description)
 
 	// Handle any other errors that occurred during the API request
 	if err != nil {
@@ -174,6 +188,12 @@ func (sakr *ServiceAccountKeyResource) Create(ctx context.Context, req resource.
 	// Map the API response to the Terraform state
 	finalState := sakr.buildState(nil, serviceAccountName, responsePayload)
 
+	// Preserve the configured timeouts
+	finalState.Timeouts = plannedState.Timeouts
+
+	// Persist the org the key belongs to
+	finalState.Org = types.StringValue(orgClient.Org)
+
 	// Return if an error has occurred during the state creation
 	if resp.Diagnostics.HasError() {
 		return
@@ -185,6 +205,10 @@ func (sakr *ServiceAccountKeyResource) Create(ctx context.Context, req resource.
 
 // Read fetches the current state of the resource.
 func (sakr *ServiceAccountKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Bound the read operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Read, DefaultReadTimeout)
+	defer cancel()
+
 	var plannedState ServiceAccountKeyResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -199,12 +223,15 @@ func (sakr *ServiceAccountKeyResource) Read(ctx context.Context, req resource.Re
 	serviceAccountName := plannedState.ServiceAccountName.ValueString()
 	keyName := plannedState.Name.ValueString()
 
+	// Target the org the service account belongs to
+	orgClient := sakr.client.WithOrg(plannedState.Org.ValueString())
+
 	// Fetch the domain route
-	responsePayload, code, err := sakr.client.GetServiceAccount(serviceAccountName)
+	responsePayload, _, err := orgClient.GetServiceAccount(ctx, serviceAccountName)
 
 	// Handle the case where the route is not found (HTTP 404),
 	// indicating it has been deleted outside of Terraform. Remove it from state
-	if code == 404 {
+	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -223,6 +250,12 @@ func (sakr *ServiceAccountKeyResource) Read(ctx context.Context, req resource.Re
 				// Map the API response to the Terraform state
 				finalState := sakr.buildState(&plannedState, serviceAccountName, &serviceAccountKey)
 
+				// Preserve the configured timeouts
+				finalState.Timeouts = plannedState.Timeouts
+
+				// Persist the org the key belongs to
+				finalState.Org = types.StringValue(orgClient.Org)
+
 				// Return if an error has occurred during the state creation
 				if resp.Diagnostics.HasError() {
 					return
@@ -241,6 +274,10 @@ func (sakr *ServiceAccountKeyResource) Read(ctx context.Context, req resource.Re
 
 // Update modifies the resource.
 func (sakr *ServiceAccountKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Bound the update operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.Plan, timeouts.Value.Update, DefaultUpdateTimeout)
+	defer cancel()
+
 	var plannedState ServiceAccountKeyResourceModel
 
 	// Retrieve the planned state from the Terraform configuration
@@ -257,6 +294,10 @@ func (sakr *ServiceAccountKeyResource) Update(ctx context.Context, req resource.
 
 // Delete removes the resource.
 func (sakr *ServiceAccountKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Bound the delete operation by the configured timeout
+	ctx, cancel := WithOperationTimeout(ctx, &resp.Diagnostics, req.State, timeouts.Value.Delete, DefaultDeleteTimeout)
+	defer cancel()
+
 	var state ServiceAccountKeyResourceModel
 
 	// Retrieve the state from the Terraform configuration
@@ -272,7 +313,7 @@ func (sakr *ServiceAccountKeyResource) Delete(ctx context.Context, req resource.
 	keyName := state.Name.ValueString()
 
 	// Send a delete request to the API using the name from the state
-	err := sakr.client.RemoveServiceAccountKey(serviceAccountName, keyName)
+	err := sakr.client.WithOrg(state.Org.ValueString()).RemoveServiceAccountKey(ctx, serviceAccountName, keyName)
 
 	// Handle errors from the API delete request
 	if err != nil {
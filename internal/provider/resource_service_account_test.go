@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -79,7 +80,7 @@ func (sart *ServiceAccountResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of serviceAccount with name: %s", serviceAccountName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		serviceAccount, code, err := TestProvider.client.GetServiceAccount(serviceAccountName)
+		serviceAccount, code, err := TestProvider.client.GetServiceAccount(context.Background(), serviceAccountName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -126,6 +127,8 @@ func (sart *ServiceAccountResourceTest) NewDefaultScenario() []resource.TestStep
 			ResourceName: initialConfig.ResourceAddress,
 			ImportState:  true,
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, ""),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -359,7 +362,7 @@ func (sartc *ServiceAccountResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteServiceAccount, _, err := TestProvider.client.GetServiceAccount(sartc.Name)
+		remoteServiceAccount, _, err := TestProvider.client.GetServiceAccount(context.Background(), sartc.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving serviceAccount from external system: %w", err)
 		}
@@ -5,11 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
-	"strings"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/volume_set"
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -32,8 +32,12 @@ var (
 	_ resource.Resource                   = &VolumeSetResource{}
 	_ resource.ResourceWithImportState    = &VolumeSetResource{}
 	_ resource.ResourceWithValidateConfig = &VolumeSetResource{}
+	_ resource.ResourceWithIdentity       = &VolumeSetResource{}
 )
 
+// volumeSetEntityIdentity describes the identity of the volumeset entity.
+var volumeSetEntityIdentity = EntityIdentity{Kind: "volumeset", IsGvcScoped: true}
+
 /*** Resource Model ***/
 
 // VolumeSetResourceModel holds the Terraform state for the resource.
@@ -50,6 +54,7 @@ type VolumeSetResourceModel struct {
 	Autoscaling        []models.AutoscalingModel  `tfsdk:"autoscaling"`
 	MountOptions       []models.MountOptionsModel `tfsdk:"mount_options"`
 	VolumesetLink      types.String               `tfsdk:"volumeset_link"`
+	Timeouts           timeouts.Value             `tfsdk:"timeouts"`
 }
 
 /*** Resource Configuration ***/
@@ -69,40 +74,19 @@ func NewVolumeSetResource() resource.Resource {
 func (vsr *VolumeSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	vsr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	vsr.Operations = NewEntityOperations(vsr.client, &VolumeSetResourceOperator{})
+	vsr.Operations.Identity = &volumeSetEntityIdentity
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (vsr *VolumeSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Split the import ID
-	parts := strings.SplitN(req.ID, ":", 2)
-
-	// Validate that ID has exactly three non-empty segments
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		// Report error when import identifier format is unexpected
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf(
-				"Expected import identifier with format: "+
-					"'gvc:volume_set_name'. Got: %q", req.ID,
-			),
-		)
-
-		// Abort import operation on error
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		volumeSetEntityIdentity.ImportState(ctx, req, resp, vsr.client.Org)
 		return
 	}
 
-	// Extract gvc and volumeSetName from parts
-	gvc, volumeSetName := parts[0], parts[1]
-
-	// Set the generated ID attribute in the Terraform state
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(volumeSetName))...,
-	)
-
-	// Set the GVC attribute in the Terraform state
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("gvc"), types.StringValue(gvc))...,
-	)
+	// Map the GVC and name, or the self link, to the state
+	ImportGvcScopedState(ctx, req, resp, "volumeset", vsr.client.Org)
 }
 
 // Metadata provides the resource type name.
@@ -110,6 +94,11 @@ func (vsr *VolumeSetResource) Metadata(ctx context.Context, req resource.Metadat
 	resp.TypeName = "cpln_volume_set"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (vsr *VolumeSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = volumeSetEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (vsr *VolumeSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -351,24 +340,36 @@ func (vsr *VolumeSetResource) Schema(ctx context.Context, req resource.SchemaReq
 										Optional:    true,
 										Computed:    true,
 										Default:     stringdefault.StaticString("2000m"),
+										Validators: []validator.String{
+											validators.CpuQuantityValidator{},
+										},
 									},
 									"min_cpu": schema.StringAttribute{
 										Description: "",
 										Optional:    true,
 										Computed:    true,
 										Default:     stringdefault.StaticString("500m"),
+										Validators: []validator.String{
+											validators.CpuQuantityValidator{},
+										},
 									},
 									"min_memory": schema.StringAttribute{
 										Description: "",
 										Optional:    true,
 										Computed:    true,
 										Default:     stringdefault.StaticString("1Gi"),
+										Validators: []validator.String{
+											validators.MemoryQuantityValidator{},
+										},
 									},
 									"max_memory": schema.StringAttribute{
 										Description: "",
 										Optional:    true,
 										Computed:    true,
 										Default:     stringdefault.StaticString("2Gi"),
+										Validators: []validator.String{
+											validators.MemoryQuantityValidator{},
+										},
 									},
 								},
 							},
@@ -382,6 +383,7 @@ func (vsr *VolumeSetResource) Schema(ctx context.Context, req resource.SchemaReq
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"timeouts": vsr.TimeoutsSchema(ctx),
 		},
 	}
 }
@@ -530,28 +532,31 @@ func (vsro *VolumeSetResourceOperator) MapResponseToState(apiResp *client.Volume
 		state.MountOptions = vsro.flattenMountOptions(apiResp.Spec.MountOptions)
 	}
 
+	// Preserve the configured timeouts
+	state.Timeouts = vsro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (vsro *VolumeSetResourceOperator) InvokeCreate(req client.VolumeSet) (*client.VolumeSet, int, error) {
-	return vsro.Client.CreateVolumeSet(req, vsro.Plan.Gvc.ValueString())
+	return vsro.Client.CreateVolumeSet(vsro.Ctx, req, vsro.Plan.Gvc.ValueString())
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (vsro *VolumeSetResourceOperator) InvokeRead(name string) (*client.VolumeSet, int, error) {
-	return vsro.Client.GetVolumeSet(name, vsro.Plan.Gvc.ValueString())
+	return vsro.Client.GetVolumeSet(vsro.Ctx, name, vsro.Plan.Gvc.ValueString())
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (vsro *VolumeSetResourceOperator) InvokeUpdate(req client.VolumeSet) (*client.VolumeSet, int, error) {
-	return vsro.Client.UpdateVolumeSet(req, vsro.Plan.Gvc.ValueString())
+	return vsro.Client.UpdateVolumeSet(vsro.Ctx, req, vsro.Plan.Gvc.ValueString())
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (vsro *VolumeSetResourceOperator) InvokeDelete(name string) error {
-	return vsro.Client.DeleteVolumeSet(name, vsro.Plan.Gvc.ValueString())
+	return vsro.Client.DeleteVolumeSet(vsro.Ctx, name, vsro.Plan.Gvc.ValueString())
 }
 
 // Builders //
@@ -1,6 +1,7 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -84,7 +85,7 @@ func (vsrt *VolumeSetResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of GVC with name: %s", gvcName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		gvc, code, err := TestProvider.client.GetGvc(gvcName)
+		gvc, code, err := TestProvider.client.GetGvc(context.Background(), gvcName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -150,6 +151,8 @@ func (vsrt *VolumeSetResourceTest) NewDefaultScenario(fileSystemType string, per
 			ImportState:   true,
 			ImportStateId: fmt.Sprintf("%s:%s", gvcName, name),
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, fmt.Sprintf("%s:%s", gvcName, name)),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -862,7 +865,7 @@ func (vsrtc *VolumeSetResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteVolumeSet, _, err := TestProvider.client.GetVolumeSet(vsrtc.Name, vsrtc.GvcCase.Name)
+		remoteVolumeSet, _, err := TestProvider.client.GetVolumeSet(context.Background(), vsrtc.Name, vsrtc.GvcCase.Name)
 		if err != nil {
 			return fmt.Errorf("error retrieving volume set from external system: %w", err)
 		}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	models "github.com/controlplane-com/terraform-provider-cpln/internal/provider/models/workload"
 	"github.com/controlplane-com/terraform-provider-cpln/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
@@ -30,7 +32,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure resource implements required interfaces.
@@ -38,12 +42,16 @@ var (
 	_ resource.Resource                   = &WorkloadResource{}
 	_ resource.ResourceWithImportState    = &WorkloadResource{}
 	_ resource.ResourceWithValidateConfig = &WorkloadResource{}
+	_ resource.ResourceWithIdentity       = &WorkloadResource{}
 )
 
+// workloadEntityIdentity describes the identity of the workload entity.
+var workloadEntityIdentity = EntityIdentity{Kind: "workload", IsGvcScoped: true}
+
 /*** Resource Model ***/
 
-// WorkloadResourceModel holds the Terraform state for the resource.
-type WorkloadResourceModel struct {
+// WorkloadModel holds the Terraform state shared by the resource and the data source.
+type WorkloadModel struct {
 	EntityBaseModel
 	Gvc                types.String `tfsdk:"gvc"`
 	Type               types.String `tfsdk:"type"`
@@ -64,6 +72,13 @@ type WorkloadResourceModel struct {
 	Status             types.List   `tfsdk:"status"`
 }
 
+// WorkloadResourceModel holds the Terraform state for the resource.
+type WorkloadResourceModel struct {
+	WorkloadModel
+	WaitForReady types.List     `tfsdk:"wait_for_ready"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
 /*** Resource Configuration ***/
 
 // WorkloadResource is the resource implementation.
@@ -83,40 +98,26 @@ func NewWorkloadResource() resource.Resource {
 func (wr *WorkloadResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	wr.EntityBaseConfigure(ctx, req.ProviderData, &resp.Diagnostics)
 	wr.Operations = NewEntityOperations(wr.client, &WorkloadResourceOperator{})
+	wr.Operations.Identity = &workloadEntityIdentity
+
+	// Fields configured through attributes named differently
+	wr.Operations.APIFieldAttributes = map[string]string{
+		"spec.containers":     "container",
+		"spec.defaultOptions": "options",
+		"spec.firewallConfig": "firewall_spec",
+	}
 }
 
 // ImportState sets up the import operation to map the imported ID to the "id" attribute in the state.
 func (wr *WorkloadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Split the import ID
-	parts := strings.SplitN(req.ID, ":", 2)
-
-	// Validate that ID has exactly three non-empty segments
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		// Report error when import identifier format is unexpected
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf(
-				"Expected import identifier with format: "+
-					"'gvc:workload_name'. Got: %q", req.ID,
-			),
-		)
-
-		// Abort import operation on error
+	// Import by the identity when the practitioner provided one instead of an ID
+	if req.ID == "" {
+		workloadEntityIdentity.ImportState(ctx, req, resp, wr.client.Org)
 		return
 	}
 
-	// Extract gvc and workloadName from parts
-	gvc, workloadName := parts[0], parts[1]
-
-	// Set the generated ID attribute in the Terraform state
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(workloadName))...,
-	)
-
-	// Set the GVC attribute in the Terraform state
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("gvc"), types.StringValue(gvc))...,
-	)
+	// Map the GVC and name, or the self link, to the state
+	ImportGvcScopedState(ctx, req, resp, "workload", wr.client.Org)
 }
 
 // Metadata provides the resource type name.
@@ -124,6 +125,11 @@ func (wr *WorkloadResource) Metadata(ctx context.Context, req resource.MetadataR
 	resp.TypeName = "cpln_workload"
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (wr *WorkloadResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workloadEntityIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (wr *WorkloadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -710,24 +716,24 @@ func (wr *WorkloadResource) Schema(ctx context.Context, req resource.SchemaReque
 							Optional:    true,
 							Computed:    true,
 							Default:     stringdefault.StaticString("128Mi"),
-							Validators:  wr.GetCpuMemoryValidators("must be a valid memory quantity"),
+							Validators:  wr.GetCpuMemoryValidators(validators.MemoryQuantityValidator{}),
 						},
 						"cpu": schema.StringAttribute{
 							Description: "Reserved CPU of the workload when capacityAI is disabled. Maximum CPU when CapacityAI is enabled. Default: \"50m\".",
 							Optional:    true,
 							Computed:    true,
 							Default:     stringdefault.StaticString("50m"),
-							Validators:  wr.GetCpuMemoryValidators("must be a valid CPU quantity"),
+							Validators:  wr.GetCpuMemoryValidators(validators.CpuQuantityValidator{}),
 						},
 						"min_cpu": schema.StringAttribute{
 							Description: "Minimum CPU when capacity AI is enabled.",
 							Optional:    true,
-							Validators:  wr.GetCpuMemoryValidators("must be a valid CPU quantity"),
+							Validators:  wr.GetCpuMemoryValidators(validators.CpuQuantityValidator{}),
 						},
 						"min_memory": schema.StringAttribute{
 							Description: "Minimum memory when capacity AI is enabled.",
 							Optional:    true,
-							Validators:  wr.GetCpuMemoryValidators("must be a valid memory quantity"),
+							Validators:  wr.GetCpuMemoryValidators(validators.MemoryQuantityValidator{}),
 						},
 						"env": schema.MapAttribute{
 							Description: "Name-Value list of environment variables.",
@@ -1415,12 +1421,58 @@ func (wr *WorkloadResource) Schema(ctx context.Context, req resource.SchemaReque
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"wait_for_ready": schema.ListNestedBlock{
+				Description: "When specified, create and update operations wait until the workload rollout converges before completing. The apply fails with the latest health check messages if the workload does not become ready within the timeout.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"timeout": schema.Int32Attribute{
+							Description: "The amount of seconds to wait for the workload to become ready. Default: `300`.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int32default.StaticInt32(300),
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"poll_interval": schema.Int32Attribute{
+							Description: "The amount of seconds between readiness checks. Default: `10`.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int32default.StaticInt32(10),
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"min_ready_locations": schema.Int32Attribute{
+							Description: "The minimum number of locations that must report ready. Defaults to every location the workload is deployed to.",
+							Optional:    true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"min_ready_replicas": schema.Int32Attribute{
+							Description: "The minimum number of replicas, across all locations, that must report ready.",
+							Optional:    true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"timeouts": wr.TimeoutsSchema(ctx),
 		},
 	}
 }
 
 // ModifyPlan modifies the plan for the resource.
 func (wr *WorkloadResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Fail the plan if the entity was changed outside of Terraform
+	wr.CheckExternalChanges(ctx, req, resp)
+
 	// If this is a destroy plan, leave everything null and return immediately
 	if req.Plan.Raw.IsNull() {
 		return
@@ -1540,6 +1592,14 @@ func (wr *WorkloadResource) ValidateConfig(ctx context.Context, req resource.Val
 // Create creates the resource.
 func (wr *WorkloadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	CreateGeneric(ctx, req, resp, wr.Operations)
+
+	// Skip waiting when the create itself failed
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the workload to become ready if requested
+	wr.waitForReady(ctx, &resp.State, resp.Private, &resp.Diagnostics)
 }
 
 // Read fetches the current state of the resource.
@@ -1550,6 +1610,14 @@ func (wr *WorkloadResource) Read(ctx context.Context, req resource.ReadRequest,
 // Update modifies the resource.
 func (wr *WorkloadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	UpdateGeneric(ctx, req, resp, wr.Operations)
+
+	// Skip waiting when the update itself failed
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the workload to become ready if requested
+	wr.waitForReady(ctx, &resp.State, resp.Private, &resp.Diagnostics)
 }
 
 // Delete removes the resource.
@@ -1557,6 +1625,205 @@ func (wr *WorkloadResource) Delete(ctx context.Context, req resource.DeleteReque
 	DeleteGeneric(ctx, req, resp, wr.Operations)
 }
 
+/*** Wait For Ready ***/
+
+// waitForReady polls the workload held in state until the version written by the operation is rolled out and satisfies the
+// wait_for_ready thresholds, then refreshes the state with the latest status.
+func (wr *WorkloadResource) waitForReady(ctx context.Context, state *tfsdk.State, private PrivateStateReader, diags *diag.Diagnostics) {
+	// Declare variable to hold the state that was just persisted
+	var model WorkloadResourceModel
+
+	// Populate the model from the state and capture diagnostics
+	diags.Append(state.Get(ctx, &model)...)
+
+	// Abort if diagnostics errors occurred
+	if diags.HasError() {
+		return
+	}
+
+	// Build the wait for ready block
+	blocks, ok := BuildList[models.WaitForReadyModel](ctx, diags, model.WaitForReady)
+
+	// Nothing to do when waiting was not requested
+	if !ok || len(blocks) == 0 {
+		return
+	}
+
+	// Extract the wait configuration
+	block := blocks[0]
+	name := model.Name.ValueString()
+	gvc := model.Gvc.ValueString()
+	timeout := time.Duration(block.Timeout.ValueInt32()) * time.Second
+	pollInterval := time.Duration(block.PollInterval.ValueInt32()) * time.Second
+	deadline := time.Now().Add(timeout)
+
+	// Poll the workload within the org it belongs to
+	apiClient := wr.client.WithOrg(EntityOrg(model, wr.client.Org))
+
+	// The health only describes the operation once the version it wrote is rolled out
+	version := RecordedVersion(ctx, private)
+
+	// Keep track of the latest workload and the reasons it is not yet ready
+	var workload *client.Workload
+	var reasons []string
+
+	// Poll until the workload converges or the deadline is reached
+	for {
+		// Fetch the latest workload including its health and status
+		latest, _, err := apiClient.GetWorkload(ctx, name, gvc)
+
+		// Handle API invocation errors
+		if err != nil {
+			diags.AddError("API error", fmt.Sprintf("Error reading workload '%s' while waiting for it to become ready: %s", name, err))
+			return
+		}
+
+		// Fall back to the version first read when the operation did not record one
+		if version == nil {
+			version = latest.Version
+		}
+
+		// Fetch the deployments to learn which version each location runs
+		deployments, err := apiClient.GetWorkloadDeployments(ctx, name, gvc)
+
+		// Handle API invocation errors
+		if err != nil {
+			diags.AddError("API error", fmt.Sprintf("Error reading the deployments of workload '%s' while waiting for it to become ready: %s", name, err))
+			return
+		}
+
+		// Evaluate readiness against the configured thresholds
+		var ready bool
+		workload = latest
+		ready, reasons = evaluateWorkloadReadiness(workload, deployments, derefInt(version), BuildInt(block.MinReadyLocations), BuildInt(block.MinReadyReplicas))
+
+		// Stop polling once the workload is ready
+		if ready {
+			break
+		}
+
+		// Give up if another poll would exceed the deadline
+		if time.Now().Add(pollInterval).After(deadline) {
+			break
+		}
+
+		// Log the pending reasons for troubleshooting
+		tflog.Debug(ctx, "Waiting for workload to become ready", map[string]any{
+			"workload": name,
+			"gvc":      gvc,
+			"reasons":  reasons,
+		})
+
+		// Wait for the next poll unless the operation is cancelled
+		select {
+		case <-ctx.Done():
+			diags.AddError("Workload Readiness Wait Cancelled", fmt.Sprintf("Stopped waiting for workload '%s' to become ready: %s", name, ctx.Err()))
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+
+	// Refresh the state so the status reflects the latest rollout
+	operator := wr.Operations.NewOperator(ctx, diags, model)
+	newState := operator.MapResponseToState(workload, false)
+
+	// Persist the refreshed state even if the workload did not converge
+	if !diags.HasError() {
+		diags.Append(state.Set(ctx, &newState)...)
+	}
+
+	// Fail the apply with the outstanding reasons if the workload is still not ready
+	if len(reasons) != 0 {
+		diags.AddAttributeError(
+			path.Root("wait_for_ready"),
+			"Workload Not Ready",
+			fmt.Sprintf(
+				"Workload '%s' in GVC '%s' did not become ready within %d seconds:\n  - %s",
+				name, gvc, block.Timeout.ValueInt32(), strings.Join(reasons, "\n  - "),
+			),
+		)
+	}
+}
+
+// evaluateWorkloadReadiness reports whether a workload version is rolled out and satisfies the readiness thresholds, along
+// with the reasons it does not. A version of zero skips the rollout check.
+func evaluateWorkloadReadiness(workload *client.Workload, deployments []client.WorkloadDeployment, version int, minReadyLocations *int, minReadyReplicas *int) (bool, []string) {
+	// Collect every unmet condition so the caller can surface them together
+	reasons := []string{}
+
+	// The health of a previous rollout must not satisfy the thresholds
+	if version > 0 {
+		if pending := client.PendingWorkloadDeployments(deployments, version); len(pending) != 0 {
+			reasons = append(reasons, fmt.Sprintf("version %d is not rolled out yet to: %s", version, strings.Join(pending, ", ")))
+		}
+	}
+
+	// The health summary carries the ready location and replica counts
+	if workload.Health == nil {
+		reasons = append(reasons, "the workload health summary is not available yet")
+	} else {
+		// Dereference the counters, treating missing values as zero
+		readyLocations := derefInt(workload.Health.ReadyLocations)
+		totalLocations := derefInt(workload.Health.TotalLocations)
+		readyReplicas := derefInt(workload.Health.ReadyReplicas)
+
+		// Default to requiring every deployed location to be ready
+		requiredLocations := totalLocations
+		if minReadyLocations != nil {
+			requiredLocations = *minReadyLocations
+		}
+
+		// A workload that has not been scheduled anywhere cannot be ready yet
+		if requiredLocations == 0 {
+			reasons = append(reasons, "the workload has not been deployed to any location yet")
+		} else if readyLocations < requiredLocations {
+			reasons = append(reasons, fmt.Sprintf("%d of %d locations are ready, %d required", readyLocations, totalLocations, requiredLocations))
+		}
+
+		// Enforce the replica threshold only when one was configured
+		if minReadyReplicas != nil && readyReplicas < *minReadyReplicas {
+			reasons = append(reasons, fmt.Sprintf("%d replicas are ready, %d required", readyReplicas, *minReadyReplicas))
+		}
+
+		// Report a failed sync explicitly, since it usually explains the counters above
+		if workload.Health.SyncFailed != nil && *workload.Health.SyncFailed {
+			reasons = append(reasons, "the workload failed to sync to one or more locations")
+		}
+	}
+
+	// An active health check that is failing blocks readiness and carries the most useful message
+	if workload.Status != nil && workload.Status.HealthCheck != nil {
+		healthCheck := workload.Status.HealthCheck
+
+		if healthCheck.Active != nil && *healthCheck.Active && (healthCheck.Success == nil || !*healthCheck.Success) {
+			// Start with a generic message and enrich it with whatever the API reported
+			reason := "the health check is failing"
+
+			if healthCheck.Code != nil {
+				reason = fmt.Sprintf("%s (code %d)", reason, *healthCheck.Code)
+			}
+
+			if healthCheck.Message != nil && *healthCheck.Message != "" {
+				reason = fmt.Sprintf("%s: %s", reason, *healthCheck.Message)
+			}
+
+			reasons = append(reasons, reason)
+		}
+	}
+
+	// The workload is ready when no condition is outstanding
+	return len(reasons) == 0, reasons
+}
+
+// derefInt returns the value of an int pointer, or zero when it is nil.
+func derefInt(input *int) int {
+	if input == nil {
+		return 0
+	}
+
+	return *input
+}
+
 /*** Schemas ***/
 
 // HealthCheckSchema returns a nested block list schema for configuring workload health checks.
@@ -2233,13 +2500,10 @@ func (wr *WorkloadResource) ModifyHealthCheck(ctx context.Context, diags *diag.D
 
 /*** Shared Validators ***/
 
-// GetCpuMemoryValidators returns a list of validators to ensure CPU/memory values follow proper format and size limits.
-func (wr *WorkloadResource) GetCpuMemoryValidators(regexMessage string) []validator.String {
+// GetCpuMemoryValidators returns a list of validators to ensure CPU/memory values are valid quantities within size limits.
+func (wr *WorkloadResource) GetCpuMemoryValidators(quantityValidator validator.String) []validator.String {
 	return []validator.String{
-		stringvalidator.RegexMatches(
-			regexp.MustCompile(`^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$`),
-			regexMessage,
-		),
+		quantityValidator,
 		stringvalidator.LengthAtMost(20),
 	}
 }
@@ -2271,6 +2535,18 @@ func (wrv *WorkloadResourceValidator) Validate() {
 		return
 	}
 
+	// Build planned wait for ready
+	waitForReady, ok := BuildList[models.WaitForReadyModel](wrv.Ctx, wrv.Diags, wrv.Plan.WaitForReady)
+
+	// Cron workloads never report readiness, so there is nothing to wait for
+	if workloadType == "cron" && ok && len(waitForReady) > 0 {
+		wrv.Diags.AddAttributeError(
+			path.Root("wait_for_ready"),
+			"Invalid Wait For Ready for Cron Workload",
+			"The 'wait_for_ready' block is not supported for 'cron' workload types. Remove this block or use a different workload type instead.",
+		)
+	}
+
 	// Build planned rollout options
 	rolloutOptions, ok := BuildList[models.RolloutOptionsModel](wrv.Ctx, wrv.Diags, wrv.Plan.RolloutOptions)
 
@@ -2584,6 +2860,7 @@ func (wro *WorkloadResourceOperator) MapResponseToState(apiResp *client.Workload
 
 	// Set specific attributes
 	state.Gvc = types.StringPointerValue(BuildString(wro.Plan.Gvc))
+	state.WaitForReady = wro.Plan.WaitForReady
 	state.Status = wro.flattenStatus(apiResp.Status)
 
 	// Just in case the spec is nil
@@ -2621,28 +2898,31 @@ func (wro *WorkloadResourceOperator) MapResponseToState(apiResp *client.Workload
 		state.Vm = wro.flattenVm(apiResp.Spec.Vm)
 	}
 
+	// Preserve the configured timeouts
+	state.Timeouts = wro.Plan.Timeouts
+
 	// Return completed state model
 	return state
 }
 
 // InvokeCreate invokes the Create API to create a new resource.
 func (wro *WorkloadResourceOperator) InvokeCreate(req client.Workload) (*client.Workload, int, error) {
-	return wro.Client.CreateWorkload(req, wro.Plan.Gvc.ValueString())
+	return wro.Client.CreateWorkload(wro.Ctx, req, wro.Plan.Gvc.ValueString())
 }
 
 // InvokeRead invokes the Get API to retrieve an existing resource by name.
 func (wro *WorkloadResourceOperator) InvokeRead(name string) (*client.Workload, int, error) {
-	return wro.Client.GetWorkload(name, wro.Plan.Gvc.ValueString())
+	return wro.Client.GetWorkload(wro.Ctx, name, wro.Plan.Gvc.ValueString())
 }
 
 // InvokeUpdate invokes the Update API to update an existing resource.
 func (wro *WorkloadResourceOperator) InvokeUpdate(req client.Workload) (*client.Workload, int, error) {
-	return wro.Client.UpdateWorkload(req, wro.Plan.Gvc.ValueString())
+	return wro.Client.UpdateWorkload(wro.Ctx, req, wro.Plan.Gvc.ValueString())
 }
 
 // InvokeDelete invokes the Delete API to delete a resource by name.
 func (wro *WorkloadResourceOperator) InvokeDelete(name string) error {
-	return wro.Client.DeleteWorkload(name, wro.Plan.Gvc.ValueString())
+	return wro.Client.DeleteWorkload(wro.Ctx, name, wro.Plan.Gvc.ValueString())
 }
 
 // Builders //
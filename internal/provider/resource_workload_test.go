@@ -1,10 +1,12 @@
 package cpln
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
+	client "github.com/controlplane-com/terraform-provider-cpln/internal/provider/client"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
@@ -28,6 +30,132 @@ func TestAccControlPlaneWorkload_basic(t *testing.T) {
 	})
 }
 
+/*** Unit Tests ***/
+
+// TestEvaluateWorkloadReadiness exercises the readiness thresholds used by the wait_for_ready block.
+func TestEvaluateWorkloadReadiness(t *testing.T) {
+	// Helpers to construct pointers from literals
+	intPtr := func(i int) *int { return &i }
+	boolPtr := func(b bool) *bool { return &b }
+	stringPtr := func(s string) *string { return &s }
+
+	// Define the table of cases
+	cases := []struct {
+		name              string
+		workload          client.Workload
+		deployments       []client.WorkloadDeployment
+		version           int
+		minReadyLocations *int
+		minReadyReplicas  *int
+		wantReady         bool
+		wantReasons       int
+	}{
+		{
+			name:        "missing health summary is not ready",
+			workload:    client.Workload{},
+			wantReady:   false,
+			wantReasons: 1,
+		},
+		{
+			name: "all locations ready",
+			workload: client.Workload{
+				Health: &client.WorkloadHealth{ReadyLocations: intPtr(2), TotalLocations: intPtr(2), ReadyReplicas: intPtr(2)},
+			},
+			wantReady:   true,
+			wantReasons: 0,
+		},
+		{
+			name: "partial locations ready without threshold",
+			workload: client.Workload{
+				Health: &client.WorkloadHealth{ReadyLocations: intPtr(1), TotalLocations: intPtr(2)},
+			},
+			wantReady:   false,
+			wantReasons: 1,
+		},
+		{
+			name: "partial locations ready with satisfied threshold",
+			workload: client.Workload{
+				Health: &client.WorkloadHealth{ReadyLocations: intPtr(1), TotalLocations: intPtr(2)},
+			},
+			minReadyLocations: intPtr(1),
+			wantReady:         true,
+			wantReasons:       0,
+		},
+		{
+			name: "not yet deployed anywhere",
+			workload: client.Workload{
+				Health: &client.WorkloadHealth{ReadyLocations: intPtr(0), TotalLocations: intPtr(0)},
+			},
+			wantReady:   false,
+			wantReasons: 1,
+		},
+		{
+			name: "replica threshold not met",
+			workload: client.Workload{
+				Health: &client.WorkloadHealth{ReadyLocations: intPtr(1), TotalLocations: intPtr(1), ReadyReplicas: intPtr(1)},
+			},
+			minReadyReplicas: intPtr(3),
+			wantReady:        false,
+			wantReasons:      1,
+		},
+		{
+			name: "failing health check blocks readiness",
+			workload: client.Workload{
+				Health: &client.WorkloadHealth{ReadyLocations: intPtr(1), TotalLocations: intPtr(1), SyncFailed: boolPtr(true)},
+				Status: &client.WorkloadStatus{
+					HealthCheck: &client.WorkloadStatusHealthCheck{Active: boolPtr(true), Success: boolPtr(false), Code: intPtr(503), Message: stringPtr("connection refused")},
+				},
+			},
+			wantReady:   false,
+			wantReasons: 2,
+		},
+		{
+			name: "healthy previous rollout is not ready",
+			workload: client.Workload{
+				Health: &client.WorkloadHealth{ReadyLocations: intPtr(2), TotalLocations: intPtr(2)},
+			},
+			deployments: []client.WorkloadDeployment{
+				{Name: stringPtr("aws-us-west-2"), Status: &client.WorkloadDeploymentStatus{Versions: &[]client.WorkloadDeploymentVersion{{Workload: intPtr(3), Ready: boolPtr(true)}}}},
+				{Name: stringPtr("gcp-us-east1"), Status: &client.WorkloadDeploymentStatus{Versions: &[]client.WorkloadDeploymentVersion{{Workload: intPtr(3), Ready: boolPtr(true)}, {Workload: intPtr(4), Ready: boolPtr(false)}}}},
+			},
+			version:     4,
+			wantReady:   false,
+			wantReasons: 1,
+		},
+		{
+			name: "rolled out version is ready",
+			workload: client.Workload{
+				Health: &client.WorkloadHealth{ReadyLocations: intPtr(1), TotalLocations: intPtr(1)},
+			},
+			deployments: []client.WorkloadDeployment{
+				{Name: stringPtr("aws-us-west-2"), Status: &client.WorkloadDeploymentStatus{Versions: &[]client.WorkloadDeploymentVersion{{Workload: intPtr(4), Ready: boolPtr(true)}}}},
+			},
+			version:     4,
+			wantReady:   true,
+			wantReasons: 0,
+		},
+	}
+
+	// Run each case
+	for _, tc := range cases {
+		// Run the case as a subtest
+		t.Run(tc.name, func(t *testing.T) {
+			// Invoke the helper
+			ready, reasons := evaluateWorkloadReadiness(&tc.workload, tc.deployments, tc.version, tc.minReadyLocations, tc.minReadyReplicas)
+
+			// Verify the readiness verdict
+			if ready != tc.wantReady {
+				t.Fatalf("expected ready=%t, got %t (reasons: %v)", tc.wantReady, ready, reasons)
+			}
+
+			// Verify the number of reported reasons
+			if len(reasons) != tc.wantReasons {
+				t.Fatalf("expected %d reasons, got %d: %v", tc.wantReasons, len(reasons), reasons)
+			}
+		})
+	}
+}
+
 /*** Resource Test ***/
 
 // WorkloadResourceTest defines the necessary functionality to test the resource.
@@ -82,6 +210,7 @@ func NewWorkloadResourceTest() WorkloadResourceTest {
 	steps = append(steps, resourceTest.NewStatefulScenario()...)
 	steps = append(steps, resourceTest.NewVmScenario()...)
 	steps = append(steps, resourceTest.NewVmDefaultsScenario()...)
+	steps = append(steps, resourceTest.NewWaitForReadyScenario()...)
 
 	// Set the cases for the resource test
 	resourceTest.Steps = steps
@@ -115,7 +244,7 @@ func (wrt *WorkloadResourceTest) CheckDestroy(s *terraform.State) error {
 		tflog.Info(TestLoggerContext, fmt.Sprintf("Checking existence of GVC with name: %s", gvcName))
 
 		// Use the TestProvider client to check if the API resource still exists in the data service
-		gvc, code, err := TestProvider.client.GetGvc(gvcName)
+		gvc, code, err := TestProvider.client.GetGvc(context.Background(), gvcName)
 
 		// If a 404 status code is returned, it indicates the API resource was deleted
 		if code == 404 {
@@ -177,6 +306,8 @@ func (wrt *WorkloadResourceTest) NewServerlessScenario() []resource.TestStep {
 			ImportState:   true,
 			ImportStateId: fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name),
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name)),
 		// Update & Read
 		caseUpdate1,
 		caseUpdate2,
@@ -206,6 +337,8 @@ func (wrt *WorkloadResourceTest) NewStandardScenario() []resource.TestStep {
 			ImportState:   true,
 			ImportStateId: fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name),
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name)),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -232,6 +365,8 @@ func (wrt *WorkloadResourceTest) NewCronScenario() []resource.TestStep {
 			ImportState:   true,
 			ImportStateId: fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name),
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name)),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -258,6 +393,8 @@ func (wrt *WorkloadResourceTest) NewStatefulScenario() []resource.TestStep {
 			ImportState:   true,
 			ImportStateId: fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name),
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name)),
 		// Update & Read
 		caseUpdate1,
 		// Revert the resource to its initial state
@@ -289,6 +426,8 @@ func (wrt *WorkloadResourceTest) NewVmScenario() []resource.TestStep {
 			ImportState:   true,
 			ImportStateId: fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name),
 		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name)),
 		// Update & Read (add cloud-init + a single access-credential)
 		caseUpdate1,
 		// Update & Read (flip to http boot source + base64 cloud-init, grow collections to two entries)
@@ -322,6 +461,31 @@ func (wrt *WorkloadResourceTest) NewVmDefaultsScenario() []resource.TestStep {
 	}
 }
 
+// NewWaitForReadyScenario verifies that a workload configured with wait_for_ready only completes once it reports ready.
+func (wrt *WorkloadResourceTest) NewWaitForReadyScenario() []resource.TestStep {
+	// Generate a unique name for the resources
+	name := fmt.Sprintf("workload-wait-%s", wrt.RandomName)
+
+	// Build test steps
+	initialConfig, initialStep := wrt.BuildWaitForReadyTestStep(name)
+
+	// Return the complete test steps
+	return []resource.TestStep{
+		// Create & Read
+		initialStep,
+		// Import State
+		{
+			ResourceName:            initialConfig.ResourceAddress,
+			ImportState:             true,
+			ImportStateId:           fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name),
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"wait_for_ready", "status"},
+		},
+		// Import with an import block and expect an empty plan
+		BuildImportPlanTestStep(initialConfig.ResourceAddress, fmt.Sprintf("%s:%s", wrt.GvcCase.Name, name)),
+	}
+}
+
 // Test Cases //
 
 // BuildK8sVolumeUriTestStep constructs a workload test step that mounts a k8s://secret volume and asserts its uri and path persist to state.
@@ -3281,6 +3445,40 @@ func (wrt *WorkloadResourceTest) BuildVmDefaultsOmittedTestStep(name string) (Wo
 	}
 }
 
+// BuildWaitForReadyTestStep constructs a serverless workload test step that waits for the rollout to converge.
+func (wrt *WorkloadResourceTest) BuildWaitForReadyTestStep(name string) (WorkloadResourceTestCase, resource.TestStep) {
+	// Create the test case with metadata and descriptions
+	c := WorkloadResourceTestCase{
+		ProviderTestCase: ProviderTestCase{
+			Kind:            "workload",
+			ResourceName:    "new",
+			ResourceAddress: "cpln_workload.new",
+			Name:            name,
+			GvcName:         wrt.GvcCase.Name,
+			Description:     name,
+		},
+	}
+
+	// Initialize and return the test step
+	return c, resource.TestStep{
+		Config: wrt.WaitForReadyHcl(c),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			c.Exists(),
+			c.GetDefaultChecks(c.Description, "0"),
+			resource.TestCheckResourceAttr(c.ResourceAddress, "gvc", wrt.GvcCase.Name),
+			resource.TestCheckResourceAttr(c.ResourceAddress, "type", "serverless"),
+			c.TestCheckNestedBlocks("wait_for_ready", []map[string]interface{}{
+				{
+					"timeout":            "600",
+					"poll_interval":      "5",
+					"min_ready_replicas": "1",
+				},
+			}),
+			resource.TestCheckResourceAttr(c.ResourceAddress, "status.0.health_check.0.success", "true"),
+		),
+	}
+}
+
 // BuildVmDefaultsSetTestStep constructs a vm workload test step that sets firmware/network/clock explicitly to override the defaults.
 func (wrt *WorkloadResourceTest) BuildVmDefaultsSetTestStep(initialCase ProviderTestCase) resource.TestStep {
 	// Create the test case with metadata and descriptions
@@ -6306,6 +6504,39 @@ resource "cpln_workload" "%s" {
 	)
 }
 
+// WaitForReadyHcl returns a serverless workload configuration that waits for the workload to become ready.
+func (wrt *WorkloadResourceTest) WaitForReadyHcl(c WorkloadResourceTestCase) string {
+	return fmt.Sprintf(`
+# GVC Resource
+%s
+
+resource "cpln_workload" "%s" {
+  depends_on = [%s]
+
+  name = "%s"
+  gvc  = %s
+  type = "serverless"
+
+  container {
+    name  = "container-01"
+    image = "gcr.io/knative-samples/helloworld-go"
+
+    ports {
+      protocol = "http"
+      number   = "8080"
+    }
+  }
+
+  wait_for_ready {
+    timeout            = 600
+    poll_interval      = 5
+    min_ready_replicas = 1
+  }
+}
+`, wrt.GvcConfig, c.ResourceName, wrt.GvcCase.ResourceAddress, c.Name, wrt.GvcCase.GetResourceNameAttr(),
+	)
+}
+
 /*** Resource Test Case ***/
 
 // WorkloadResourceTestCase defines a specific resource test case.
@@ -6333,7 +6564,7 @@ func (wrtc *WorkloadResourceTestCase) Exists() resource.TestCheckFunc {
 		}
 
 		// Retrieve the API resource from the external system using the provider client
-		remoteWorkload, _, err := TestProvider.client.GetWorkload(wrtc.Name, wrtc.GvcName)
+		remoteWorkload, _, err := TestProvider.client.GetWorkload(context.Background(), wrtc.Name, wrtc.GvcName)
 		if err != nil {
 			return fmt.Errorf("error retrieving workload from external system: %w", err)
 		}
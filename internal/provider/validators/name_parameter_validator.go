@@ -0,0 +1,36 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// NameParameterValidator ensures a function argument matches the required name pattern and length constraints.
+type NameParameterValidator struct{}
+
+// ValidateParameterString performs the validation logic.
+func (v NameParameterValidator) ValidateParameterString(ctx context.Context, req function.StringParameterValidatorRequest, resp *function.StringParameterValidatorResponse) {
+	// Skip unknown values, they are validated once known
+	if req.Value.IsNull() || req.Value.IsUnknown() {
+		return
+	}
+
+	value := req.Value.ValueString()
+
+	// Define the regular expression pattern
+	re := regexp.MustCompile(`^[a-z][-a-z0-9]([-a-z0-9])*[a-z0-9]$`)
+
+	// Check if the value matches the pattern
+	if !re.MatchString(value) {
+		resp.Error = function.NewArgumentFuncError(req.ArgumentPosition, fmt.Sprintf("The value '%s' does not match the required pattern: %s", value, re.String()))
+		return
+	}
+
+	// Check the length constraint
+	if len(value) > 63 {
+		resp.Error = function.NewArgumentFuncError(req.ArgumentPosition, fmt.Sprintf("The value '%s' exceeds the maximum length of 63 characters.", value))
+	}
+}
@@ -0,0 +1,238 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+/*** Quantity ***/
+
+// QuantityFamily groups the units a quantity can be expressed in.
+type QuantityFamily string
+
+const (
+	// QuantityFamilyCpu holds cores, without a suffix, and millicores, with the "m" suffix.
+	QuantityFamilyCpu QuantityFamily = "cpu"
+
+	// QuantityFamilyBinary holds bytes with a power of two suffix, such as "Mi".
+	QuantityFamilyBinary QuantityFamily = "binary"
+
+	// QuantityFamilyDecimal holds bytes with a power of ten suffix, such as "M".
+	QuantityFamilyDecimal QuantityFamily = "decimal"
+)
+
+// quantityPattern matches an unsigned decimal number with an optional decimal exponent, such as "1e9", followed by an
+// optional unit suffix. An "e" or "E" is only read as an exponent when digits follow it, so "1E" stays one exabyte, and
+// the exponent is limited to two digits to keep the parsed numbers small.
+var quantityPattern = regexp.MustCompile(`^((?:[0-9]+(?:\.[0-9]+)?|\.[0-9]+)(?:[eE][+-]?[0-9]{1,2})?)([A-Za-z]*)$`)
+
+// quantityUnit describes a unit suffix and its multiplier relative to cores or bytes.
+type quantityUnit struct {
+	Suffix     string
+	Family     QuantityFamily
+	Multiplier *big.Rat
+}
+
+// quantityUnits lists every supported unit, each family ordered from the largest to the smallest unit.
+var quantityUnits = []quantityUnit{
+	{"", QuantityFamilyCpu, big.NewRat(1, 1)},
+	{"m", QuantityFamilyCpu, big.NewRat(1, 1000)},
+	{"Ei", QuantityFamilyBinary, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 60))},
+	{"Pi", QuantityFamilyBinary, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 50))},
+	{"Ti", QuantityFamilyBinary, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 40))},
+	{"Gi", QuantityFamilyBinary, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 30))},
+	{"Mi", QuantityFamilyBinary, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 20))},
+	{"Ki", QuantityFamilyBinary, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 10))},
+	{"E", QuantityFamilyDecimal, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))},
+	{"P", QuantityFamilyDecimal, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(15), nil))},
+	{"T", QuantityFamilyDecimal, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(12), nil))},
+	{"G", QuantityFamilyDecimal, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(9), nil))},
+	{"M", QuantityFamilyDecimal, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(6), nil))},
+	{"k", QuantityFamilyDecimal, big.NewRat(1000, 1)},
+}
+
+// Quantity is a parsed resource quantity, such as "500m" or "1.5Gi".
+type Quantity struct {
+	// Value is the number as written, with its exponent but before the unit is applied.
+	Value *big.Rat
+
+	// Unit is the suffix as written.
+	Unit string
+
+	// Family is the family of the unit.
+	Family QuantityFamily
+
+	// Base is the quantity in cores or bytes.
+	Base *big.Rat
+}
+
+// ParseQuantity parses a CPU or memory quantity.
+func ParseQuantity(value string) (*Quantity, error) {
+	// Split the number from the unit
+	match := quantityPattern.FindStringSubmatch(value)
+	if match == nil {
+		return nil, fmt.Errorf("%q is not a quantity, expected a number followed by an optional unit such as \"500m\" or \"128Mi\"", value)
+	}
+
+	// Parse the number, applying its exponent
+	number, ok := new(big.Rat).SetString(match[1])
+	if !ok {
+		return nil, fmt.Errorf("%q is not a quantity, the number cannot be parsed", value)
+	}
+
+	// Look up the unit
+	for _, unit := range quantityUnits {
+		if unit.Suffix == match[2] {
+			return &Quantity{
+				Value:  number,
+				Unit:   unit.Suffix,
+				Family: unit.Family,
+				Base:   new(big.Rat).Mul(number, unit.Multiplier),
+			}, nil
+		}
+	}
+
+	// Report the unsupported unit
+	return nil, fmt.Errorf("%q is not a quantity, unit %q is not one of m, k, M, G, T, P, E, Ki, Mi, Gi, Ti, Pi or Ei", value, match[2])
+}
+
+// ParseCpuQuantity parses a quantity of cores, such as "1.5", or millicores, such as "1500m".
+func ParseCpuQuantity(value string) (*Quantity, error) {
+	// Parse the quantity
+	quantity, err := ParseQuantity(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject memory units
+	if quantity.Family != QuantityFamilyCpu {
+		return nil, fmt.Errorf("%q is not a CPU quantity, use cores such as \"1.5\" or millicores such as \"1500m\"", value)
+	}
+
+	// Return the parsed quantity
+	return quantity, nil
+}
+
+// ParseMemoryQuantity parses a quantity of bytes, optionally with a binary or decimal suffix such as "128Mi".
+func ParseMemoryQuantity(value string) (*Quantity, error) {
+	// Parse the quantity
+	quantity, err := ParseQuantity(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject millibytes, which cannot be allocated
+	if quantity.Unit == "m" {
+		return nil, fmt.Errorf("%q is not a memory quantity, use bytes with a suffix such as \"128Mi\" or \"1G\"", value)
+	}
+
+	// Reject fractions of a byte, such as "0.5", which cannot be allocated
+	if !quantity.Base.IsInt() {
+		return nil, fmt.Errorf("%q is not a memory quantity, it is not a whole number of bytes", value)
+	}
+
+	// Return the parsed quantity
+	return quantity, nil
+}
+
+// Canonical returns the quantity in the largest unit of its family that keeps the number whole, such as "1Gi" for
+// "1024Mi" or "1500m" for "1.5".
+func (q *Quantity) Canonical() string {
+	return FormatQuantity(q.Base, q.Family)
+}
+
+// FormatQuantity formats a quantity of cores or bytes in the largest unit of the family that keeps the number whole.
+func FormatQuantity(base *big.Rat, family QuantityFamily) string {
+	// Try every unit of the family, from the largest to the smallest
+	for _, unit := range quantityUnits {
+		// Skip the units of other families
+		if unit.Family != family {
+			continue
+		}
+
+		// Express the quantity in the unit
+		value := new(big.Rat).Quo(base, unit.Multiplier)
+
+		// Use the first unit that keeps the number whole
+		if value.IsInt() && (value.Sign() != 0 || unit.Multiplier.Cmp(big.NewRat(1, 1)) == 0) {
+			return value.Num().String() + unit.Suffix
+		}
+	}
+
+	// Fall back to the smallest unit, cores or bytes, with a fraction
+	if family == QuantityFamilyCpu {
+		return FormatDecimal(new(big.Rat).Mul(base, big.NewRat(1000, 1))) + "m"
+	}
+
+	return FormatDecimal(base)
+}
+
+// FormatDecimal formats a rational number without trailing zeros.
+func FormatDecimal(value *big.Rat) string {
+	// Whole numbers need no fraction
+	if value.IsInt() {
+		return value.Num().String()
+	}
+
+	// Trim the trailing zeros of the fraction
+	return strings.TrimRight(strings.TrimRight(value.FloatString(9), "0"), ".")
+}
+
+/*** Validators ***/
+
+// CpuQuantityValidator ensures the string is a quantity of cores or millicores.
+type CpuQuantityValidator struct{}
+
+// Description provides a plain text description of the validator's behavior.
+func (v CpuQuantityValidator) Description(ctx context.Context) string {
+	return "Ensures the string is a CPU quantity in cores, such as \"1.5\", or millicores, such as \"1500m\"."
+}
+
+// MarkdownDescription provides a markdown description of the validator's behavior.
+func (v CpuQuantityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation logic.
+func (v CpuQuantityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip values that are not known yet
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Parse the quantity
+	if _, err := ParseCpuQuantity(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid CPU Quantity", err.Error())
+	}
+}
+
+// MemoryQuantityValidator ensures the string is a quantity of bytes.
+type MemoryQuantityValidator struct{}
+
+// Description provides a plain text description of the validator's behavior.
+func (v MemoryQuantityValidator) Description(ctx context.Context) string {
+	return "Ensures the string is a memory quantity in bytes, optionally with a binary (Ki, Mi, Gi, Ti, Pi, Ei) or decimal (k, M, G, T, P, E) suffix."
+}
+
+// MarkdownDescription provides a markdown description of the validator's behavior.
+func (v MemoryQuantityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation logic.
+func (v MemoryQuantityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip values that are not known yet
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Parse the quantity
+	if _, err := ParseMemoryQuantity(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Memory Quantity", err.Error())
+	}
+}